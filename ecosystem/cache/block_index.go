@@ -0,0 +1,161 @@
+package cache
+
+import (
+	"bytes"
+	"sync"
+)
+
+// heights further than this below the highest height of a chain are no longer tracked, their entries expire normally
+const DefaultMaxTrackedHeights = 10000
+
+type blockEntries struct {
+	hash      []byte
+	finalized bool
+	keys      map[string]string // cache key -> method
+}
+
+type chainBlocks struct {
+	highest int64
+	heights map[int64]*blockEntries
+}
+
+// blockIndex tracks the block hash and the cache keys stored for every height of a chain,
+// so entries of a block that was replaced by a reorg can be found and purged
+type blockIndex struct {
+	lock              sync.Mutex
+	chains            map[string]*chainBlocks
+	maxTrackedHeights int64
+}
+
+func newBlockIndex(maxTrackedHeights int64) *blockIndex {
+	if maxTrackedHeights <= 0 {
+		maxTrackedHeights = DefaultMaxTrackedHeights
+	}
+	return &blockIndex{chains: map[string]*chainBlocks{}, maxTrackedHeights: maxTrackedHeights}
+}
+
+// records a key stored for a height, when the height is not finalized and the hash differs from the tracked hash
+// the block was reorged and the keys stored for the previous hash are returned so they can be purged.
+// a nil hash adds the key without checking the hash
+func (bi *blockIndex) add(chainID string, height int64, hash []byte, finalized bool, key string, method string) (reorged []string) {
+	bi.lock.Lock()
+	defer bi.lock.Unlock()
+	chain, entries := bi.getEntries(chainID, height, hash)
+	if entries == nil {
+		return nil
+	}
+	if hash != nil {
+		if entries.hash != nil && !bytes.Equal(entries.hash, hash) && !entries.finalized {
+			for storedKey := range entries.keys {
+				reorged = append(reorged, storedKey)
+			}
+			entries.keys = map[string]string{}
+		}
+		if !entries.finalized || entries.hash == nil {
+			entries.hash = hash
+		}
+	}
+	if finalized {
+		// a finalized block can't be reorged, later hashes for it are ignored
+		entries.finalized = true
+	}
+	if key != "" {
+		entries.keys[key] = method
+	}
+	bi.updateHighest(chain, height)
+	return reorged
+}
+
+// records the hash of the latest block reported for a chain. When the height is tracked with a different hash
+// the chain reorged from that height, and the keys stored for non-finalized heights from it on are returned so they can be purged
+func (bi *blockIndex) setLatest(chainID string, height int64, hash []byte) (reorged []string) {
+	if hash == nil {
+		return nil
+	}
+	bi.lock.Lock()
+	defer bi.lock.Unlock()
+	chain, entries := bi.getEntries(chainID, height, hash)
+	if entries == nil {
+		return nil
+	}
+	bi.updateHighest(chain, height)
+	if entries.finalized || entries.hash == nil || bytes.Equal(entries.hash, hash) {
+		if entries.hash == nil {
+			entries.hash = hash
+		}
+		return nil
+	}
+	for storedHeight, storedEntries := range chain.heights {
+		if storedHeight < height || storedEntries.finalized {
+			continue
+		}
+		for storedKey := range storedEntries.keys {
+			reorged = append(reorged, storedKey)
+		}
+		storedEntries.keys = map[string]string{}
+		// the hashes above the reorged height belong to the replaced fork
+		storedEntries.hash = nil
+	}
+	entries.hash = hash
+	return reorged
+}
+
+// returns the tracked entries of a height, creating them with the given hash when missing.
+// returns nil entries for heights that are no longer tracked. assumes the lock is held
+func (bi *blockIndex) getEntries(chainID string, height int64, hash []byte) (*chainBlocks, *blockEntries) {
+	chain, ok := bi.chains[chainID]
+	if !ok {
+		chain = &chainBlocks{highest: height, heights: map[int64]*blockEntries{}}
+		bi.chains[chainID] = chain
+	}
+	if height < chain.highest-bi.maxTrackedHeights {
+		return chain, nil
+	}
+	entries, ok := chain.heights[height]
+	if !ok {
+		entries = &blockEntries{hash: hash, keys: map[string]string{}}
+		chain.heights[height] = entries
+	}
+	return chain, entries
+}
+
+// assumes the lock is held
+func (bi *blockIndex) updateHighest(chain *chainBlocks, height int64) {
+	if height > chain.highest {
+		chain.highest = height
+		bi.prune(chain)
+	}
+}
+
+// assumes the lock is held
+func (bi *blockIndex) prune(chain *chainBlocks) {
+	for height := range chain.heights {
+		if height < chain.highest-bi.maxTrackedHeights {
+			delete(chain.heights, height)
+		}
+	}
+}
+
+// removes and returns the keys matching the filters, an empty chainID or method matches all, toBlock 0 has no upper limit
+func (bi *blockIndex) invalidate(chainID string, fromBlock int64, toBlock int64, method string) (keys []string) {
+	bi.lock.Lock()
+	defer bi.lock.Unlock()
+	for storedChainID, chain := range bi.chains {
+		if chainID != "" && storedChainID != chainID {
+			continue
+		}
+		for height, entries := range chain.heights {
+			if height < fromBlock || (toBlock > 0 && height > toBlock) {
+				continue
+			}
+			for key, storedMethod := range entries.keys {
+				if method != "" && storedMethod != method {
+					continue
+				}
+				keys = append(keys, key)
+				delete(entries.keys, key)
+			}
+		}
+	}
+	return keys
+}
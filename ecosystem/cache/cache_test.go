@@ -490,3 +490,282 @@ func TestCacheSetGetJsonRPCWithID(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheStorageSurvivesRestart(t *testing.T) {
+	storagePath := t.TempDir()
+	initTestWithStorage := func() (context.Context, *cache.RelayerCacheServer, *cache.BadgerStorage) {
+		ctx, cacheServer := initTest()
+		storage, err := cache.NewLocalStorage(storagePath)
+		require.NoError(t, err)
+		cacheServer.CacheServer.Storage = storage
+		cacheServer.CacheServer.ExpirationStorage = cache.DefaultExpirationStorage
+		require.NoError(t, cacheServer.CacheServer.LoadStorageIndex())
+		return ctx, cacheServer, storage
+	}
+	ctx, cacheServer, storage := initTestWithStorage()
+	finalizedRequest := getRequest(1230, []byte(StubData), StubApiInterface)
+	nonFinalizedRequest := getRequest(1240, []byte(StubData), StubApiInterface)
+	for _, request := range []*pairingtypes.RelayPrivateData{finalizedRequest, nonFinalizedRequest} {
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			Request:          shallowCopy(request),
+			ChainID:          StubChainID,
+			Response:         &pairingtypes.RelayReply{Data: []byte(strconv.FormatInt(request.RequestBlock, 10))},
+			Finalized:        request == finalizedRequest,
+			OptionalMetadata: []pairingtypes.Metadata{{Name: "stub", Value: "meta"}},
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, storage.Close())
+
+	// a new cache server starts with empty memory caches, only finalized requests read the storage
+	ctx, cacheServer, storage = initTestWithStorage()
+	defer storage.Close()
+	_, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(finalizedRequest), ChainID: StubChainID, Finalized: false})
+	require.Error(t, err)
+	reply, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(finalizedRequest), ChainID: StubChainID, Finalized: true})
+	require.NoError(t, err)
+	require.Equal(t, "1230", string(reply.Reply.Data))
+	require.Equal(t, []pairingtypes.Metadata{{Name: "stub", Value: "meta"}}, reply.OptionalMetadata)
+	// non finalized entries are only kept in memory
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(nonFinalizedRequest), ChainID: StubChainID, Finalized: false})
+	require.Error(t, err)
+
+	// entries stored before the restart can be invalidated
+	invalidated, err := cacheServer.InvalidateRelays(ctx, &pairingtypes.RelayCacheInvalidate{ChainID: StubChainID, FromBlock: 1230, ToBlock: 1230})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), invalidated.Invalidated)
+	require.NoError(t, storage.Close())
+	ctx, cacheServer, storage = initTestWithStorage()
+	defer storage.Close()
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(finalizedRequest), ChainID: StubChainID, Finalized: true})
+	require.Error(t, err)
+}
+
+func TestCacheSetGetRestReorderedQuery(t *testing.T) {
+	t.Parallel()
+	ctx, cacheServer := initTest()
+	request := getRequest(1230, nil, spectypes.APIInterfaceRest)
+	request.ApiUrl = "/cosmos/tx/v1beta1/txs?events=tx.height%3D1230&pagination.limit=10"
+	_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+		Request:   shallowCopy(request),
+		ChainID:   StubChainID,
+		Response:  &pairingtypes.RelayReply{Data: []byte(`{"txs":[]}`)},
+		Finalized: true,
+	})
+	require.NoError(t, err)
+	time.Sleep(3 * time.Millisecond)
+
+	reorderedRequest := shallowCopy(request)
+	reorderedRequest.ApiUrl = "/cosmos/tx/v1beta1/txs?pagination.limit=10&events=tx.height%3D1230"
+	reply, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: reorderedRequest, ChainID: StubChainID, Finalized: true})
+	require.NoError(t, err)
+	require.Equal(t, `{"txs":[]}`, string(reply.Reply.Data))
+
+	otherRequest := shallowCopy(request)
+	otherRequest.ApiUrl = "/cosmos/tx/v1beta1/txs?pagination.limit=11&events=tx.height%3D1230"
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: otherRequest, ChainID: StubChainID, Finalized: true})
+	require.Error(t, err)
+}
+
+func TestCachePurgeReorgedBlock(t *testing.T) {
+	t.Parallel()
+	ctx, cacheServer := initTest()
+	oldHash := []byte{1, 2, 3}
+	newHash := []byte{4, 5, 6}
+	hashedRequest := getRequest(1240, []byte("hashed"), StubApiInterface)
+	noHashRequest := getRequest(1240, []byte("no-hash"), StubApiInterface)
+	otherBlockRequest := getRequest(1239, []byte("hashed"), StubApiInterface)
+	for _, request := range []*pairingtypes.RelayPrivateData{hashedRequest, noHashRequest, otherBlockRequest} {
+		hash := oldHash
+		if request == noHashRequest {
+			hash = nil
+		}
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			Request:   shallowCopy(request),
+			BlockHash: hash,
+			ChainID:   StubChainID,
+			Response:  &pairingtypes.RelayReply{Data: request.Data},
+			Provider:  StubProviderAddr,
+		})
+		require.NoError(t, err)
+	}
+	time.Sleep(3 * time.Millisecond)
+	_, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(hashedRequest), BlockHash: oldHash, ChainID: StubChainID, Provider: StubProviderAddr})
+	require.NoError(t, err)
+
+	// the chain tracker reports a different hash for the block
+	_, err = cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+		Request:   getRequest(1240, []byte("block-hash"), StubApiInterface),
+		BlockHash: newHash,
+		ChainID:   StubChainID,
+		Response:  &pairingtypes.RelayReply{Data: newHash},
+	})
+	require.NoError(t, err)
+	time.Sleep(3 * time.Millisecond)
+
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(hashedRequest), BlockHash: oldHash, ChainID: StubChainID, Provider: StubProviderAddr})
+	require.Error(t, err)
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(noHashRequest), ChainID: StubChainID, Provider: StubProviderAddr})
+	require.Error(t, err)
+	// other blocks are not affected
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(otherBlockRequest), BlockHash: oldHash, ChainID: StubChainID, Provider: StubProviderAddr})
+	require.NoError(t, err)
+}
+
+func TestCachePurgeReorgedLatestBlock(t *testing.T) {
+	t.Parallel()
+	ctx, cacheServer := initTest()
+	const otherProviderAddr = "lava@other"
+	oldHash := []byte{1, 2, 3}
+	newHash := []byte{4, 5, 6}
+	type entry struct {
+		request   *pairingtypes.RelayPrivateData
+		provider  string
+		finalized bool
+	}
+	entries := []entry{
+		{request: getRequest(1239, []byte("before"), StubApiInterface), provider: StubProviderAddr},
+		{request: getRequest(1240, []byte("latest"), StubApiInterface), provider: StubProviderAddr},
+		{request: getRequest(1241, []byte("after"), StubApiInterface), provider: otherProviderAddr},
+		{request: getRequest(1242, []byte("finalized"), StubApiInterface), provider: otherProviderAddr, finalized: true},
+	}
+	isCached := func(e entry) bool {
+		_, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(e.request), BlockHash: oldHash, ChainID: StubChainID, Provider: e.provider, Finalized: e.finalized})
+		return err == nil
+	}
+	for _, e := range entries {
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			Request:   shallowCopy(e.request),
+			BlockHash: oldHash,
+			ChainID:   StubChainID,
+			Response:  &pairingtypes.RelayReply{Data: e.request.Data},
+			Provider:  e.provider,
+			Finalized: e.finalized,
+		})
+		require.NoError(t, err)
+	}
+	time.Sleep(3 * time.Millisecond)
+	for _, e := range entries {
+		require.True(t, isCached(e))
+	}
+
+	// the provider's latest block is reported again with a different hash, the chain reorged from it
+	_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+		Request:   getRequest(1240, []byte("block-hash"), StubApiInterface),
+		BlockHash: newHash,
+		ChainID:   StubChainID,
+		Response:  &pairingtypes.RelayReply{Data: newHash},
+		Provider:  StubProviderAddr,
+	})
+	require.NoError(t, err)
+	time.Sleep(3 * time.Millisecond)
+
+	require.Equal(t, []bool{true, false, false, true}, []bool{isCached(entries[0]), isCached(entries[1]), isCached(entries[2]), isCached(entries[3])})
+}
+
+func TestCacheFinalizedBlockIsNotPurged(t *testing.T) {
+	t.Parallel()
+	ctx, cacheServer := initTest()
+	request := getRequest(1230, []byte(StubData), StubApiInterface)
+	_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+		Request:   shallowCopy(request),
+		BlockHash: []byte{1, 2, 3},
+		ChainID:   StubChainID,
+		Response:  &pairingtypes.RelayReply{Data: []byte(StubData)},
+		Finalized: true,
+	})
+	require.NoError(t, err)
+	_, err = cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+		Request:   getRequest(1230, []byte("block-hash"), StubApiInterface),
+		BlockHash: []byte{4, 5, 6},
+		ChainID:   StubChainID,
+		Response:  &pairingtypes.RelayReply{},
+	})
+	require.NoError(t, err)
+	time.Sleep(3 * time.Millisecond)
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: shallowCopy(request), ChainID: StubChainID, Finalized: true})
+	require.NoError(t, err)
+}
+
+func TestCacheInvalidateRelays(t *testing.T) {
+	t.Parallel()
+	const otherChainID = "other-chain"
+	ctx, cacheServer := initTest()
+	type entry struct {
+		chainID string
+		block   int64
+		method  string
+	}
+	entries := []entry{
+		{chainID: StubChainID, block: 100, method: "method1"},
+		{chainID: StubChainID, block: 101, method: "method1"},
+		{chainID: StubChainID, block: 102, method: "method1"},
+		{chainID: StubChainID, block: 102, method: "method2"},
+		{chainID: otherChainID, block: 102, method: "method1"},
+	}
+	requestForEntry := func(e entry) *pairingtypes.RelayPrivateData {
+		request := getRequest(e.block, []byte(StubData), StubApiInterface)
+		request.ApiUrl = e.method
+		return request
+	}
+	isCached := func(e entry) bool {
+		_, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: requestForEntry(e), ChainID: e.chainID, Finalized: true})
+		return err == nil
+	}
+	for _, e := range entries {
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			Request:   requestForEntry(e),
+			ChainID:   e.chainID,
+			Response:  &pairingtypes.RelayReply{Data: []byte(StubData)},
+			Finalized: true,
+		})
+		require.NoError(t, err)
+	}
+	time.Sleep(3 * time.Millisecond)
+
+	_, err := cacheServer.InvalidateRelays(ctx, &pairingtypes.RelayCacheInvalidate{ChainID: StubChainID, FromBlock: 102, ToBlock: 101})
+	require.Error(t, err)
+
+	reply, err := cacheServer.InvalidateRelays(ctx, &pairingtypes.RelayCacheInvalidate{ChainID: StubChainID, FromBlock: 101, Method: "method1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), reply.Invalidated)
+	time.Sleep(3 * time.Millisecond)
+	require.Equal(t, []bool{true, false, false, true, true}, []bool{isCached(entries[0]), isCached(entries[1]), isCached(entries[2]), isCached(entries[3]), isCached(entries[4])})
+
+	reply, err = cacheServer.InvalidateRelays(ctx, &pairingtypes.RelayCacheInvalidate{ChainID: StubChainID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), reply.Invalidated)
+	time.Sleep(3 * time.Millisecond)
+	require.False(t, isCached(entries[0]))
+	require.False(t, isCached(entries[3]))
+	require.True(t, isCached(entries[4]))
+}
+
+func TestCacheStorageQueuedWritesKeepOrder(t *testing.T) {
+	storagePath := t.TempDir()
+	storage, err := cache.NewLocalStorage(storagePath)
+	require.NoError(t, err)
+	value := cache.CacheValue{Response: pairingtypes.RelayReply{Data: []byte("1230")}}
+	index := cache.StorageIndex{ChainID: StubChainID, Height: 1230, Method: "status"}
+	require.NoError(t, storage.Set("deleted", value, index, 0))
+	require.NoError(t, storage.Set("kept", value, index, 0))
+	require.NoError(t, storage.Delete("deleted"))
+	// queued writes are flushed on close
+	require.NoError(t, storage.Close())
+	require.Error(t, storage.Set("closed", value, index, 0))
+
+	storage, err = cache.NewLocalStorage(storagePath)
+	require.NoError(t, err)
+	defer storage.Close()
+	_, found, err := storage.Get("deleted")
+	require.NoError(t, err)
+	require.False(t, found)
+	stored, found, err := storage.Get("kept")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "1230", string(stored.Response.Data))
+	// the index of a deleted entry is deleted with it
+	indexed := map[string]cache.StorageIndex{}
+	require.NoError(t, storage.LoadIndex(func(key string, index cache.StorageIndex) { indexed[key] = index }))
+	require.Equal(t, map[string]cache.StorageIndex{"kept": index}, indexed)
+}
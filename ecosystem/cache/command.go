@@ -44,6 +44,9 @@ longer DefaultExpirationForNonFinalized will reduce sync QoS for "latest" reques
 	cacheCmd.Flags().Duration(ExpirationNonFinalizedFlagName, DefaultExpirationForNonFinalized, "how long does a cache entry lasts in the cache for a non finalized entry")
 	cacheCmd.Flags().String(FlagMetricsAddress, DisabledFlagOption, "address to listen to prometheus metrics 127.0.0.1:5555, later you can curl http://127.0.0.1:5555/metrics")
 	cacheCmd.Flags().Int64(FlagCacheSizeName, 2*1024*1024*1024, "the maximal amount of entries to save")
+	cacheCmd.Flags().String(FlagStoragePathName, "", "directory to persist finalized entries in so they survive restarts, the memory cache is kept in front of it, empty keeps entries only in memory")
+	cacheCmd.Flags().Duration(ExpirationStorageFlagName, DefaultExpirationStorage, "how long does a finalized entry lasts in the persistent storage")
+	cacheCmd.Flags().String(FlagAdminListenAddressName, "", "address to serve the admin service that invalidates entries on, e.g 127.0.0.1:7778, empty disables it")
 	cacheCmd.Flags().Bool(FlagUseMethodInApiSpecificCacheMetricsName, false, "use method in the cache specific api metric")
 	return cacheCmd
 }
@@ -12,11 +12,30 @@ func FormatterForRelayRequestAndResponse(apiInterface string) (inputFormatter fu
 	case spectypes.APIInterfaceTendermintRPC:
 		// tendermint has json rpc input as well
 		return FormatterForRelayRequestAndResponseJsonRPC()
+	case spectypes.APIInterfaceRest:
+		return FormatterForRelayRequestAndResponseRest()
+	case spectypes.APIInterfaceGrpc:
+		return FormatterForRelayRequestAndResponseGrpc()
 	default:
 		return IdentityFormatter()
 	}
 }
 
+// api url formatter works on the api url of the request, the url is part of the cache key but isn't returned so it doesn't need restoring
+func FormatterForRelayRequestApiUrl(apiInterface string) (urlFormatter func(string) string) {
+	switch apiInterface {
+	case spectypes.APIInterfaceRest:
+		return CanonicalQueryUrl
+	case spectypes.APIInterfaceTendermintRPC:
+		// tendermint uri requests have their params in the query
+		return CanonicalQueryUrl
+	default:
+		return func(apiUrl string) string {
+			return apiUrl
+		}
+	}
+}
+
 func IdentityFormatter() (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
 	inputFormatter = func(inpData []byte) []byte {
 		return inpData
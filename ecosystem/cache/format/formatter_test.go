@@ -5,6 +5,7 @@ import (
 
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func TestFormatter(t *testing.T) {
@@ -15,3 +16,61 @@ func TestFormatter(t *testing.T) {
 	outData := outputFormatter(inputData)
 	require.Equal(t, string(outData), data)
 }
+
+func TestFormatterApiUrl(t *testing.T) {
+	restFormatter := FormatterForRelayRequestApiUrl(spectypes.APIInterfaceRest)
+	require.Equal(t, restFormatter("/cosmos/tx/v1beta1/txs?events=a&pagination.limit=10"), restFormatter("/cosmos/tx/v1beta1/txs?pagination.limit=10&events=a"))
+	// the order of a repeated key's values is kept
+	require.NotEqual(t, restFormatter("/txs?events=a&events=b"), restFormatter("/txs?events=b&events=a"))
+	require.Equal(t, "/blocks/latest", restFormatter("/blocks/latest"))
+
+	tendermintFormatter := FormatterForRelayRequestApiUrl(spectypes.APIInterfaceTendermintRPC)
+	require.Equal(t, tendermintFormatter("block_results?height=10&prove=true"), tendermintFormatter("block_results?prove=true&height=10"))
+	require.Equal(t, tendermintFormatter("status"), tendermintFormatter("status?"))
+	require.Equal(t, tendermintFormatter(`tx?hash=%22ABCD%22`), tendermintFormatter(`tx?hash="ABCD"`))
+
+	// grpc urls are method names
+	require.Equal(t, "cosmos.bank.v1beta1.Query/Balance", FormatterForRelayRequestApiUrl(spectypes.APIInterfaceGrpc)("cosmos.bank.v1beta1.Query/Balance"))
+}
+
+func TestFormatterRest(t *testing.T) {
+	inputFormatter, outputFormatter := FormatterForRelayRequestAndResponse(spectypes.APIInterfaceRest)
+	require.Equal(t, inputFormatter([]byte(`{"tx_bytes":"AA==","mode":"BROADCAST_MODE_SYNC"}`)), inputFormatter([]byte(`{ "mode": "BROADCAST_MODE_SYNC", "tx_bytes": "AA==" }`)))
+	require.NotEqual(t, inputFormatter([]byte(`{"amount":123456789012345678901}`)), inputFormatter([]byte(`{"amount":123456789012345678902}`)))
+	require.Equal(t, "not json", string(inputFormatter([]byte("not json"))))
+	require.Empty(t, inputFormatter(nil))
+	require.Equal(t, `{"b":1,"a":2}`, string(outputFormatter([]byte(`{"b":1,"a":2}`))))
+}
+
+func TestFormatterGrpc(t *testing.T) {
+	inputFormatter, outputFormatter := FormatterForRelayRequestAndResponse(spectypes.APIInterfaceGrpc)
+	encode := func(fields ...func([]byte) []byte) []byte {
+		data := []byte{}
+		for _, field := range fields {
+			data = field(data)
+		}
+		return data
+	}
+	address := func(data []byte) []byte {
+		data = protowire.AppendTag(data, 1, protowire.BytesType)
+		return protowire.AppendString(data, "lava@address")
+	}
+	denom := func(value string) func([]byte) []byte {
+		return func(data []byte) []byte {
+			data = protowire.AppendTag(data, 2, protowire.BytesType)
+			return protowire.AppendString(data, value)
+		}
+	}
+	height := func(data []byte) []byte {
+		data = protowire.AppendTag(data, 3, protowire.VarintType)
+		return protowire.AppendVarint(data, 100)
+	}
+	require.Equal(t, inputFormatter(encode(address, denom("ulava"), height)), inputFormatter(encode(height, denom("ulava"), address)))
+	// repeated fields keep their order
+	require.NotEqual(t, inputFormatter(encode(address, denom("a"), denom("b"))), inputFormatter(encode(denom("b"), address, denom("a"))))
+	require.Equal(t, inputFormatter(encode(address, denom("a"), denom("b"))), inputFormatter(encode(denom("a"), address, denom("b"))))
+	invalid := []byte{0xff, 0xff}
+	require.Equal(t, invalid, inputFormatter(invalid))
+	reply := encode(height, address)
+	require.Equal(t, reply, outputFormatter(reply))
+}
@@ -0,0 +1,52 @@
+package format
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type protoField struct {
+	number protowire.Number
+	raw    []byte
+}
+
+// grpc requests have no per request fields, the proto encoding is canonicalized so fields encoded in a different order match
+func FormatterForRelayRequestAndResponseGrpc() (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
+	inputFormatter = func(inpData []byte) []byte {
+		return canonicalProto(inpData)
+	}
+	outputFormatter = func(inpData []byte) []byte {
+		return inpData
+	}
+	return inputFormatter, outputFormatter
+}
+
+// orders the top level fields of an encoded proto message by their numbers. the sort is stable so repeated fields and
+// duplicate fields (where the last one wins) keep their order. nested messages can't be told apart from bytes without
+// the descriptor so they are kept as is. data that isn't a valid encoding is returned as is
+func canonicalProto(inpData []byte) []byte {
+	fields := []protoField{}
+	remaining := inpData
+	for len(remaining) > 0 {
+		number, wireType, tagLength := protowire.ConsumeTag(remaining)
+		if tagLength < 0 {
+			return inpData
+		}
+		valueLength := protowire.ConsumeFieldValue(number, wireType, remaining[tagLength:])
+		if valueLength < 0 {
+			return inpData
+		}
+		fields = append(fields, protoField{number: number, raw: remaining[:tagLength+valueLength]})
+		remaining = remaining[tagLength+valueLength:]
+	}
+	if sort.SliceIsSorted(fields, func(i, j int) bool { return fields[i].number < fields[j].number }) {
+		return inpData
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].number < fields[j].number })
+	canonical := make([]byte, 0, len(inpData))
+	for _, field := range fields {
+		canonical = append(canonical, field.raw...)
+	}
+	return canonical
+}
@@ -0,0 +1,53 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// orders the query parameters of the url by their keys, the order of values of a repeated key is kept as it can matter
+func CanonicalQueryUrl(apiUrl string) string {
+	path, rawQuery, found := strings.Cut(apiUrl, "?")
+	if !found {
+		return apiUrl
+	}
+	if rawQuery == "" {
+		return path
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return apiUrl
+	}
+	return path + "?" + query.Encode()
+}
+
+// rest requests have no per request fields, the body of post requests is canonicalized so the order of json keys doesn't matter
+func FormatterForRelayRequestAndResponseRest() (inputFormatter func([]byte) []byte, outputFormatter func([]byte) []byte) {
+	inputFormatter = func(inpData []byte) []byte {
+		return canonicalJson(inpData)
+	}
+	outputFormatter = func(inpData []byte) []byte {
+		return inpData
+	}
+	return inputFormatter, outputFormatter
+}
+
+// returns the json with ordered keys and without whitespace, data that isn't json is returned as is
+func canonicalJson(inpData []byte) []byte {
+	if len(inpData) == 0 {
+		return inpData
+	}
+	decoder := json.NewDecoder(bytes.NewReader(inpData))
+	decoder.UseNumber() // keep big numbers intact
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil || decoder.More() {
+		return inpData
+	}
+	canonical, err := json.Marshal(parsed)
+	if err != nil {
+		return inpData
+	}
+	return canonical
+}
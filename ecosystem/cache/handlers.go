@@ -33,6 +33,7 @@ const (
 
 type RelayerCacheServer struct {
 	pairingtypes.UnimplementedRelayerCacheServer
+	pairingtypes.UnimplementedRelayerCacheAdminServer
 	CacheServer *CacheServer
 	cacheHits   uint64
 	cacheMisses uint64
@@ -83,6 +84,7 @@ func (s *RelayerCacheServer) GetRelay(ctx context.Context, relayCacheGet *pairin
 func (s *RelayerCacheServer) getRelayInner(ctx context.Context, relayCacheGet *pairingtypes.RelayCacheGet) (*pairingtypes.CacheRelayReply, error) {
 	inputFormatter, outputFormatter := format.FormatterForRelayRequestAndResponse(relayCacheGet.Request.ApiInterface)
 	relayCacheGet.Request.Data = inputFormatter(relayCacheGet.Request.Data)
+	relayCacheGet.Request.ApiUrl = format.FormatterForRelayRequestApiUrl(relayCacheGet.Request.ApiInterface)(relayCacheGet.Request.ApiUrl)
 	requestedBlock := relayCacheGet.Request.RequestBlock
 	getLatestBlock := s.getLatestBlock(relayCacheGet.ChainID, relayCacheGet.Provider)
 	relayCacheGet.Request.RequestBlock = lavaprotocol.ReplaceRequestedBlock(requestedBlock, getLatestBlock)
@@ -127,6 +129,7 @@ func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairin
 	// TODO: make this non-blocking
 	inputFormatter, _ := format.FormatterForRelayRequestAndResponse(relayCacheSet.Request.ApiInterface)
 	relayCacheSet.Request.Data = inputFormatter(relayCacheSet.Request.Data) // so we can find the entry regardless of id
+	relayCacheSet.Request.ApiUrl = format.FormatterForRelayRequestApiUrl(relayCacheSet.Request.ApiInterface)(relayCacheSet.Request.ApiUrl)
 
 	cacheKey := formatCacheKey(relayCacheSet.Request.ApiInterface, relayCacheSet.ChainID, relayCacheSet.Request, relayCacheSet.Provider)
 	cacheValue := formatCacheValue(relayCacheSet.Response, relayCacheSet.BlockHash, relayCacheSet.Finalized, relayCacheSet.OptionalMetadata)
@@ -134,18 +137,61 @@ func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairin
 		utils.Attribute{Key: "finalized", Value: fmt.Sprintf("%t", relayCacheSet.Finalized)},
 		utils.Attribute{Key: "response_data", Value: parser.CapStringLen(string(relayCacheSet.Response.Data))},
 		utils.Attribute{Key: "requestHash", Value: string(relayCacheSet.BlockHash)})
+	// purge before setting, the new entry can have the same key as a reorged one
+	s.setLatestBlock(relayCacheSet.ChainID, relayCacheSet.Provider, relayCacheSet.Request.RequestBlock, relayCacheSet.BlockHash)
+	reorged := s.CacheServer.blocks.add(relayCacheSet.ChainID, relayCacheSet.Request.RequestBlock, relayCacheSet.BlockHash, relayCacheSet.Finalized, cacheKey, getMethodFromRelayData(relayCacheSet.Request))
+	if len(reorged) > 0 {
+		utils.LavaFormatInfo("block hash changed, purging reorged entries", utils.Attribute{Key: "chainID", Value: relayCacheSet.ChainID},
+			utils.Attribute{Key: "block", Value: relayCacheSet.Request.RequestBlock},
+			utils.Attribute{Key: "entries", Value: len(reorged)})
+		s.deleteEntries(reorged)
+	}
 	// finalized entries can stay there
 	if relayCacheSet.Finalized {
 		cache := s.CacheServer.finalizedCache
 		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.CacheServer.ExpirationFinalized)
+		if s.CacheServer.Storage != nil {
+			index := StorageIndex{ChainID: relayCacheSet.ChainID, Height: relayCacheSet.Request.RequestBlock, Method: getMethodFromRelayData(relayCacheSet.Request)}
+			err := s.CacheServer.Storage.Set(cacheKey, cacheValue, index, s.CacheServer.ExpirationStorage)
+			if err != nil {
+				utils.LavaFormatWarning("failed storing finalized entry", err, utils.Attribute{Key: "cacheKey", Value: parser.CapStringLen(cacheKey)})
+			}
+		}
 	} else {
 		cache := s.CacheServer.tempCache
 		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.getExpirationForChain(relayCacheSet.ChainID, relayCacheSet.BlockHash))
 	}
-	s.setLatestBlock(relayCacheSet.ChainID, relayCacheSet.Provider, relayCacheSet.Request.RequestBlock)
 	return &emptypb.Empty{}, nil
 }
 
+// removes the entries matching the chain, the block range and the method from all the tiers.
+// only the heights still tracked by the block index can be invalidated, older entries expire normally
+func (s *RelayerCacheServer) InvalidateRelays(ctx context.Context, invalidate *pairingtypes.RelayCacheInvalidate) (*pairingtypes.RelayCacheInvalidateReply, error) {
+	if invalidate.ToBlock > 0 && invalidate.FromBlock > invalidate.ToBlock {
+		return nil, utils.LavaFormatWarning("invalid relay cache invalidate range", nil, utils.Attribute{Key: "fromBlock", Value: invalidate.FromBlock}, utils.Attribute{Key: "toBlock", Value: invalidate.ToBlock})
+	}
+	keys := s.CacheServer.blocks.invalidate(invalidate.ChainID, invalidate.FromBlock, invalidate.ToBlock, invalidate.Method)
+	s.deleteEntries(keys)
+	utils.LavaFormatInfo("invalidated cache entries", utils.Attribute{Key: "chainID", Value: invalidate.ChainID},
+		utils.Attribute{Key: "fromBlock", Value: invalidate.FromBlock},
+		utils.Attribute{Key: "toBlock", Value: invalidate.ToBlock},
+		utils.Attribute{Key: "method", Value: invalidate.Method},
+		utils.Attribute{Key: "entries", Value: len(keys)})
+	return &pairingtypes.RelayCacheInvalidateReply{Invalidated: uint64(len(keys))}, nil
+}
+
+func (s *RelayerCacheServer) deleteEntries(keys []string) {
+	for _, key := range keys {
+		s.CacheServer.tempCache.Del(key)
+		s.CacheServer.finalizedCache.Del(key)
+		if s.CacheServer.Storage != nil {
+			if err := s.CacheServer.Storage.Delete(key); err != nil {
+				utils.LavaFormatWarning("failed deleting entry from storage", err, utils.Attribute{Key: "cacheKey", Value: parser.CapStringLen(key)})
+			}
+		}
+	}
+}
+
 func (s *RelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*pairingtypes.CacheUsage, error) {
 	cacheHits := atomic.LoadUint64(&s.cacheHits)
 	cacheMisses := atomic.LoadUint64(&s.cacheMisses)
@@ -193,10 +239,18 @@ func (s *RelayerCacheServer) getLatestBlock(chainID string, providerAddr string)
 	return spectypes.NOT_APPLICABLE
 }
 
-func (s *RelayerCacheServer) setLatestBlock(chainID string, providerAddr string, latestBlock int64) {
+func (s *RelayerCacheServer) setLatestBlock(chainID string, providerAddr string, latestBlock int64, blockHash []byte) {
 	existingLatest, _ := s.getLatestBlockInner(chainID, providerAddr) // we need to bypass the expirationTimeCheck
 
 	if existingLatest <= latestBlock { // equal refreshes latest if it expired
+		// a different hash for the latest block means the chain reorged from it, the entries from that height on are stale
+		reorged := s.CacheServer.blocks.setLatest(chainID, latestBlock, blockHash)
+		if len(reorged) > 0 {
+			utils.LavaFormatInfo("latest block hash changed, purging reorged entries", utils.Attribute{Key: "chainID", Value: chainID},
+				utils.Attribute{Key: "block", Value: latestBlock},
+				utils.Attribute{Key: "entries", Value: len(reorged)})
+			s.deleteEntries(reorged)
+		}
 		// we are setting this with a futuristic invalidation time, we still want the entry in cache to protect us from putting a lower last block
 		cacheStore := LastestCacheStore{latestBlock: latestBlock, latestExpirationTime: time.Now().Add(DefaultExpirationForNonFinalized)}
 		utils.LavaFormatDebug("setting latest block", utils.Attribute{Key: "providerAddr", Value: providerAddr}, utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "latestBlock", Value: latestBlock})
@@ -255,7 +309,11 @@ func (s *RelayerCacheServer) findInAllCaches(finalized bool, cacheKey string) (r
 
 	value, cacheSource, found := inner(finalized, cacheKey)
 	if !found {
-		return CacheValue{}, "", false
+		if !finalized {
+			// only finalized entries are stored, a miss on a non finalized request doesn't need a disk read
+			return CacheValue{}, "", false
+		}
+		return s.findInStorage(cacheKey)
 	}
 	if cacheVal, ok := value.(CacheValue); ok {
 		return cacheVal, cacheSource, true
@@ -264,6 +322,23 @@ func (s *RelayerCacheServer) findInAllCaches(finalized bool, cacheKey string) (r
 	return CacheValue{}, "", false
 }
 
+// the storage is the last tier, entries found in it are added back to the memory cache
+func (s *RelayerCacheServer) findInStorage(cacheKey string) (retVal CacheValue, cacheSource string, found bool) {
+	if s.CacheServer.Storage == nil {
+		return CacheValue{}, "", false
+	}
+	cacheVal, found, err := s.CacheServer.Storage.Get(cacheKey)
+	if err != nil {
+		utils.LavaFormatWarning("failed reading entry from storage", err, utils.Attribute{Key: "cacheKey", Value: parser.CapStringLen(cacheKey)})
+		return CacheValue{}, "", false
+	}
+	if !found {
+		return CacheValue{}, "", false
+	}
+	s.CacheServer.finalizedCache.SetWithTTL(cacheKey, cacheVal, cacheVal.Cost(), s.CacheServer.ExpirationFinalized)
+	return cacheVal, "storage", true
+}
+
 func formatCacheKey(apiInterface string, chainID string, request *pairingtypes.RelayPrivateData, provider string) string {
 	return chainID + SEP + usedFieldsFromRequest(request, provider)
 }
@@ -301,11 +376,15 @@ func latestBlockKey(chainID string, providerAddr string) string {
 }
 
 func getMethodFromRequest(relayCacheGet *pairingtypes.RelayCacheGet) string {
-	if relayCacheGet.Request.ApiUrl != "" {
-		return relayCacheGet.Request.ApiUrl
+	return getMethodFromRelayData(relayCacheGet.Request)
+}
+
+func getMethodFromRelayData(request *pairingtypes.RelayPrivateData) string {
+	if request.ApiUrl != "" {
+		return request.ApiUrl
 	}
 	var msg rpcInterfaceMessages.JsonrpcMessage
-	err := json.Unmarshal(relayCacheGet.Request.Data, &msg)
+	err := json.Unmarshal(request.Data, &msg)
 	if err != nil {
 		return "failed_parsing_method"
 	}
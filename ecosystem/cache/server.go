@@ -24,8 +24,12 @@ const (
 	ExpirationNonFinalizedFlagName             = "expiration-non-finalized"
 	FlagCacheSizeName                          = "max-items"
 	FlagUseMethodInApiSpecificCacheMetricsName = "use-method-in-cache-metrics"
+	FlagStoragePathName                        = "storage-path"
+	FlagAdminListenAddressName                 = "admin-listen-address"
+	ExpirationStorageFlagName                  = "expiration-storage"
 	DefaultExpirationForNonFinalized           = 500 * time.Millisecond
 	DefaultExpirationTimeFinalized             = time.Hour
+	DefaultExpirationStorage                   = 30 * 24 * time.Hour
 	CacheNumCounters                           = 100000000 // expect 10M items
 )
 
@@ -36,6 +40,9 @@ type CacheServer struct {
 	ExpirationNonFinalized time.Duration
 	CacheMetrics           *CacheMetrics
 	CacheMaxCost           int64
+	Storage                Storage // persistent tier for finalized entries, the memory caches are in front of it, nil disables it
+	ExpirationStorage      time.Duration
+	blocks                 *blockIndex
 }
 
 func (cs *CacheServer) InitCache(ctx context.Context, expiration time.Duration, expirationNonFinalized time.Duration, metricsAddr string, useMethodInApiSpecificMetric bool) {
@@ -52,13 +59,48 @@ func (cs *CacheServer) InitCache(ctx context.Context, expiration time.Duration,
 		utils.LavaFormatFatal("could not create finalized cache", err)
 	}
 	cs.finalizedCache = cache
+	cs.blocks = newBlockIndex(DefaultMaxTrackedHeights)
 
 	// initialize prometheus
 	cs.CacheMetrics = NewCacheMetricsServer(metricsAddr, useMethodInApiSpecificMetric)
 }
 
+// tracks the entries kept in the storage from previous runs so they can be invalidated, must be called after InitCache
+func (cs *CacheServer) LoadStorageIndex() error {
+	if cs.Storage == nil {
+		return nil
+	}
+	loaded := 0
+	err := cs.Storage.LoadIndex(func(key string, index StorageIndex) {
+		// stored entries are finalized, their hash is not stored
+		cs.blocks.add(index.ChainID, index.Height, nil, true, key, index.Method)
+		loaded++
+	})
+	utils.LavaFormatInfo("loaded storage index", utils.Attribute{Key: "entries", Value: loaded})
+	return err
+}
+
+// serves the admin service on its own listener, it can delete entries so it is not exposed on the relays listener
+func (cs *CacheServer) serveAdmin(ctx context.Context, adminListenAddr string, server *RelayerCacheServer) {
+	lis, err := net.Listen("tcp", adminListenAddr)
+	if err != nil {
+		utils.LavaFormatFatal("cache server failure setting up admin listener", err, utils.Attribute{Key: "adminListenAddr", Value: adminListenAddr})
+	}
+	s := grpc.NewServer()
+	pairingtypes.RegisterRelayerCacheAdminServer(s, server)
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+	_ = utils.LavaFormatInfo("Cache Admin Server listening", utils.Attribute{Key: "Address", Value: lis.Addr().String()})
+	if err := s.Serve(lis); err != nil {
+		utils.LavaFormatError("cache admin failed to serve", err, utils.Attribute{Key: "Address", Value: lis.Addr().String()})
+	}
+}
+
 func (cs *CacheServer) Serve(ctx context.Context,
 	listenAddr string,
+	adminListenAddr string,
 ) {
 	ctx, cancel := context.WithCancel(ctx)
 	signalChan := make(chan os.Signal, 1)
@@ -105,11 +147,19 @@ func (cs *CacheServer) Serve(ctx context.Context,
 	Server := &RelayerCacheServer{CacheServer: cs}
 
 	pairingtypes.RegisterRelayerCacheServer(s, Server)
+	if adminListenAddr != "" {
+		go cs.serveAdmin(ctx, adminListenAddr, Server)
+	}
 
 	_ = utils.LavaFormatInfo("Cache Server listening", utils.Attribute{Key: "Address", Value: lis.Addr().String()})
 	if err := httpServer.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
 		utils.LavaFormatFatal("cache failed to serve", err, utils.Attribute{Key: "Address", Value: lis.Addr().String()})
 	}
+	if cs.Storage != nil {
+		if err := cs.Storage.Close(); err != nil {
+			utils.LavaFormatError("failed closing cache storage", err)
+		}
+	}
 }
 
 func (cs *CacheServer) ExpirationForChain(chainID string) time.Duration {
@@ -144,7 +194,33 @@ func Server(
 		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagUseMethodInApiSpecificCacheMetricsName})
 	}
 
+	storagePath, err := flags.GetString(FlagStoragePathName)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagStoragePathName})
+	}
+	if storagePath != "" {
+		expirationStorage, err := flags.GetDuration(ExpirationStorageFlagName)
+		if err != nil {
+			utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: ExpirationStorageFlagName})
+		}
+		storage, err := NewLocalStorage(storagePath)
+		if err != nil {
+			utils.LavaFormatFatal("failed to open cache storage", err, utils.Attribute{Key: "path", Value: storagePath})
+		}
+		cs.Storage = storage
+		cs.ExpirationStorage = expirationStorage
+		utils.LavaFormatInfo("storing finalized entries on disk", utils.Attribute{Key: "path", Value: storagePath})
+	}
+
+	adminListenAddr, err := flags.GetString(FlagAdminListenAddressName)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: FlagAdminListenAddressName})
+	}
+
 	cs.InitCache(ctx, expiration, expirationNonFinalized, metricsAddr, useMethodInApiSpecificMetric)
+	if err := cs.LoadStorageIndex(); err != nil {
+		utils.LavaFormatError("failed loading the storage index, stored entries can't be invalidated", err)
+	}
 	// TODO: have a state tracker
-	cs.Serve(ctx, listenAddr)
+	cs.Serve(ctx, listenAddr, adminListenAddr)
 }
@@ -0,0 +1,249 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/lavanet/lava/protocol/parser"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// Storage keeps finalized entries behind the memory cache, finalized data never changes
+// so entries stored in a persistent storage are still valid after the cache restarts
+type Storage interface {
+	Get(key string) (value CacheValue, found bool, err error)
+	Set(key string, value CacheValue, index StorageIndex, ttl time.Duration) error
+	Delete(key string) error
+	// calls load with the index of every stored entry, used to track the stored entries for invalidation after a restart
+	LoadIndex(load func(key string, index StorageIndex)) error
+	Close() error
+}
+
+// the block index fields of a stored entry
+type StorageIndex struct {
+	ChainID string `json:"chainID"`
+	Height  int64  `json:"height"`
+	Method  string `json:"method"`
+}
+
+// the index of an entry is stored next to it under this prefix, cache keys start with a chain id so they can't collide
+const storageIndexPrefix = "\x00index" + SEP
+
+const storageWriteQueueSize = 10000
+
+var StorageWriteQueueFullError = sdkerrors.New("Storage write queue full", 4, "too many entries are waiting to be written to the storage")
+
+// a set or a delete waiting to be written, deletes are queued with the sets so they are applied in order
+type storageWrite struct {
+	key   string
+	data  []byte // nil for a delete
+	index []byte
+	ttl   time.Duration
+}
+
+// BadgerStorage writes in the background so relays don't wait for the disk, queued writes are applied in batches
+type BadgerStorage struct {
+	db      *badger.DB
+	once    sync.Once
+	writes  chan storageWrite
+	closing chan struct{}
+	closed  chan struct{}
+}
+
+var _ Storage = (*BadgerStorage)(nil)
+
+func (bs *BadgerStorage) Get(key string) (value CacheValue, found bool, err error) {
+	var data []byte
+	err = bs.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(key))
+		if err != nil {
+			return err
+		}
+		data, err = item.ValueCopy(nil)
+		return err
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return CacheValue{}, false, nil
+	}
+	if err != nil {
+		return CacheValue{}, false, err
+	}
+	stored := pairingtypes.CacheRelayReply{}
+	err = stored.Unmarshal(data)
+	if err != nil {
+		return CacheValue{}, false, err
+	}
+	value = CacheValue{OptionalMetadata: stored.OptionalMetadata}
+	if stored.Reply != nil {
+		value.Response = *stored.Reply
+	}
+	return value, true, nil
+}
+
+// the hash is not stored, it is only used by non finalized entries
+func (bs *BadgerStorage) Set(key string, value CacheValue, index StorageIndex, ttl time.Duration) error {
+	stored := value.ToCacheReply()
+	data, err := stored.Marshal()
+	if err != nil {
+		return err
+	}
+	indexData, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if bs.isClosing() {
+		return badger.ErrDBClosed
+	}
+	select {
+	case bs.writes <- storageWrite{key: key, data: data, index: indexData, ttl: ttl}:
+		return nil
+	default:
+		// the entry is still in the memory cache, it's dropped from the storage rather than blocking the relay
+		return StorageWriteQueueFullError
+	}
+}
+
+// deletes wait for room in the queue, an invalidated entry must not stay in the storage
+func (bs *BadgerStorage) Delete(key string) error {
+	if bs.isClosing() {
+		return badger.ErrDBClosed
+	}
+	select {
+	case bs.writes <- storageWrite{key: key}:
+		return nil
+	case <-bs.closing:
+		return badger.ErrDBClosed
+	}
+}
+
+func (bs *BadgerStorage) isClosing() bool {
+	select {
+	case <-bs.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+func (bs *BadgerStorage) LoadIndex(load func(key string, index StorageIndex)) error {
+	return bs.db.View(func(txn *badger.Txn) error {
+		options := badger.DefaultIteratorOptions
+		options.Prefix = []byte(storageIndexPrefix)
+		iterator := txn.NewIterator(options)
+		defer iterator.Close()
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			key := strings.TrimPrefix(string(item.Key()), storageIndexPrefix)
+			err := item.Value(func(data []byte) error {
+				index := StorageIndex{}
+				if err := json.Unmarshal(data, &index); err != nil {
+					return err
+				}
+				load(key, index)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// writes the queued entries before closing the database
+func (bs *BadgerStorage) Close() (err error) {
+	bs.once.Do(func() {
+		close(bs.closing)
+		<-bs.closed
+		err = bs.db.Close()
+	})
+	return err
+}
+
+func (bs *BadgerStorage) writeLoop() {
+	defer close(bs.closed)
+	for {
+		select {
+		case write := <-bs.writes:
+			bs.writeBatch(write)
+		case <-bs.closing:
+			for {
+				select {
+				case write := <-bs.writes:
+					bs.writeBatch(write)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// applies the given write together with the writes already queued behind it
+func (bs *BadgerStorage) writeBatch(write storageWrite) {
+	batch := bs.db.NewWriteBatch()
+	defer batch.Cancel()
+	for count := 1; ; count++ {
+		var err error
+		if write.data == nil {
+			err = batch.Delete([]byte(write.key))
+			if err == nil {
+				err = batch.Delete([]byte(storageIndexPrefix + write.key))
+			}
+		} else {
+			err = batch.SetEntry(storageEntry(write.key, write.data, write.ttl))
+			if err == nil {
+				err = batch.SetEntry(storageEntry(storageIndexPrefix+write.key, write.index, write.ttl))
+			}
+		}
+		if err != nil {
+			utils.LavaFormatWarning("failed writing entry to storage", err, utils.Attribute{Key: "cacheKey", Value: parser.CapStringLen(write.key)})
+		}
+		if count == storageWriteQueueSize || len(bs.writes) == 0 {
+			break
+		}
+		write = <-bs.writes
+	}
+	if err := batch.Flush(); err != nil {
+		utils.LavaFormatWarning("failed flushing entries to storage", err)
+	}
+}
+
+func storageEntry(key string, data []byte, ttl time.Duration) *badger.Entry {
+	entry := badger.NewEntry([]byte(key), data)
+	if ttl > 0 {
+		entry = entry.WithTTL(ttl)
+	}
+	return entry
+}
+
+func newBadgerStorage(db *badger.DB) *BadgerStorage {
+	bs := &BadgerStorage{db: db, writes: make(chan storageWrite, storageWriteQueueSize), closing: make(chan struct{}), closed: make(chan struct{})}
+	go bs.writeLoop()
+	return bs
+}
+
+// a storage on the local disk, entries in it survive restarts
+func NewLocalStorage(storagePath string) (*BadgerStorage, error) {
+	options := badger.DefaultOptions(storagePath)
+	options.Logger = nil
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, err
+	}
+	return newBadgerStorage(db), nil
+}
+
+func NewMemoryStorage() (*BadgerStorage, error) {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLogger(nil))
+	if err != nil {
+		return nil, err
+	}
+	return newBadgerStorage(db), nil
+}
@@ -2,8 +2,11 @@ package chainlib
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -11,6 +14,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/chaintracker"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/parser"
@@ -298,6 +303,107 @@ func (cf *ChainFetcher) FetchBlockHashByNum(ctx context.Context, blockNum int64)
 	return res, nil
 }
 
+// subscribes to the node's new heads over websocket, the chain tracker uses it to avoid polling the latest block
+func (cf *ChainFetcher) SubscribeNewHeads(ctx context.Context) (<-chan int64, <-chan error, error) {
+	var data []byte
+	switch cf.endpoint.ApiInterface {
+	case spectypes.APIInterfaceJsonRPC:
+		data = []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`)
+	case spectypes.APIInterfaceTendermintRPC:
+		data = []byte(`{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"query":"tm.event='NewBlock'"}}`)
+	default:
+		return nil, nil, chaintracker.HeadsSubscriptionUnsupportedError.Wrapf("api interface %s", cf.endpoint.ApiInterface)
+	}
+	// subscription apis live in the same collection as the latest block api
+	_, collectionData, ok := cf.chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCKNUM)
+	if !ok {
+		return nil, nil, chaintracker.HeadsSubscriptionUnsupportedError.Wrapf("%s tag function not found", spectypes.FUNCTION_TAG_GET_BLOCKNUM.String())
+	}
+	chainMessage, err := CraftChainMessage(nil, collectionData.Type, cf.chainParser, &CraftData{Data: data, ConnectionType: collectionData.Type}, cf.ChainFetcherMetadata())
+	if err != nil {
+		return nil, nil, chaintracker.HeadsSubscriptionUnsupportedError.Wrapf("failed creating subscription message: %s", err.Error())
+	}
+	notifications := make(chan interface{})
+	_, _, sub, _, _, err := cf.chainRouter.SendNodeMsg(ctx, notifications, chainMessage, nil)
+	if err != nil {
+		if errors.Is(err, rpcclient.ErrNotificationsUnsupported) {
+			return nil, nil, chaintracker.HeadsSubscriptionUnsupportedError.Wrapf("node url %s", err.Error())
+		}
+		return nil, nil, err
+	}
+	if sub == nil {
+		return nil, nil, utils.LavaFormatWarning("node did not return a heads subscription", nil, utils.LogAttr("chainID", cf.endpoint.ChainID), utils.LogAttr("APIInterface", cf.endpoint.ApiInterface))
+	}
+	heads := make(chan int64)
+	errs := make(chan error, 1)
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-sub.Err():
+				errs <- err
+				return
+			case notification := <-notifications:
+				msg, ok := notification.(*rpcclient.JsonrpcMessage)
+				if !ok {
+					continue
+				}
+				blockNum, err := parseNewHeadNotification(msg)
+				if err != nil {
+					utils.LavaFormatDebug("failed parsing new head notification", utils.LogAttr("error", err), utils.LogAttr("notification", msg.String()))
+					continue
+				}
+				atomic.StoreInt64(&cf.latestBlock, blockNum)
+				select {
+				case heads <- blockNum:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return heads, errs, nil
+}
+
+// reads the block height from an ethereum newHeads or a tendermint NewBlock notification
+func parseNewHeadNotification(msg *rpcclient.JsonrpcMessage) (int64, error) {
+	result := msg.Result
+	if len(msg.Params) > 0 {
+		// ethereum notifications carry the head in params.result
+		var params struct {
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return 0, err
+		}
+		result = params.Result
+	}
+	var head struct {
+		Number string `json:"number"`
+		Data   struct {
+			Value struct {
+				Block struct {
+					Header struct {
+						Height string `json:"height"`
+					} `json:"header"`
+				} `json:"block"`
+			} `json:"value"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(result, &head); err != nil {
+		return 0, err
+	}
+	if head.Number != "" {
+		return strconv.ParseInt(strings.TrimPrefix(head.Number, "0x"), 16, 64)
+	}
+	if height := head.Data.Value.Block.Header.Height; height != "" {
+		return strconv.ParseInt(height, 10, 64)
+	}
+	return 0, fmt.Errorf("missing block height in notification %s", string(result))
+}
+
 type ChainFetcherOptions struct {
 	ChainRouter ChainRouter
 	ChainParser ChainParser
@@ -0,0 +1,51 @@
+package chainlib
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNewHeadNotification(t *testing.T) {
+	playbook := []struct {
+		name     string
+		msg      *rpcclient.JsonrpcMessage
+		expected int64
+		valid    bool
+	}{
+		{
+			name:     "ethereum newHeads",
+			msg:      &rpcclient.JsonrpcMessage{Method: "eth_subscription", Params: []byte(`{"subscription":"0x9ce59a13059e417087c02d3236a0b1cc","result":{"number":"0x1b4","hash":"0xdc0818cf"}}`)},
+			expected: 436,
+			valid:    true,
+		},
+		{
+			name:     "tendermint NewBlock",
+			msg:      &rpcclient.JsonrpcMessage{ID: []byte("1"), Result: []byte(`{"query":"tm.event='NewBlock'","data":{"type":"tendermint/event/NewBlock","value":{"block":{"header":{"height":"12345"}}}}}`)},
+			expected: 12345,
+			valid:    true,
+		},
+		{
+			name:  "missing height",
+			msg:   &rpcclient.JsonrpcMessage{ID: []byte("1"), Result: []byte(`{"query":"tm.event='NewBlock'","data":{}}`)},
+			valid: false,
+		},
+		{
+			name:  "invalid number",
+			msg:   &rpcclient.JsonrpcMessage{Method: "eth_subscription", Params: []byte(`{"subscription":"0x9c","result":{"number":"0xzz"}}`)},
+			valid: false,
+		},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			blockNum, err := parseNewHeadNotification(play.msg)
+			if play.valid {
+				require.NoError(t, err)
+				require.Equal(t, play.expected, blockNum)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/parser"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
@@ -45,6 +46,14 @@ func (pm baseChainMessageContainer) GetApi() *spectypes.Api {
 	return pm.api
 }
 
+func (pm baseChainMessageContainer) GetRPCInput() parser.RPCInput {
+	rpcInput, ok := pm.msg.(parser.RPCInput)
+	if !ok {
+		return nil
+	}
+	return rpcInput
+}
+
 func (pm baseChainMessageContainer) GetApiCollection() *spectypes.ApiCollection {
 	return pm.apiCollection
 }
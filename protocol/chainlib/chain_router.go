@@ -2,7 +2,11 @@ package chainlib
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
@@ -11,9 +15,28 @@ import (
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
+var (
+	// consecutive node connection errors or timeouts after which a node is ejected from the routing
+	NodeErrorsForEjection int64 = 3
+	// an ejected node gets relays again after this time, or earlier if all the other nodes failed
+	NodeEjectionTime = 30 * time.Second
+)
+
+// tracks the load and health of a chain proxy, shared by all the router entries of the proxy
+type proxyHealth struct {
+	outstanding       atomic.Int64
+	consecutiveErrors atomic.Int64
+	ejectedUntil      atomic.Int64 // unix nano
+}
+
+func (ph *proxyHealth) isEjected(now time.Time) bool {
+	return ph.ejectedUntil.Load() > now.UnixNano()
+}
+
 type chainRouterEntry struct {
 	ChainProxy
 	addonsSupported map[string]struct{}
+	health          *proxyHealth
 }
 
 func (cre *chainRouterEntry) isSupporting(addon string) bool {
@@ -26,46 +49,101 @@ func (cre *chainRouterEntry) isSupporting(addon string) bool {
 	return false
 }
 
+func (cre *chainRouterEntry) sendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	cre.health.outstanding.Add(1)
+	defer cre.health.outstanding.Add(-1)
+	relayReply, subscriptionID, relayReplyServer, err = cre.ChainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	if err == nil {
+		cre.health.consecutiveErrors.Store(0)
+		cre.health.ejectedUntil.Store(0)
+		return relayReply, subscriptionID, relayReplyServer, err
+	}
+	// a relay the consumer stopped waiting for doesn't tell anything about the node
+	if isNodeConnectionError(err) && ctx.Err() == nil {
+		if cre.health.consecutiveErrors.Add(1) >= NodeErrorsForEjection {
+			if !cre.health.isEjected(time.Now()) {
+				nodeUrl, chainID := cre.GetChainProxyInformation()
+				utils.LavaFormatWarning("ejecting node after consecutive errors", err, utils.LogAttr("nodeUrl", nodeUrl.UrlStr()), utils.LogAttr("chainID", chainID), utils.LogAttr("ejectionTime", NodeEjectionTime))
+			}
+			cre.health.ejectedUntil.Store(time.Now().Add(NodeEjectionTime).UnixNano())
+		}
+	}
+	return relayReply, subscriptionID, relayReplyServer, err
+}
+
 type chainRouterImpl struct {
 	lock             *sync.RWMutex
 	chainProxyRouter map[lavasession.RouterKey][]chainRouterEntry
+	nextEntry        *atomic.Uint64 // rotates the order of equally loaded entries
 }
 
-func (cri *chainRouterImpl) getChainProxySupporting(addon string, extensions []string) (ChainProxy, error) {
+// returns all the entries supporting the addon and extensions, ordered by preference: nodes that are not ejected first,
+// then the least outstanding relays, equally loaded nodes are taken in round robin
+func (cri *chainRouterImpl) getChainProxiesSupporting(addon string, extensions []string) ([]chainRouterEntry, error) {
 	cri.lock.RLock()
 	defer cri.lock.RUnlock()
 	wantedRouterKey := lavasession.NewRouterKey(extensions)
 	if chainProxyEntries, ok := cri.chainProxyRouter[wantedRouterKey]; ok {
+		supporting := []chainRouterEntry{}
 		for _, chainRouterEntry := range chainProxyEntries {
 			if chainRouterEntry.isSupporting(addon) {
-				return chainRouterEntry.ChainProxy, nil
+				supporting = append(supporting, chainRouterEntry)
+				continue
 			}
 			if debug {
 				utils.LavaFormatDebug("chainProxy supporting extensions but not supporting addon", utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "wantedRouterKey", Value: wantedRouterKey})
 			}
 		}
-		// no support for this addon
-		return nil, utils.LavaFormatError("no chain proxy supporting requested addon", nil, utils.Attribute{Key: "addon", Value: addon})
+		if len(supporting) == 0 {
+			// no support for this addon
+			return nil, utils.LavaFormatError("no chain proxy supporting requested addon", nil, utils.Attribute{Key: "addon", Value: addon})
+		}
+		return orderByHealthAndLoad(supporting, cri.nextEntry.Add(1), time.Now()), nil
 	}
 	// no support for these extensions
 	return nil, utils.LavaFormatError("no chain proxy supporting requested extensions", nil, utils.Attribute{Key: "extensions", Value: extensions})
 }
 
+func orderByHealthAndLoad(entries []chainRouterEntry, rotation uint64, now time.Time) []chainRouterEntry {
+	ordered := make([]chainRouterEntry, 0, len(entries))
+	start := int(rotation % uint64(len(entries)))
+	ordered = append(ordered, entries[start:]...)
+	ordered = append(ordered, entries[:start]...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ejectedI, ejectedJ := ordered[i].health.isEjected(now), ordered[j].health.isEjected(now)
+		if ejectedI != ejectedJ {
+			return !ejectedI
+		}
+		return ordered[i].health.outstanding.Load() < ordered[j].health.outstanding.Load()
+	})
+	return ordered
+}
+
 func (cri chainRouterImpl) ExtensionsSupported(extensions []string) bool {
 	routerKey := lavasession.NewRouterKey(extensions)
 	_, ok := cri.chainProxyRouter[routerKey]
 	return ok
 }
 
+// sends the message to the preferred node supporting it, when the node fails on the connection or times out
+// the relay is retried on the next node. subscriptions and stateful apis (e.g. sending a transaction) are not retried
+// as the failed node could have already executed them
 func (cri chainRouterImpl) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
 	// add the parsed addon from the apiCollection
 	addon := chainMessage.GetApiCollection().CollectionData.AddOn
-	selectedChainProxy, err := cri.getChainProxySupporting(addon, extensions)
+	chainRouterEntries, err := cri.getChainProxiesSupporting(addon, extensions)
 	if err != nil {
 		return nil, "", nil, common.NodeUrl{}, "", err
 	}
-	relayReply, subscriptionID, relayReplyServer, err = selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
-	proxyUrl, chainId = selectedChainProxy.GetChainProxyInformation()
+	retriable := ch == nil && chainMessage.GetApi().Category.Stateful == 0
+	for idx, selectedEntry := range chainRouterEntries {
+		relayReply, subscriptionID, relayReplyServer, err = selectedEntry.sendNodeMsg(ctx, ch, chainMessage)
+		proxyUrl, chainId = selectedEntry.GetChainProxyInformation()
+		if err == nil || !retriable || ctx.Err() != nil || !isNodeConnectionError(err) || idx == len(chainRouterEntries)-1 {
+			break
+		}
+		utils.LavaFormatDebug("node failed sending relay, retrying on another node", utils.LogAttr("error", err), utils.LogAttr("nodeUrl", proxyUrl.UrlStr()), utils.LogAttr("GUID", ctx))
+	}
 	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
 }
 
@@ -89,6 +167,44 @@ func batchNodeUrlsByServices(rpcProviderEndpoint lavasession.RPCProviderEndpoint
 	return returnedBatch
 }
 
+// node urls of a batch with the same internal path and protocol are replicas of each other
+func nodeUrlRole(nodeUrl common.NodeUrl) string {
+	websocket := strings.HasPrefix(nodeUrl.Url, "ws://") || strings.HasPrefix(nodeUrl.Url, "wss://")
+	if websocket {
+		return nodeUrl.InternalPath + ";ws"
+	}
+	return nodeUrl.InternalPath + ";http"
+}
+
+// splits the node urls of a batch to equivalent endpoints, each with one url of every role.
+// roles with fewer urls than others have their urls reused by several endpoints
+func splitNodeUrlsToReplicas(rpcProviderEndpoint lavasession.RPCProviderEndpoint) []lavasession.RPCProviderEndpoint {
+	roles := []string{}
+	urlsPerRole := map[string][]common.NodeUrl{}
+	replicasCount := 0
+	for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+		role := nodeUrlRole(nodeUrl)
+		if _, ok := urlsPerRole[role]; !ok {
+			roles = append(roles, role)
+		}
+		urlsPerRole[role] = append(urlsPerRole[role], nodeUrl)
+		if len(urlsPerRole[role]) > replicasCount {
+			replicasCount = len(urlsPerRole[role])
+		}
+	}
+	replicas := make([]lavasession.RPCProviderEndpoint, 0, replicasCount)
+	for idx := 0; idx < replicasCount; idx++ {
+		replica := rpcProviderEndpoint
+		replica.NodeUrls = make([]common.NodeUrl, 0, len(roles))
+		for _, role := range roles {
+			roleUrls := urlsPerRole[role]
+			replica.NodeUrls = append(replica.NodeUrls, roleUrls[idx%len(roleUrls)])
+		}
+		replicas = append(replicas, replica)
+	}
+	return replicas
+}
+
 func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavasession.RPCProviderEndpoint, chainParser ChainParser, proxyConstructor func(context.Context, uint, lavasession.RPCProviderEndpoint, ChainParser) (ChainProxy, error)) (ChainRouter, error) {
 	chainProxyRouter := map[lavasession.RouterKey][]chainRouterEntry{}
 
@@ -117,19 +233,28 @@ func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavase
 			return allExtensionsRouterKey
 		}
 		routerKey := updateRouteCombinations(extensions, addons)
-		chainProxy, err := proxyConstructor(ctx, nConns, rpcProviderEndpointEntry, chainParser)
-		if err != nil {
-			// TODO: allow some urls to be down
-			return nil, err
-		}
-		chainRouterEntryInst := chainRouterEntry{
-			ChainProxy:      chainProxy,
-			addonsSupported: addonsSupportedMap,
+		replicas := splitNodeUrlsToReplicas(rpcProviderEndpointEntry)
+		replicasUp := 0
+		for _, replica := range replicas {
+			chainProxy, err := proxyConstructor(ctx, nConns, replica, chainParser)
+			if err != nil {
+				if len(replicas) == 1 {
+					return nil, err
+				}
+				// the other replicas serve the relays
+				utils.LavaFormatError("failed connecting to node replica", err, utils.LogAttr("nodeUrls", replica.NodeUrls))
+				continue
+			}
+			replicasUp++
+			chainRouterEntryInst := chainRouterEntry{
+				ChainProxy:      chainProxy,
+				addonsSupported: addonsSupportedMap,
+				health:          &proxyHealth{},
+			}
+			chainProxyRouter[routerKey] = append(chainProxyRouter[routerKey], chainRouterEntryInst)
 		}
-		if chainRouterEntries, ok := chainProxyRouter[routerKey]; !ok {
-			chainProxyRouter[routerKey] = []chainRouterEntry{chainRouterEntryInst}
-		} else {
-			chainProxyRouter[routerKey] = append(chainRouterEntries, chainRouterEntryInst)
+		if replicasUp == 0 {
+			return nil, utils.LavaFormatError("failed connecting to all node replicas", nil, utils.LogAttr("nodeUrls", rpcProviderEndpointEntry.NodeUrls))
 		}
 	}
 	if len(requiredMap) > len(supportedMap) {
@@ -139,6 +264,7 @@ func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavase
 	cri := chainRouterImpl{
 		lock:             &sync.RWMutex{},
 		chainProxyRouter: chainProxyRouter,
+		nextEntry:        &atomic.Uint64{},
 	}
 	return cri, nil
 }
@@ -2,11 +2,15 @@ package chainlib
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	testcommon "github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -341,3 +345,130 @@ func TestChainRouter(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitNodeUrlsToReplicas(t *testing.T) {
+	endpoint := lavasession.RPCProviderEndpoint{
+		ChainID:      "LAV1",
+		ApiInterface: spectypes.APIInterfaceTendermintRPC,
+		NodeUrls: []common.NodeUrl{
+			{Url: "http://node1:26657"},
+			{Url: "ws://node1:26657/websocket"},
+			{Url: "http://node2:26657"},
+			{Url: "ws://node2:26657/websocket"},
+			{Url: "http://node3:26657"},
+		},
+	}
+	replicas := splitNodeUrlsToReplicas(endpoint)
+	require.Len(t, replicas, 3)
+	urls := [][]string{}
+	for _, replica := range replicas {
+		require.Equal(t, endpoint.ChainID, replica.ChainID)
+		replicaUrls := []string{}
+		for _, nodeUrl := range replica.NodeUrls {
+			replicaUrls = append(replicaUrls, nodeUrl.Url)
+		}
+		urls = append(urls, replicaUrls)
+	}
+	require.Equal(t, [][]string{
+		{"http://node1:26657", "ws://node1:26657/websocket"},
+		{"http://node2:26657", "ws://node2:26657/websocket"},
+		// the websocket urls are reused when there are fewer of them
+		{"http://node3:26657", "ws://node1:26657/websocket"},
+	}, urls)
+
+	// internal paths are separate roles, a single url of each stays a single replica
+	endpoint.NodeUrls = []common.NodeUrl{{Url: "http://node1:8545", InternalPath: ""}, {Url: "http://node1:8545/x", InternalPath: "/x"}}
+	replicas = splitNodeUrlsToReplicas(endpoint)
+	require.Len(t, replicas, 1)
+	require.Len(t, replicas[0].NodeUrls, 2)
+}
+
+type mockChainProxy struct {
+	nodeUrl common.NodeUrl
+	fail    atomic.Bool
+	calls   atomic.Int64
+}
+
+func (mcp *mockChainProxy) GetChainProxyInformation() (common.NodeUrl, string) {
+	return mcp.nodeUrl, "LAV1"
+}
+
+func (mcp *mockChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (*pairingtypes.RelayReply, string, *rpcclient.ClientSubscription, error) {
+	mcp.calls.Add(1)
+	if mcp.fail.Load() {
+		return nil, "", nil, markNodeConnectionError(utils.LavaFormatWarning("Provider Side Failed Sending Message, Reason: Connection refused", nil))
+	}
+	return &pairingtypes.RelayReply{Data: []byte(mcp.nodeUrl.Url)}, "", nil, nil
+}
+
+func TestChainRouterLoadBalancingAndFailover(t *testing.T) {
+	ctx := context.Background()
+	apiInterface := spectypes.APIInterfaceRest
+	chainParser, err := NewChainParser(apiInterface)
+	require.NoError(t, err)
+	spec := testcommon.CreateMockSpec()
+	spec.ApiCollections = []*spectypes.ApiCollection{{Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: apiInterface}}}
+	chainParser.SetSpec(spec)
+
+	proxies := map[string]*mockChainProxy{}
+	proxyConstructor := func(ctx context.Context, nConns uint, endpoint lavasession.RPCProviderEndpoint, chainParser ChainParser) (ChainProxy, error) {
+		proxy := &mockChainProxy{nodeUrl: endpoint.NodeUrls[0]}
+		proxies[endpoint.NodeUrls[0].Url] = proxy
+		return proxy, nil
+	}
+	nodeUrls := []string{"http://node1:1317", "http://node2:1317", "http://node3:1317"}
+	endpoint := lavasession.RPCProviderEndpoint{ChainID: spec.Index, ApiInterface: apiInterface}
+	for _, url := range nodeUrls {
+		endpoint.NodeUrls = append(endpoint.NodeUrls, common.NodeUrl{Url: url})
+	}
+	chainRouter, err := newChainRouter(ctx, 1, endpoint, chainParser, proxyConstructor)
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+	chainMessage := &baseChainMessageContainer{apiCollection: spec.ApiCollections[0], api: &spectypes.Api{Name: "test"}}
+
+	t.Run("relays are spread between the nodes", func(t *testing.T) {
+		for i := 0; i < 30; i++ {
+			_, _, _, _, _, err := chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+			require.NoError(t, err)
+		}
+		for _, url := range nodeUrls {
+			require.Equal(t, int64(10), proxies[url].calls.Load(), url)
+		}
+	})
+
+	t.Run("failed relays are retried and the node is ejected", func(t *testing.T) {
+		proxies[nodeUrls[0]].fail.Store(true)
+		for i := 0; i < 30; i++ {
+			reply, _, _, proxyUrl, _, err := chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+			require.NoError(t, err)
+			require.NotEqual(t, nodeUrls[0], proxyUrl.Url)
+			require.Equal(t, proxyUrl.Url, string(reply.Data))
+		}
+		// after the consecutive errors the failing node doesn't get relays
+		require.Equal(t, int64(10)+NodeErrorsForEjection, proxies[nodeUrls[0]].calls.Load())
+	})
+
+	t.Run("all nodes failing returns the error", func(t *testing.T) {
+		for _, proxy := range proxies {
+			proxy.fail.Store(true)
+		}
+		_, _, _, _, _, err := chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+		require.Error(t, err)
+		require.True(t, isNodeConnectionError(err))
+	})
+
+	t.Run("stateful relays are not retried", func(t *testing.T) {
+		callsBefore := int64(0)
+		for _, proxy := range proxies {
+			callsBefore += proxy.calls.Load()
+		}
+		statefulMessage := &baseChainMessageContainer{apiCollection: spec.ApiCollections[0], api: &spectypes.Api{Name: "sendTx", Category: spectypes.SpecCategory{Stateful: common.CONSISTENCY_SELECT_ALLPROVIDERS}}}
+		_, _, _, _, _, err := chainRouter.SendNodeMsg(ctx, nil, statefulMessage, nil)
+		require.Error(t, err)
+		callsAfter := int64(0)
+		for _, proxy := range proxies {
+			callsAfter += proxy.calls.Load()
+		}
+		require.Equal(t, callsBefore+1, callsAfter)
+	})
+}
@@ -308,6 +308,26 @@ func createAndSetupBaseAppListener(cmdFlags common.ConsumerCmdFlags, healthCheck
 		return c.Next()
 	})
 
+	if cmdFlags.AccessControl.Enabled() {
+		app.Use(func(c *fiber.Ctx) error {
+			if c.Path() == healthCheckPath {
+				return c.Next()
+			}
+			dappID, err := cmdFlags.AccessControl.Authenticate(func(name string) string { return c.Get(name) }, c.OriginalURL(), c.Body())
+			if err != nil {
+				utils.LavaFormatDebug("rejected unauthenticated request", utils.LogAttr("path", c.OriginalURL()), utils.LogAttr("ip", c.IP()), utils.LogAttr("reason", err.Error()))
+				c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+				return c.Status(fiber.StatusUnauthorized).SendString(convertToJsonError(err.Error()))
+			}
+			// the dapp id of the credential replaces the one sent by the client, and the credentials are not relayed
+			for _, header := range common.AccessControlHeaders {
+				c.Request().Header.Del(header)
+			}
+			c.Request().Header.Set("dapp-id", dappID)
+			return c.Next()
+		})
+	}
+
 	app.Get(healthCheckPath, func(fiberCtx *fiber.Ctx) error {
 		if healthReporter.IsHealthy() {
 			fiberCtx.Status(http.StatusOK)
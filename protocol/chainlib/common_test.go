@@ -1,18 +1,30 @@
 package chainlib
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/websocket/v2"
 	websocket2 "github.com/gorilla/websocket"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMatchSpecApiByName(t *testing.T) {
@@ -391,3 +403,87 @@ func TestGetServiceApis(t *testing.T) {
 		t.Errorf("Expected serverApis length to be 3, but got %d", len(serverApis))
 	}
 }
+
+type rejectingRelaySender struct {
+	relayResult *common.RelayResult
+	err         error
+}
+
+func (rs *rejectingRelaySender) SendRelay(ctx context.Context, url string, req string, connectionType string, dappID string, consumerIp string, analytics *metrics.RelayMetrics, metadataValues []pairingtypes.Metadata) (*common.RelayResult, error) {
+	return rs.relayResult, rs.err
+}
+
+// serveChainListener serves a consumer chain listener on a free local port and returns its address
+func serveChainListener(t *testing.T, apiInterface string, relaySender RelaySender) string {
+	rand.InitRandomSeed()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	rpcConsumerLogs, err := metrics.NewRPCConsumerLogs(nil, nil)
+	require.NoError(t, err)
+	endpoint := &lavasession.RPCEndpoint{NetworkAddress: address, ChainID: "LAV1", ApiInterface: apiInterface}
+	chainListener, err := NewChainListener(context.Background(), endpoint, relaySender, nil, rpcConsumerLogs, nil)
+	require.NoError(t, err)
+	go chainListener.Serve(context.Background(), common.ConsumerCmdFlags{})
+
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", address)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	return "http://" + address
+}
+
+func TestListenersSendRejectionReplies(t *testing.T) {
+	jsonRPCReply := `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"eth_sign is denied: method is not allowed on this endpoint"}}`
+	restReply := `{"code":7,"details":[],"message":"/blocks/latest is denied: method is not allowed on this endpoint"}`
+	testTable := []struct {
+		name         string
+		apiInterface string
+		method       string
+		path         string
+		body         string
+		reply        string
+		err          error
+	}{
+		{"jsonrpc", spectypes.APIInterfaceJsonRPC, http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_sign","params":[]}`, jsonRPCReply, sdkerrors.Wrapf(common.MethodDeniedError, "eth_sign is denied")},
+		{"tendermint", spectypes.APIInterfaceTendermintRPC, http.MethodPost, "/", `{"jsonrpc":"2.0","id":1,"method":"eth_sign","params":[]}`, jsonRPCReply, sdkerrors.Wrapf(common.MethodConstraintError, "eth_sign is denied")},
+		{"rest get", spectypes.APIInterfaceRest, http.MethodGet, "/blocks/latest", "", restReply, sdkerrors.Wrapf(common.MethodDeniedError, "/blocks/latest is denied")},
+		{"rest post", spectypes.APIInterfaceRest, http.MethodPost, "/blocks/latest", "{}", restReply, sdkerrors.Wrapf(common.MethodDeniedError, "/blocks/latest is denied")},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			relaySender := &rejectingRelaySender{
+				relayResult: &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte(tt.reply)}, StatusCode: http.StatusForbidden},
+				err:         tt.err,
+			}
+			address := serveChainListener(t, tt.apiInterface, relaySender)
+
+			req, err := http.NewRequest(tt.method, address+tt.path, strings.NewReader(tt.body))
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusForbidden, resp.StatusCode)
+			require.Equal(t, tt.reply, string(body))
+		})
+	}
+
+	// other errors are still masked
+	relaySender := &rejectingRelaySender{relayResult: &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte(jsonRPCReply)}}, err: fmt.Errorf("relay failed")}
+	address := serveChainListener(t, spectypes.APIInterfaceJsonRPC, relaySender)
+	resp, err := http.Post(address, "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_sign","params":[]}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	require.NotContains(t, string(body), "-32601")
+}
@@ -1,6 +1,7 @@
 package extensionslib
 
 import (
+	"github.com/lavanet/lava/protocol/parser"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 )
 
@@ -13,6 +14,8 @@ type ExtensionInfo struct {
 type ExtensionsChainMessage interface {
 	SetExtension(*spectypes.Extension)
 	RequestedBlock() (latest int64, earliest int64)
+	GetApi() *spectypes.Api
+	GetRPCInput() parser.RPCInput // nil when the request can't be parsed for params (e.g. batches)
 }
 
 type ExtensionKey struct {
@@ -65,6 +68,10 @@ func (ep *ExtensionParser) ExtensionParsing(addon string, extensionsChainMessage
 			continue
 		}
 		extensionParserRule := NewExtensionParserRule(extension)
+		if extensionParserRule == nil {
+			// no rule routes requests to this extension, it can only be requested explicitly
+			continue
+		}
 		if extensionParserRule.isPassingRule(extensionsChainMessage, latestBlock) {
 			extensionsChainMessage.SetExtension(extension)
 		}
@@ -72,6 +79,10 @@ func (ep *ExtensionParser) ExtensionParsing(addon string, extensionsChainMessage
 }
 
 func NewExtensionParserRule(extension *spectypes.Extension) ExtensionParserRule {
+	if hasRequestRules(extension.Rule) {
+		// spec defined rules, allows new extensions without a code change
+		return RequestParserRule{extension: extension}
+	}
 	switch extension.Name {
 	case "archive":
 		return ArchiveParserRule{extension: extension}
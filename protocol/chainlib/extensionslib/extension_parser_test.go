@@ -0,0 +1,129 @@
+package extensionslib
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/parser"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExtensionsChainMessage struct {
+	api        *spectypes.Api
+	rpcInput   parser.RPCInput
+	latest     int64
+	earliest   int64
+	extensions []*spectypes.Extension
+}
+
+func (m *mockExtensionsChainMessage) SetExtension(extension *spectypes.Extension) {
+	m.extensions = append(m.extensions, extension)
+}
+
+func (m *mockExtensionsChainMessage) RequestedBlock() (latest int64, earliest int64) {
+	return m.latest, m.earliest
+}
+
+func (m *mockExtensionsChainMessage) GetApi() *spectypes.Api {
+	return m.api
+}
+
+func (m *mockExtensionsChainMessage) GetRPCInput() parser.RPCInput {
+	return m.rpcInput
+}
+
+func TestExtensionParserRules(t *testing.T) {
+	const latestBlock = uint64(1000)
+	archive := &spectypes.Extension{Name: "archive", Rule: &spectypes.Rule{Block: 100}}
+	trace := &spectypes.Extension{Name: "trace", Rule: &spectypes.Rule{Methods: []string{"debug_trace*", "trace_block"}}}
+	tracer := &spectypes.Extension{Name: "tracer", Rule: &spectypes.Rule{
+		Methods: []string{"eth_call"},
+		Params: []*spectypes.ParamRule{{
+			Parser: spectypes.BlockParser{ParserArg: []string{"2", "tracer"}, ParserFunc: spectypes.PARSER_FUNC_PARSE_CANONICAL},
+			Values: []string{"callTracer", "prestateTracer"},
+		}},
+	}}
+	wideRange := &spectypes.Extension{Name: "wide", Rule: &spectypes.Rule{Methods: []string{"eth_getLogs"}, BlockRange: 50}}
+	noRule := &spectypes.Extension{Name: "manual"}
+
+	extensionParser := ExtensionParser{}
+	extensionParser.SetConfiguredExtensions(map[ExtensionKey]*spectypes.Extension{
+		{Extension: archive.Name}:   archive,
+		{Extension: trace.Name}:     trace,
+		{Extension: tracer.Name}:    tracer,
+		{Extension: wideRange.Name}: wideRange,
+		{Extension: noRule.Name}:    noRule,
+	})
+
+	jsonrpcInput := func(params ...interface{}) parser.RPCInput {
+		return rpcInterfaceMessages.JsonrpcMessage{Params: params}
+	}
+
+	playbook := []struct {
+		name     string
+		msg      *mockExtensionsChainMessage
+		expected []string
+	}{
+		{
+			name:     "latest block request",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getBalance"}, rpcInput: jsonrpcInput("0x1", "latest"), latest: spectypes.LATEST_BLOCK, earliest: spectypes.LATEST_BLOCK},
+			expected: []string{},
+		},
+		{
+			name:     "archive block request",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getBalance"}, rpcInput: jsonrpcInput("0x1", "0x1"), latest: 1, earliest: 1},
+			expected: []string{archive.Name},
+		},
+		{
+			name:     "trace method prefix",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "debug_traceTransaction"}, rpcInput: jsonrpcInput("0xabc"), latest: spectypes.NOT_APPLICABLE, earliest: spectypes.NOT_APPLICABLE},
+			expected: []string{trace.Name},
+		},
+		{
+			name:     "trace exact method",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "trace_block"}, rpcInput: jsonrpcInput("latest"), latest: spectypes.LATEST_BLOCK, earliest: spectypes.LATEST_BLOCK},
+			expected: []string{trace.Name},
+		},
+		{
+			name:     "exact method is not a prefix",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "trace_blockByHash"}, rpcInput: jsonrpcInput("0xabc"), latest: spectypes.NOT_APPLICABLE, earliest: spectypes.NOT_APPLICABLE},
+			expected: []string{},
+		},
+		{
+			name:     "param value match",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call"}, rpcInput: jsonrpcInput(map[string]interface{}{}, "latest", map[string]interface{}{"tracer": "callTracer"}), latest: spectypes.LATEST_BLOCK, earliest: spectypes.LATEST_BLOCK},
+			expected: []string{tracer.Name},
+		},
+		{
+			name:     "param value mismatch",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call"}, rpcInput: jsonrpcInput(map[string]interface{}{}, "latest", map[string]interface{}{"tracer": "4byteTracer"}), latest: spectypes.LATEST_BLOCK, earliest: spectypes.LATEST_BLOCK},
+			expected: []string{},
+		},
+		{
+			name:     "param missing",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_call"}, rpcInput: jsonrpcInput(map[string]interface{}{}, "latest"), latest: spectypes.LATEST_BLOCK, earliest: spectypes.LATEST_BLOCK},
+			expected: []string{},
+		},
+		{
+			name:     "wide block range",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getLogs"}, latest: spectypes.LATEST_BLOCK, earliest: 950},
+			expected: []string{wideRange.Name},
+		},
+		{
+			name:     "narrow block range",
+			msg:      &mockExtensionsChainMessage{api: &spectypes.Api{Name: "eth_getLogs"}, latest: 990, earliest: 950},
+			expected: []string{},
+		},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			extensionParser.ExtensionParsing("", play.msg, latestBlock)
+			names := []string{}
+			for _, extension := range play.msg.extensions {
+				names = append(names, extension.Name)
+			}
+			require.ElementsMatch(t, play.expected, names)
+		})
+	}
+}
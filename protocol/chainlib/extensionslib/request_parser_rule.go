@@ -0,0 +1,90 @@
+package extensionslib
+
+import (
+	"strings"
+
+	"github.com/lavanet/lava/protocol/parser"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+// routes requests by the rules defined in the spec, every criteria that is set must pass
+type RequestParserRule struct {
+	extension *spectypes.Extension
+}
+
+func hasRequestRules(rule *spectypes.Rule) bool {
+	return rule != nil && (len(rule.Methods) > 0 || len(rule.Params) > 0 || rule.BlockRange != 0)
+}
+
+func (rpr RequestParserRule) isPassingRule(extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	rule := rpr.extension.Rule
+	if rule.Block != 0 && !(ArchiveParserRule{extension: rpr.extension}).isPassingRule(extensionChainMessage, latestBlock) {
+		return false
+	}
+	if len(rule.Methods) > 0 && !isMatchingMethod(rule.Methods, extensionChainMessage.GetApi()) {
+		return false
+	}
+	if len(rule.Params) > 0 && !isMatchingParams(rule.Params, extensionChainMessage.GetRPCInput()) {
+		return false
+	}
+	if rule.BlockRange != 0 && !isSpanningBlockRange(rule.BlockRange, extensionChainMessage, latestBlock) {
+		return false
+	}
+	return true
+}
+
+func isMatchingMethod(methods []string, api *spectypes.Api) bool {
+	if api == nil {
+		return false
+	}
+	for _, method := range methods {
+		if prefix, ok := strings.CutSuffix(method, "*"); ok {
+			if strings.HasPrefix(api.Name, prefix) {
+				return true
+			}
+		} else if api.Name == method {
+			return true
+		}
+	}
+	return false
+}
+
+// passes if any of the param rules matches the request
+func isMatchingParams(paramRules []*spectypes.ParamRule, rpcInput parser.RPCInput) bool {
+	if rpcInput == nil {
+		return false
+	}
+	for _, paramRule := range paramRules {
+		if paramRule == nil {
+			continue
+		}
+		value, err := parser.ParseFromParams(rpcInput, paramRule.Parser)
+		if err != nil || value == "" {
+			continue
+		}
+		if len(paramRule.Values) == 0 {
+			return true
+		}
+		for _, wanted := range paramRule.Values {
+			if value == wanted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isSpanningBlockRange(blockRange uint64, extensionChainMessage ExtensionsChainMessage, latestBlock uint64) bool {
+	latest, earliest := extensionChainMessage.RequestedBlock()
+	if earliest == spectypes.EARLIEST_BLOCK {
+		return true
+	}
+	if latest == spectypes.LATEST_BLOCK && latestBlock != 0 {
+		latest = int64(latestBlock)
+	}
+	if latest < 0 || earliest < 0 || latest < earliest {
+		// not a specific range
+		return false
+	}
+	return uint64(latest-earliest)+1 >= blockRange
+}
@@ -19,7 +19,7 @@ import (
 type ProxyCallBack = func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error)
 
 func NewGRPCProxy(cb ProxyCallBack, healthCheckPath string, cmdFlags common.ConsumerCmdFlags) (*grpc.Server, *http.Server, error) {
-	s := grpc.NewServer(grpc.UnknownServiceHandler(makeProxyFunc(cb)), grpc.ForceServerCodec(RawBytesCodec{}))
+	s := grpc.NewServer(grpc.UnknownServiceHandler(makeProxyFunc(cb, cmdFlags.AccessControl)), grpc.ForceServerCodec(RawBytesCodec{}))
 	wrappedServer := grpcweb.WrapServer(s)
 	handler := func(resp http.ResponseWriter, req *http.Request) {
 		// Set CORS headers
@@ -50,7 +50,7 @@ func NewGRPCProxy(cb ProxyCallBack, healthCheckPath string, cmdFlags common.Cons
 	return s, httpServer, nil
 }
 
-func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
+func makeProxyFunc(callBack ProxyCallBack, accessControl *common.AccessControl) grpc.StreamHandler {
 	return func(srv interface{}, stream grpc.ServerStream) error {
 		// currently the callback function does not account for headers.
 		methodName, ok := grpc.MethodFromServerStream(stream)
@@ -62,7 +62,11 @@ func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
 		if err != nil {
 			return err
 		}
-		respBytes, md, err := callBack(stream.Context(), methodName[1:], reqBytes) // strip first '/' of the method name
+		ctx, err := authenticateStream(stream.Context(), accessControl, methodName, reqBytes)
+		if err != nil {
+			return err
+		}
+		respBytes, md, err := callBack(ctx, methodName[1:], reqBytes) // strip first '/' of the method name
 		if err != nil {
 			return err
 		}
@@ -71,6 +75,30 @@ func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
 	}
 }
 
+// returns a context with the dapp id of the request credentials in place of the one sent by the client, and without the credentials
+func authenticateStream(ctx context.Context, accessControl *common.AccessControl, methodName string, reqBytes []byte) (context.Context, error) {
+	if !accessControl.Enabled() {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	dappID, err := accessControl.Authenticate(func(name string) string {
+		if values := md.Get(name); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}, methodName, reqBytes)
+	if err != nil {
+		utils.LavaFormatDebug("rejected unauthenticated request", utils.LogAttr("method", methodName), utils.LogAttr("reason", err.Error()))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	md = md.Copy()
+	for _, header := range common.AccessControlHeaders {
+		md.Delete(header)
+	}
+	md.Set("dapp-id", dappID)
+	return metadata.NewIncomingContext(ctx, md), nil
+}
+
 type RawBytesCodec struct{}
 
 func (RawBytesCodec) Marshal(v interface{}) ([]byte, error) {
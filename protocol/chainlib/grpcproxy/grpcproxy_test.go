@@ -7,7 +7,9 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/grpcproxy/testproto"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestGRPCProxy(t *testing.T) {
@@ -37,3 +39,27 @@ func TestGRPCProxy(t *testing.T) {
 	do()
 	do()
 }
+
+func TestGRPCProxyAccessControl(t *testing.T) {
+	accessControl, err := common.NewAccessControl([]common.AccessCredential{{Type: common.CredentialTypeApiKey, Key: "secret-key", DappID: "team"}})
+	require.NoError(t, err)
+	proxyGRPCSrv, _, err := NewGRPCProxy(func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		require.Equal(t, []string{"team"}, md.Get("dapp-id"))
+		require.Empty(t, md.Get(common.API_KEY_HEADER_NAME))
+		respBytes, err := (&testproto.TestResponse{Response: "ok"}).Marshal()
+		require.NoError(t, err)
+		return respBytes, metadata.MD{}, nil
+	}, "", common.ConsumerCmdFlags{AccessControl: accessControl})
+	require.NoError(t, err)
+	client := testproto.NewTestClient(testproto.InMemoryClientConn(t, proxyGRPCSrv))
+
+	_, err = client.Test(context.Background(), &testproto.TestRequest{Request: "echo"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the client can't choose its dapp id when access control is enabled
+	ctx := metadata.AppendToOutgoingContext(context.Background(), common.API_KEY_HEADER_NAME, "secret-key", "dapp-id", "other")
+	resp, err := client.Test(ctx, &testproto.TestRequest{Request: "echo"})
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp.Response)
+}
@@ -3,6 +3,7 @@ package chainlib
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -19,6 +20,26 @@ import (
 	"github.com/lavanet/lava/utils"
 )
 
+// marks errors of the connection to the node and node timeouts, the chain router counts them towards ejecting the node
+// and retries the relay on another node. the message of the wrapped error is kept as is
+type nodeConnectionError struct{ error }
+
+func (nce nodeConnectionError) Unwrap() error { return nce.error }
+
+// sdkerrors matches wrapped errors through Cause
+func (nce nodeConnectionError) Cause() error { return nce.error }
+
+func markNodeConnectionError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return nodeConnectionError{err}
+}
+
+func isNodeConnectionError(err error) bool {
+	return errors.As(err, &nodeConnectionError{})
+}
+
 type genericErrorHandler struct{}
 
 func (geh *genericErrorHandler) handleConnectionError(err error) error {
@@ -44,23 +65,23 @@ func (geh *genericErrorHandler) handleConnectionError(err error) error {
 
 func (geh *genericErrorHandler) handleGenericErrors(ctx context.Context, nodeError error) error {
 	if nodeError == context.DeadlineExceeded || ctx.Err() == context.DeadlineExceeded {
-		return utils.LavaFormatProduction("Provider Failed Sending Message", common.ContextDeadlineExceededError)
+		return markNodeConnectionError(utils.LavaFormatProduction("Provider Failed Sending Message", common.ContextDeadlineExceededError))
 	}
 	retError := geh.handleConnectionError(nodeError)
 	if retError != nil {
 		// printing the original error as  it was masked for the consumer to not see the private information such as ip address etc..
 		utils.LavaFormatProduction("Original Node Error", nodeError)
 	}
-	return retError
+	return markNodeConnectionError(retError)
 }
 
 func (geh *genericErrorHandler) handleCodeErrors(ctx context.Context, code codes.Code) error {
 	if code == codes.DeadlineExceeded {
-		return utils.LavaFormatProduction("Provider Failed Sending Message", common.ContextDeadlineExceededError)
+		return markNodeConnectionError(utils.LavaFormatProduction("Provider Failed Sending Message", common.ContextDeadlineExceededError))
 	}
 	switch code {
 	case codes.PermissionDenied, codes.Canceled, codes.Aborted, codes.DataLoss, codes.Unauthenticated, codes.Unavailable:
-		return utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: "+code.String(), nil)
+		return markNodeConnectionError(utils.LavaFormatProduction("Provider Side Failed Sending Message, Reason: "+code.String(), nil))
 	}
 	return nil
 }
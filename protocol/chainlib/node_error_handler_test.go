@@ -73,6 +73,8 @@ func TestNodeErrorHandlerGenericErrors(t *testing.T) {
 	err = neh.handleGenericErrors(ctx, opErr)
 	expectedError = utils.LavaFormatError("Provider Side Failed Sending Message, Reason: Connection refused", nil)
 	require.Equal(t, err.Error(), expectedError.Error())
+	// connection errors are marked for the chain router
+	require.True(t, isNodeConnectionError(err))
 
 	// Test non-matching error
 	err = neh.handleGenericErrors(ctx, errors.New("dummy error"))
@@ -352,20 +352,37 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		)
 		msgSeed := apil.logger.GetMessageSeed()
 		startTime := time.Now()
+		subscriptions := newWebsocketSubscriptions(websocketConn)
+		defer subscriptions.closeAll() // the client disconnected, close all of its provider streams
 		for {
 			if mt, msg, err = websocketConn.ReadMessage(); err != nil {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				subscriptions.withWriteLock(func() {
+					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				})
 				break
 			}
 			dappID, ok := websocketConn.Locals("dappId").(string)
 			if !ok {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, nil, msgSeed, []byte("Unable to extract dappID"), spectypes.APIInterfaceJsonRPC, time.Since(startTime))
+				subscriptions.withWriteLock(func() {
+					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, nil, msgSeed, []byte("Unable to extract dappID"), spectypes.APIInterfaceJsonRPC, time.Since(startTime))
+				})
+			}
+
+			if unsubscribeReply, handled := subscriptions.handleUnsubscribe(msg); handled {
+				// the subscription was opened on this connection, its provider stream is closed without another relay
+				if err = subscriptions.WriteMessage(mt, unsubscribeReply); err != nil {
+					subscriptions.withWriteLock(func() {
+						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+					})
+					continue
+				}
+				apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(unsubscribeReply), msgSeed, time.Since(startTime), nil)
+				continue
 			}
 
 			ctx, cancel := context.WithCancel(context.Background())
 			guid := utils.GenerateUniqueIdentifier()
 			ctx = utils.WithUniqueIdentifier(ctx, guid)
-			defer cancel() // incase there's a problem make sure to cancel the connection
 			utils.LavaFormatInfo("ws in <<<", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "seed", Value: msgSeed}, utils.Attribute{Key: "msg", Value: msg}, utils.Attribute{Key: "dappID", Value: dappID})
 			msgSeed = strconv.FormatUint(guid, 10)
 			metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
@@ -373,8 +390,11 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 			reply := relayResult.GetReply()
 			replyServer := relayResult.GetReplyServer()
 			go apil.logger.AddMetricForWebSocket(metricsData, err, websocketConn)
-			if err != nil {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+			if err != nil && !common.IsRejectedWithReply(relayResult, err) {
+				cancel()
+				subscriptions.withWriteLock(func() {
+					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				})
 				continue
 			}
 			// If subscribe the first reply would contain the RPC ID that can be used for disconnect.
@@ -382,33 +402,37 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 				var reply pairingtypes.RelayReply
 				err = (*replyServer).RecvMsg(&reply) // this reply contains the RPC ID
 				if err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+					cancel()
+					subscriptions.withWriteLock(func() {
+						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+					})
 					continue
 				}
 
-				if err = websocketConn.WriteMessage(mt, reply.Data); err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				if err = subscriptions.WriteMessage(mt, reply.Data); err != nil {
+					cancel()
+					subscriptions.withWriteLock(func() {
+						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+					})
 					continue
 				}
 				apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
-				for {
-					err = (*replyServer).RecvMsg(&reply)
-					if err != nil {
-						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
-						break
-					}
-
-					// If portal cant write to the client
-					if err = websocketConn.WriteMessage(mt, reply.Data); err != nil {
-						cancel()
+				key := subscriptionKey(msg, reply.Data)
+				subscriptionID := subscriptions.add(key, cancel)
+				// stream the events in the background so we can keep reading requests (such as unsubscribe) on this connection
+				go func(msg []byte, msgSeed string) {
+					subscriptions.stream(ctx, key, subscriptionID, replyServer, mt, func(data []byte) {
+						apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(data), msgSeed, time.Since(startTime), nil)
+					}, func(err error) {
 						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
-						// break
-					}
-					apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
-				}
+					})
+				}(msg, msgSeed)
 			} else {
-				if err = websocketConn.WriteMessage(mt, reply.Data); err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				cancel()
+				if err = subscriptions.WriteMessage(mt, reply.Data); err != nil {
+					subscriptions.withWriteLock(func() {
+						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+					})
 					continue
 				}
 				apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
@@ -439,7 +463,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		reply := relayResult.GetReply()
 		go apil.logger.AddMetricForHttp(metricsData, err, fiberCtx.GetReqHeaders())
 
-		if err != nil {
+		if err != nil && !common.IsRejectedWithReply(relayResult, err) {
 			// Get unique GUID response
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
 
@@ -490,7 +514,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		msgSeed := strconv.FormatUint(guid, 10)
 		reply := relayResult.GetReply()
 		go apil.logger.AddMetricForHttp(metricsData, err, fiberCtx.GetReqHeaders())
-		if err != nil {
+		if err != nil && !common.IsRejectedWithReply(relayResult, err) {
 			// Get unique GUID response
 			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
 
@@ -0,0 +1,198 @@
+package chainlib
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/websocket/v2"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	tendermintUnsubscribeMethod    = "unsubscribe"
+	tendermintUnsubscribeAllMethod = "unsubscribe_all"
+	jsonRPCUnsubscribeSuffix       = "_unsubscribe"
+)
+
+// websocketSubscriptions tracks the subscriptions opened on a single consumer websocket connection.
+// subscription events are streamed in the background so the connection keeps reading requests,
+// and unsubscribe requests for tracked subscriptions are answered locally by closing the provider stream
+type websocketSubscriptions struct {
+	conn      *websocket.Conn
+	writeLock sync.Mutex
+	lock      sync.Mutex
+	nextID    uint64
+	active    map[string]map[uint64]context.CancelFunc // subscription key -> cancel of each stream opened with it
+}
+
+func newWebsocketSubscriptions(conn *websocket.Conn) *websocketSubscriptions {
+	return &websocketSubscriptions{conn: conn, active: map[string]map[uint64]context.CancelFunc{}}
+}
+
+// websocket connections support a single concurrent writer
+func (wss *websocketSubscriptions) WriteMessage(messageType int, data []byte) error {
+	wss.writeLock.Lock()
+	defer wss.writeLock.Unlock()
+	return wss.conn.WriteMessage(messageType, data)
+}
+
+// runs a callback that writes to the connection while holding the write lock
+func (wss *websocketSubscriptions) withWriteLock(callback func()) {
+	wss.writeLock.Lock()
+	defer wss.writeLock.Unlock()
+	callback()
+}
+
+func (wss *websocketSubscriptions) add(key string, cancel context.CancelFunc) uint64 {
+	wss.lock.Lock()
+	defer wss.lock.Unlock()
+	wss.nextID++
+	if _, ok := wss.active[key]; !ok {
+		wss.active[key] = map[uint64]context.CancelFunc{}
+	}
+	wss.active[key][wss.nextID] = cancel
+	return wss.nextID
+}
+
+func (wss *websocketSubscriptions) remove(key string, id uint64) {
+	wss.lock.Lock()
+	defer wss.lock.Unlock()
+	streams, ok := wss.active[key]
+	if !ok {
+		return
+	}
+	if cancel, ok := streams[id]; ok {
+		cancel()
+		delete(streams, id)
+	}
+	if len(streams) == 0 {
+		delete(wss.active, key)
+	}
+}
+
+// cancels all the streams opened with key, returns false if there are none
+func (wss *websocketSubscriptions) cancel(key string) bool {
+	wss.lock.Lock()
+	defer wss.lock.Unlock()
+	streams, ok := wss.active[key]
+	if !ok {
+		return false
+	}
+	for _, cancel := range streams {
+		cancel()
+	}
+	delete(wss.active, key)
+	return true
+}
+
+func (wss *websocketSubscriptions) closeAll() {
+	wss.lock.Lock()
+	defer wss.lock.Unlock()
+	for key, streams := range wss.active {
+		for _, cancel := range streams {
+			cancel()
+		}
+		delete(wss.active, key)
+	}
+}
+
+// streams the subscription events to the client until the stream ends or ctx is canceled
+func (wss *websocketSubscriptions) stream(ctx context.Context, key string, id uint64, replyServer *pairingtypes.Relayer_RelaySubscribeClient, messageType int, onReply func(data []byte), onError func(err error)) {
+	defer wss.remove(key, id)
+	for {
+		var reply pairingtypes.RelayReply
+		err := (*replyServer).RecvMsg(&reply)
+		if err != nil {
+			if ctx.Err() == nil {
+				wss.withWriteLock(func() { onError(err) })
+			}
+			return
+		}
+		if err = wss.WriteMessage(messageType, reply.Data); err != nil {
+			// the client can't be written to, close the subscription
+			wss.withWriteLock(func() { onError(err) })
+			return
+		}
+		onReply(reply.Data)
+	}
+}
+
+// answers unsubscribe requests of tracked subscriptions, returns handled false if msg should be relayed
+func (wss *websocketSubscriptions) handleUnsubscribe(msg []byte) (reply []byte, handled bool) {
+	request := struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}{}
+	if err := json.Unmarshal(msg, &request); err != nil {
+		return nil, false
+	}
+	result := json.RawMessage("true")
+	switch {
+	case request.Method == tendermintUnsubscribeAllMethod:
+		wss.closeAll()
+		result = json.RawMessage("{}")
+	case request.Method == tendermintUnsubscribeMethod:
+		if !wss.cancel(subscriptionKeyFromParams(request.Params)) {
+			return nil, false
+		}
+		result = json.RawMessage("{}")
+	case strings.HasSuffix(request.Method, jsonRPCUnsubscribeSuffix):
+		if !wss.cancel(subscriptionKeyFromParams(request.Params)) {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+	reply, err := json.Marshal(struct {
+		Version string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id,omitempty"`
+		Result  json.RawMessage `json:"result"`
+	}{Version: "2.0", ID: request.ID, Result: result})
+	if err != nil {
+		return nil, false
+	}
+	return reply, true
+}
+
+// the key identifying a subscription on unsubscribe: the subscription id returned on the first reply (jsonrpc),
+// or the query it was opened with when the reply doesn't hold an id (tendermint)
+func subscriptionKey(request []byte, firstReply []byte) string {
+	reply := struct {
+		Result json.RawMessage `json:"result"`
+	}{}
+	if err := json.Unmarshal(firstReply, &reply); err == nil {
+		var subscriptionID string
+		if err := json.Unmarshal(reply.Result, &subscriptionID); err == nil && subscriptionID != "" {
+			return subscriptionID
+		}
+	}
+	params := struct {
+		Params json.RawMessage `json:"params"`
+	}{}
+	if err := json.Unmarshal(request, &params); err != nil {
+		return ""
+	}
+	return subscriptionKeyFromParams(params.Params)
+}
+
+func subscriptionKeyFromParams(params json.RawMessage) string {
+	var paramsList []interface{}
+	if err := json.Unmarshal(params, &paramsList); err == nil {
+		if len(paramsList) > 0 {
+			if key, ok := paramsList[0].(string); ok {
+				return key
+			}
+		}
+		return ""
+	}
+	var paramsMap map[string]interface{}
+	if err := json.Unmarshal(params, &paramsMap); err == nil {
+		if query, ok := paramsMap["query"].(string); ok {
+			return query
+		}
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package chainlib
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketSubscriptionKey(t *testing.T) {
+	playbook := []struct {
+		name       string
+		request    string
+		firstReply string
+		key        string
+	}{
+		{
+			name:       "jsonrpc subscription id",
+			request:    `{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`,
+			firstReply: `{"jsonrpc":"2.0","id":1,"result":"0xcd0c3e8af590364c09d0fa6a1210faf5"}`,
+			key:        "0xcd0c3e8af590364c09d0fa6a1210faf5",
+		},
+		{
+			name:       "tendermint query",
+			request:    `{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"query":"tm.event='NewBlock'"}}`,
+			firstReply: `{"jsonrpc":"2.0","id":1,"result":{}}`,
+			key:        "tm.event='NewBlock'",
+		},
+		{
+			name:       "tendermint query as list",
+			request:    `{"jsonrpc":"2.0","id":1,"method":"subscribe","params":["tm.event='Tx'"]}`,
+			firstReply: `{"jsonrpc":"2.0","id":1,"result":{}}`,
+			key:        "tm.event='Tx'",
+		},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			require.Equal(t, play.key, subscriptionKey([]byte(play.request), []byte(play.firstReply)))
+		})
+	}
+}
+
+func TestWebsocketSubscriptionsUnsubscribe(t *testing.T) {
+	subscriptions := newWebsocketSubscriptions(nil)
+	ethCtx, ethCancel := context.WithCancel(context.Background())
+	defer ethCancel()
+	ethID := subscriptions.add("0xabc", ethCancel)
+	tmCtx, tmCancel := context.WithCancel(context.Background())
+	defer tmCancel()
+	subscriptions.add("tm.event='NewBlock'", tmCancel)
+
+	// unknown subscriptions are relayed
+	_, handled := subscriptions.handleUnsubscribe([]byte(`{"jsonrpc":"2.0","id":7,"method":"eth_unsubscribe","params":["0xdef"]}`))
+	require.False(t, handled)
+	// non unsubscribe requests are relayed
+	_, handled = subscriptions.handleUnsubscribe([]byte(`{"jsonrpc":"2.0","id":7,"method":"eth_blockNumber","params":[]}`))
+	require.False(t, handled)
+
+	reply, handled := subscriptions.handleUnsubscribe([]byte(`{"jsonrpc":"2.0","id":7,"method":"eth_unsubscribe","params":["0xabc"]}`))
+	require.True(t, handled)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":7,"result":true}`, string(reply))
+	require.Error(t, ethCtx.Err())
+	require.NoError(t, tmCtx.Err())
+	// removing an already canceled stream is a no-op
+	subscriptions.remove("0xabc", ethID)
+
+	reply, handled = subscriptions.handleUnsubscribe([]byte(`{"jsonrpc":"2.0","id":8,"method":"unsubscribe","params":{"query":"tm.event='NewBlock'"}}`))
+	require.True(t, handled)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":8,"result":{}}`, string(reply))
+	require.Error(t, tmCtx.Err())
+
+	otherCtx, otherCancel := context.WithCancel(context.Background())
+	defer otherCancel()
+	subscriptions.add("tm.event='Tx'", otherCancel)
+	_, handled = subscriptions.handleUnsubscribe([]byte(`{"jsonrpc":"2.0","id":9,"method":"unsubscribe_all","params":{}}`))
+	require.True(t, handled)
+	require.Error(t, otherCtx.Err())
+	require.Empty(t, subscriptions.active)
+}
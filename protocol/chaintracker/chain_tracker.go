@@ -36,9 +36,13 @@ const (
 	PollingUpdateLength           = 10
 	MostFrequentPollingMultiplier = 16
 	PollingMultiplierFlagName     = "polling-multiplier"
+	SubscribeHeadsFlagName        = "subscribe-heads"
 )
 
-var PollingMultiplier = uint64(1)
+var (
+	PollingMultiplier = uint64(1)
+	SubscribeHeads    = false
+)
 
 type ChainFetcher interface {
 	FetchLatestBlockNum(ctx context.Context) (int64, error)
@@ -46,6 +50,12 @@ type ChainFetcher interface {
 	FetchEndpoint() lavasession.RPCProviderEndpoint
 }
 
+// optional ChainFetcher capability, nodes that can push new heads save the chain tracker from polling them
+type HeadsSubscriber interface {
+	// returns a channel of new latest block numbers and a channel that reports when the subscription ends
+	SubscribeNewHeads(ctx context.Context) (heads <-chan int64, errs <-chan error, err error)
+}
+
 type blockTimeUpdatable interface {
 	UpdateBlockTime(time.Duration)
 }
@@ -70,6 +80,10 @@ type ChainTracker struct {
 	blockEventsGap          []time.Duration
 	blockTimeUpdatables     map[blockTimeUpdatable]struct{}
 	pmetrics                *metrics.ProviderMetricsManager
+	subscribeHeads          bool          // try to get new heads pushed by the node instead of polling
+	headsSubscribed         atomic.Bool   // true while a heads subscription is active, polling is relaxed
+	pushedHeads             chan int64    // new heads received from the subscription
+	pollNow                 chan struct{} // triggers an immediate poll when the subscription drops
 }
 
 // this function returns block hashes of the blocks: [from block - to block] inclusive. an additional specific block hash can be provided. order is sorted ascending
@@ -305,6 +319,11 @@ func (cs *ChainTracker) fetchAllPreviousBlocksIfNecessary(ctx context.Context) (
 		return err
 	}
 	cs.pmetrics.SetLatestBlockFetchSuccess(cs.endpoint.ChainID)
+	return cs.updateLatestBlock(ctx, newLatestBlock)
+}
+
+// handles a latest block number, either polled or pushed by the node, fetching the hashes and triggering the callbacks
+func (cs *ChainTracker) updateLatestBlock(ctx context.Context, newLatestBlock int64) (err error) {
 	gotNewBlock := cs.gotNewBlock(ctx, newLatestBlock)
 	forked, err := cs.forkChanged(ctx, newLatestBlock)
 	if err != nil {
@@ -369,29 +388,54 @@ func (cs *ChainTracker) start(ctx context.Context, pollingTime time.Duration) er
 		return err
 	}
 	blockGapTicker := time.NewTicker(pollingTime) // initially every block we check for a polling time
+	if cs.subscribeHeads {
+		if subscriber, ok := cs.chainFetcher.(HeadsSubscriber); ok {
+			go cs.keepHeadsSubscription(ctx, subscriber, pollingTime)
+		} else {
+			utils.LavaFormatWarning("chain fetcher does not support heads subscription, polling instead", nil, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+		}
+	}
 	// Polls blocks and keeps a queue of them
 	go func() {
 		fetchFails := uint64(0)
+		poll := func() {
+			if debug {
+				utils.LavaFormatDebug("chain tracker fetch triggered", utils.Attribute{Key: "currTime", Value: time.Now()})
+			}
+			fetchCtx, cancel := context.WithTimeout(ctx, 3*time.Second) // protect this flow from hanging code
+			err := cs.fetchAllPreviousBlocksIfNecessary(fetchCtx)
+			cancel()
+			if err != nil {
+				fetchFails += 1
+				cs.updateTimer(pollingTime, fetchFails)
+				if fetchFails > maxFails {
+					utils.LavaFormatError("failed to fetch all previous blocks and was necessary", err, utils.Attribute{Key: "fetchFails", Value: fetchFails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+				} else {
+					utils.LavaFormatDebug("failed to fetch all previous blocks", utils.Attribute{Key: "error", Value: err}, utils.Attribute{Key: "fetchFails", Value: fetchFails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+				}
+			} else {
+				cs.updateTimer(pollingTime, 0)
+				fetchFails = 0
+			}
+		}
 		for {
 			select {
 			case <-cs.timer.C:
-				if debug {
-					utils.LavaFormatDebug("chain tracker fetch triggered", utils.Attribute{Key: "currTime", Value: time.Now()})
+				poll()
+			case <-cs.pollNow:
+				// the heads subscription dropped, don't wait for the relaxed timer
+				cs.timer.Stop()
+				poll()
+			case newHead := <-cs.pushedHeads:
+				if newHead < cs.GetAtomicLatestBlockNum() {
+					// out of order notification, rollbacks are detected by polling
+					continue
 				}
-				fetchCtx, cancel := context.WithTimeout(ctx, 3*time.Second) // protect this flow from hanging code
-				err := cs.fetchAllPreviousBlocksIfNecessary(fetchCtx)
+				fetchCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+				err := cs.updateLatestBlock(fetchCtx, newHead)
 				cancel()
 				if err != nil {
-					fetchFails += 1
-					cs.updateTimer(pollingTime, fetchFails)
-					if fetchFails > maxFails {
-						utils.LavaFormatError("failed to fetch all previous blocks and was necessary", err, utils.Attribute{Key: "fetchFails", Value: fetchFails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
-					} else {
-						utils.LavaFormatDebug("failed to fetch all previous blocks", utils.Attribute{Key: "error", Value: err}, utils.Attribute{Key: "fetchFails", Value: fetchFails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
-					}
-				} else {
-					cs.updateTimer(pollingTime, 0)
-					fetchFails = 0
+					utils.LavaFormatDebug("failed to update pushed head", utils.Attribute{Key: "error", Value: err}, utils.Attribute{Key: "head", Value: newHead}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
 				}
 			case <-blockGapTicker.C:
 				var enoughSamples bool
@@ -408,6 +452,64 @@ func (cs *ChainTracker) start(ctx context.Context, pollingTime time.Duration) er
 	return nil
 }
 
+// keeps a subscription to the node's new heads alive, while it's down the chain tracker falls back to regular polling
+func (cs *ChainTracker) keepHeadsSubscription(ctx context.Context, subscriber HeadsSubscriber, pollingTime time.Duration) {
+	fails := uint64(0)
+	for {
+		heads, errs, err := subscriber.SubscribeNewHeads(ctx)
+		if err != nil {
+			if HeadsSubscriptionUnsupportedError.Is(err) {
+				utils.LavaFormatWarning("node does not support heads subscription, polling instead", err, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+				return
+			}
+			fails++
+			utils.LavaFormatDebug("failed subscribing to new heads, polling instead", utils.Attribute{Key: "error", Value: err}, utils.Attribute{Key: "fails", Value: fails}, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+		} else {
+			fails = 0
+			cs.headsSubscribed.Store(true)
+			utils.LavaFormatInfo("chain tracker subscribed to new heads", utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+			err = cs.forwardHeads(ctx, heads, errs)
+			cs.headsSubscribed.Store(false)
+			if ctx.Err() != nil {
+				return
+			}
+			utils.LavaFormatWarning("heads subscription disconnected, falling back to polling", err, utils.Attribute{Key: "endpoint", Value: cs.endpoint.String()})
+			select {
+			case cs.pollNow <- struct{}{}:
+			default:
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(exponentialBackoff(pollingTime, fails)):
+		}
+	}
+}
+
+func (cs *ChainTracker) forwardHeads(ctx context.Context, heads <-chan int64, errs <-chan error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			if err == nil {
+				err = HeadsSubscriptionClosedError
+			}
+			return err
+		case newHead, ok := <-heads:
+			if !ok {
+				return HeadsSubscriptionClosedError
+			}
+			select {
+			case cs.pushedHeads <- newHead:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
 func (cs *ChainTracker) updateTimer(tickerBaseTime time.Duration, fetchFails uint64) {
 	blockGap := cs.smallestBlockGap()
 	timeSinceLastUpdate := time.Since(cs.latestChangeTime)
@@ -420,7 +522,10 @@ func (cs *ChainTracker) updateTimer(tickerBaseTime time.Duration, fetchFails uin
 		newPollingTime = tickerBaseTime / MostFrequentPollingMultiplier
 	}
 	newTickerDuration := exponentialBackoff(newPollingTime, fetchFails)
-	if PollingMultiplier > 1 {
+	if cs.headsSubscribed.Load() {
+		// new heads are pushed by the node, polling is only a safety net
+		newTickerDuration = exponentialBackoff(tickerBaseTime, fetchFails)
+	} else if PollingMultiplier > 1 {
 		newTickerDuration /= time.Duration(PollingMultiplier)
 	}
 	if debug {
@@ -590,6 +695,9 @@ func NewChainTracker(ctx context.Context, chainFetcher ChainFetcher, config Chai
 		blockTimeUpdatables:     map[blockTimeUpdatable]struct{}{},
 		startupTime:             time.Now(),
 		pmetrics:                config.Pmetrics,
+		subscribeHeads:          config.SubscribeToHeads,
+		pushedHeads:             make(chan int64),
+		pollNow:                 make(chan struct{}, 1),
 	}
 	if chainFetcher == nil {
 		return nil, utils.LavaFormatError("can't start chainTracker with nil chainFetcher argument", nil)
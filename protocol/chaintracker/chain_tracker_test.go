@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -625,3 +626,85 @@ func TestFindRequestedBlockHash(t *testing.T) {
 	require.NotNil(t, requestedHash)
 	require.Len(t, hashesMap, 4)
 }
+
+type subscription struct {
+	heads chan int64
+	errs  chan error
+}
+
+type MockHeadsSubscriber struct {
+	*MockChainFetcher
+	subscriptions chan subscription
+}
+
+func (mhs *MockHeadsSubscriber) SubscribeNewHeads(ctx context.Context) (<-chan int64, <-chan error, error) {
+	sub := subscription{heads: make(chan int64), errs: make(chan error, 1)}
+	mhs.subscriptions <- sub
+	return sub.heads, sub.errs, nil
+}
+
+func TestChainTrackerHeadsSubscription(t *testing.T) {
+	mockBlocks := int64(100)
+	mockChainFetcher := NewMockChainFetcher(1000, mockBlocks, nil)
+	currentLatestBlockInMock := mockChainFetcher.AdvanceBlock()
+	subscriber := &MockHeadsSubscriber{MockChainFetcher: mockChainFetcher, subscriptions: make(chan subscription, 1)}
+
+	forked := atomic.Bool{}
+	forkCallback := func(arg int64) {
+		forked.Store(true)
+	}
+	// a long block time makes the polling fallback slow enough to tell it apart from pushed heads
+	averageBlockTime := 2 * time.Second
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chainTrackerConfig := chaintracker.ChainTrackerConfig{BlocksToSave: 10, AverageBlockTime: averageBlockTime, ServerBlockMemory: uint64(mockBlocks), ForkCallback: forkCallback, SubscribeToHeads: true}
+	chainTracker, err := chaintracker.NewChainTracker(ctx, subscriber, chainTrackerConfig)
+	require.NoError(t, err)
+	require.Equal(t, currentLatestBlockInMock, chainTracker.GetAtomicLatestBlockNum())
+
+	var sub subscription
+	select {
+	case sub = <-subscriber.subscriptions:
+	case <-time.After(time.Second):
+		require.FailNow(t, "chain tracker did not subscribe to new heads")
+	}
+	// let the initial fast poll pass, from now on polling is relaxed to the block time
+	time.Sleep(averageBlockTime / 8)
+
+	waitForLatest := func(expected int64, timeout time.Duration) {
+		require.Eventually(t, func() bool {
+			return chainTracker.GetAtomicLatestBlockNum() == expected
+		}, timeout, time.Millisecond)
+	}
+
+	t.Run("pushed heads", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+			sub.heads <- currentLatestBlockInMock
+			waitForLatest(currentLatestBlockInMock, averageBlockTime/20)
+		}
+		_, requestedHashes, _, err := chainTracker.GetLatestBlockData(spectypes.LATEST_BLOCK-2, spectypes.LATEST_BLOCK, spectypes.NOT_APPLICABLE)
+		require.NoError(t, err)
+		for _, blockStore := range requestedHashes {
+			require.True(t, mockChainFetcher.IsCorrectHash(blockStore.Hash, blockStore.Block))
+		}
+	})
+
+	t.Run("fork on pushed head", func(t *testing.T) {
+		mockChainFetcher.Fork("fork")
+		sub.heads <- currentLatestBlockInMock
+		require.Eventually(t, forked.Load, averageBlockTime/20, time.Millisecond)
+		_, requestedHashes, _, err := chainTracker.GetLatestBlockData(spectypes.NOT_APPLICABLE, spectypes.NOT_APPLICABLE, spectypes.LATEST_BLOCK)
+		require.NoError(t, err)
+		require.True(t, mockChainFetcher.IsCorrectHash(requestedHashes[0].Hash, currentLatestBlockInMock))
+	})
+
+	t.Run("fallback to polling", func(t *testing.T) {
+		currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+		sub.errs <- fmt.Errorf("connection closed")
+		// the tracker polls right away instead of waiting for the relaxed timer
+		waitForLatest(currentLatestBlockInMock, averageBlockTime/20)
+		currentLatestBlockInMock = mockChainFetcher.AdvanceBlock()
+		waitForLatest(currentLatestBlockInMock, averageBlockTime/4)
+	})
+}
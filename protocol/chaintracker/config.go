@@ -22,6 +22,7 @@ type ChainTrackerConfig struct {
 	ServerBlockMemory        uint64
 	BlocksCheckpointDistance uint64 // this causes the chainTracker to trigger it's checkpoint every X blocks
 	Pmetrics                 *metrics.ProviderMetricsManager
+	SubscribeToHeads         bool // get new heads pushed by the node when the chain fetcher supports it, polling is kept as a fallback
 }
 
 func (cnf *ChainTrackerConfig) validate() error {
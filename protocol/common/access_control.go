@@ -0,0 +1,329 @@
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/viper"
+)
+
+const (
+	AccessControlConfigName = "access-control"
+	CredentialTypeApiKey    = "api-key"
+	CredentialTypeHmac      = "hmac"
+	CredentialTypeJwt       = "jwt"
+	DefaultCuBudgetPeriod   = 24 * time.Hour
+	// signed requests with a timestamp further than this from the local clock are rejected
+	HmacMaxClockSkew = 5 * time.Minute
+	// these headers need to be lowercase
+	API_KEY_HEADER_NAME        = "lava-api-key"
+	HMAC_KEY_ID_HEADER_NAME    = "lava-key-id"
+	HMAC_TIMESTAMP_HEADER_NAME = "lava-timestamp"
+	HMAC_SIGNATURE_HEADER_NAME = "lava-signature"
+	AUTHORIZATION_HEADER_NAME  = "authorization"
+	bearerPrefix               = "bearer "
+	jwtAlgorithm               = "HS256"
+)
+
+var (
+	credentialTypes = []string{CredentialTypeApiKey, CredentialTypeHmac, CredentialTypeJwt}
+	// credentials are removed from the request once authenticated so they are never sent to providers
+	AccessControlHeaders = []string{API_KEY_HEADER_NAME, HMAC_KEY_ID_HEADER_NAME, HMAC_TIMESTAMP_HEADER_NAME, HMAC_SIGNATURE_HEADER_NAME, AUTHORIZATION_HEADER_NAME}
+)
+
+// AccessCredential maps an api key, hmac key or jwt signing key to a dapp id.
+// key is the api key itself, the hmac key id or the jwt kid header, secret signs hmac requests and jwt tokens
+type AccessCredential struct {
+	Type           string        `yaml:"type,omitempty" json:"type,omitempty" mapstructure:"type"`
+	Key            string        `yaml:"key,omitempty" json:"key,omitempty" mapstructure:"key"`
+	Secret         string        `yaml:"secret,omitempty" json:"secret,omitempty" mapstructure:"secret"`
+	DappID         string        `yaml:"dapp-id,omitempty" json:"dapp-id,omitempty" mapstructure:"dapp-id"`
+	CuBudget       uint64        `yaml:"cu-budget,omitempty" json:"cu-budget,omitempty" mapstructure:"cu-budget"`                      // 0 is unlimited
+	CuBudgetPeriod time.Duration `yaml:"cu-budget-period,omitempty" json:"cu-budget-period,omitempty" mapstructure:"cu-budget-period"` // defaults to DefaultCuBudgetPeriod
+	AllowedMethods []string      `yaml:"allowed-methods,omitempty" json:"allowed-methods,omitempty" mapstructure:"allowed-methods"`    // empty allows all methods
+}
+
+func (ac AccessCredential) Validate() error {
+	validType := false
+	for _, credentialType := range credentialTypes {
+		if ac.Type == credentialType {
+			validType = true
+		}
+	}
+	if !validType {
+		return fmt.Errorf("invalid access credential type %q, expected one of %v", ac.Type, credentialTypes)
+	}
+	if ac.Key == "" {
+		return fmt.Errorf("empty key for %s access credential", ac.Type)
+	}
+	if ac.DappID == "" {
+		return fmt.Errorf("empty dapp-id for %s access credential %s", ac.Type, ac.Key)
+	}
+	if ac.Type != CredentialTypeApiKey && ac.Secret == "" {
+		return fmt.Errorf("empty secret for %s access credential %s", ac.Type, ac.Key)
+	}
+	if ac.CuBudgetPeriod < 0 {
+		return fmt.Errorf("negative cu-budget-period for dapp %s", ac.DappID)
+	}
+	return nil
+}
+
+func ParseAccessControl(viperAccessControl *viper.Viper) (credentials []AccessCredential, err error) {
+	err = viperAccessControl.UnmarshalKey(AccessControlConfigName, &credentials)
+	if err != nil {
+		return nil, err
+	}
+	for _, credential := range credentials {
+		if err := credential.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return credentials, nil
+}
+
+type dappAccess struct {
+	credential     AccessCredential
+	allowedMethods map[string]struct{}
+	usedCu         uint64
+	periodStart    time.Time
+}
+
+// AccessControl authenticates the requests of the consumer listeners and enforces the budget and methods of their dapp.
+// a nil access control allows everything
+type AccessControl struct {
+	lock           sync.Mutex
+	keys           map[string]map[string]*dappAccess // credential type -> key
+	dapps          map[string]*dappAccess            // dapp id
+	usedSignatures map[string]time.Time              // hmac signature -> the time its timestamp is no longer accepted
+	nextPrune      time.Time
+}
+
+// returns nil when no credentials are configured
+func NewAccessControl(credentials []AccessCredential) (*AccessControl, error) {
+	if len(credentials) == 0 {
+		return nil, nil
+	}
+	ac := &AccessControl{keys: map[string]map[string]*dappAccess{}, dapps: map[string]*dappAccess{}, usedSignatures: map[string]time.Time{}}
+	for _, credential := range credentials {
+		if err := credential.Validate(); err != nil {
+			return nil, err
+		}
+		// the budget and methods belong to the dapp id, so it can't be shared between credentials
+		if _, ok := ac.dapps[credential.DappID]; ok {
+			return nil, fmt.Errorf("dapp-id %s is used by more than one access credential", credential.DappID)
+		}
+		if _, ok := ac.keys[credential.Type][credential.Key]; ok {
+			return nil, fmt.Errorf("duplicate %s access credential %s", credential.Type, credential.Key)
+		}
+		if credential.CuBudgetPeriod == 0 {
+			credential.CuBudgetPeriod = DefaultCuBudgetPeriod
+		}
+		access := &dappAccess{credential: credential, allowedMethods: map[string]struct{}{}, periodStart: time.Now()}
+		for _, method := range credential.AllowedMethods {
+			access.allowedMethods[method] = struct{}{}
+		}
+		if _, ok := ac.keys[credential.Type]; !ok {
+			ac.keys[credential.Type] = map[string]*dappAccess{}
+		}
+		ac.keys[credential.Type][credential.Key] = access
+		ac.dapps[credential.DappID] = access
+	}
+	return ac, nil
+}
+
+func (ac *AccessControl) Enabled() bool {
+	return ac != nil
+}
+
+// returns the dapp id of the credentials in the request headers. headers are looked up by their lowercase name,
+// path and body are the signed parts of hmac requests along with the timestamp
+func (ac *AccessControl) Authenticate(getHeader func(name string) string, path string, body []byte) (dappID string, err error) {
+	if ac == nil {
+		return "", nil
+	}
+	if apiKey := getHeader(API_KEY_HEADER_NAME); apiKey != "" {
+		access, ok := ac.keys[CredentialTypeApiKey][apiKey]
+		if !ok {
+			return "", sdkerrors.Wrap(UnauthenticatedError, "unknown api key")
+		}
+		return access.credential.DappID, nil
+	}
+	if keyID := getHeader(HMAC_KEY_ID_HEADER_NAME); keyID != "" {
+		return ac.authenticateHmac(keyID, getHeader(HMAC_TIMESTAMP_HEADER_NAME), getHeader(HMAC_SIGNATURE_HEADER_NAME), path, body)
+	}
+	if authorization := getHeader(AUTHORIZATION_HEADER_NAME); len(authorization) > len(bearerPrefix) && strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return ac.authenticateJwt(authorization[len(bearerPrefix):])
+	}
+	return "", sdkerrors.Wrapf(UnauthenticatedError, "expected one of the %s, %s or %s headers", API_KEY_HEADER_NAME, HMAC_KEY_ID_HEADER_NAME, AUTHORIZATION_HEADER_NAME)
+}
+
+func (ac *AccessControl) authenticateHmac(keyID string, timestamp string, signature string, path string, body []byte) (string, error) {
+	access, ok := ac.keys[CredentialTypeHmac][keyID]
+	if !ok {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "unknown hmac key id %s", keyID)
+	}
+	unixTime, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "invalid %s header %q", HMAC_TIMESTAMP_HEADER_NAME, timestamp)
+	}
+	skew := time.Since(time.Unix(unixTime, 0))
+	if skew > HmacMaxClockSkew || skew < -HmacMaxClockSkew {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "hmac timestamp is %s away from the local clock", skew)
+	}
+	expected := SignHmacRequest(access.credential.Secret, timestamp, path, body)
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "invalid hmac signature for key id %s", keyID)
+	}
+	// a signature is accepted once, it only needs to be remembered while its timestamp is within the clock skew
+	if !ac.useSignature(expected, time.Unix(unixTime, 0).Add(HmacMaxClockSkew)) {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "hmac signature for key id %s was already used", keyID)
+	}
+	return access.credential.DappID, nil
+}
+
+// returns false if the signature was already used, expired signatures are pruned once per HmacMaxClockSkew
+func (ac *AccessControl) useSignature(signature string, expiry time.Time) bool {
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
+	now := time.Now()
+	if now.After(ac.nextPrune) {
+		for usedSignature, usedExpiry := range ac.usedSignatures {
+			if now.After(usedExpiry) {
+				delete(ac.usedSignatures, usedSignature)
+			}
+		}
+		ac.nextPrune = now.Add(HmacMaxClockSkew)
+	}
+	if _, ok := ac.usedSignatures[signature]; ok {
+		return false
+	}
+	ac.usedSignatures[signature] = expiry
+	return true
+}
+
+// hex encoded hmac-sha256 of the timestamp, path and body, each followed by a new line except the body
+func SignHmacRequest(secret string, timestamp string, path string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + path + "\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// only HS256 tokens are supported, the kid header selects the credential and exp and nbf are enforced when set
+func (ac *AccessControl) authenticateJwt(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", sdkerrors.Wrap(UnauthenticatedError, "malformed jwt")
+	}
+	header := struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}{}
+	if err := decodeJwtPart(parts[0], &header); err != nil {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "malformed jwt header: %s", err)
+	}
+	if header.Alg != jwtAlgorithm {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "unsupported jwt algorithm %q, expected %s", header.Alg, jwtAlgorithm)
+	}
+	access, ok := ac.keys[CredentialTypeJwt][header.Kid]
+	if !ok {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "unknown jwt kid %q", header.Kid)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", sdkerrors.Wrap(UnauthenticatedError, "malformed jwt signature")
+	}
+	mac := hmac.New(sha256.New, []byte(access.credential.Secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "invalid jwt signature for kid %s", header.Kid)
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+		Nbf int64 `json:"nbf"`
+	}{}
+	if err := decodeJwtPart(parts[1], &claims); err != nil {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "malformed jwt claims: %s", err)
+	}
+	now := time.Now().Unix()
+	if claims.Exp != 0 && now >= claims.Exp {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "jwt of kid %s expired", header.Kid)
+	}
+	if claims.Nbf != 0 && now < claims.Nbf {
+		return "", sdkerrors.Wrapf(UnauthenticatedError, "jwt of kid %s is not valid yet", header.Kid)
+	}
+	return access.credential.DappID, nil
+}
+
+func decodeJwtPart(part string, into interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, into)
+}
+
+// checks the methods of the relay are allowed for the dapp and charges its budget, nothing is charged on an error.
+// the charge is returned with RefundRelay if the relay fails.
+// dapp ids without a credential are not limited, when access control is enabled the listeners only pass authenticated
+// dapp ids so these are relays the consumer sends on its own
+func (ac *AccessControl) AllowRelay(dappID string, methods []string, cu uint64) error {
+	if ac == nil {
+		return nil
+	}
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
+	access, ok := ac.dapps[dappID]
+	if !ok {
+		return nil
+	}
+	if len(access.allowedMethods) > 0 {
+		for _, method := range methods {
+			if _, ok := access.allowedMethods[method]; !ok {
+				return sdkerrors.Wrapf(MethodNotAllowedError, "dapp %s is not allowed to call %s", dappID, method)
+			}
+		}
+	}
+	if access.credential.CuBudget == 0 {
+		return nil
+	}
+	now := time.Now()
+	if now.Sub(access.periodStart) >= access.credential.CuBudgetPeriod {
+		access.periodStart = now
+		access.usedCu = 0
+	}
+	if access.usedCu+cu > access.credential.CuBudget {
+		utils.LavaFormatDebug("dapp exceeded its cu budget", utils.LogAttr("dappID", dappID), utils.LogAttr("usedCu", access.usedCu), utils.LogAttr("cu", cu), utils.LogAttr("budget", access.credential.CuBudget))
+		return sdkerrors.Wrapf(CuBudgetExceededError, "dapp %s used %d of its %d cu budget", dappID, access.usedCu, access.credential.CuBudget)
+	}
+	access.usedCu += cu
+	return nil
+}
+
+// returns the compute units charged by AllowRelay for a relay that failed, chargeTime is taken after AllowRelay returned.
+// a charge from a budget period that already ended is not refunded to the current one
+func (ac *AccessControl) RefundRelay(dappID string, cu uint64, chargeTime time.Time) {
+	if ac == nil {
+		return
+	}
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
+	access, ok := ac.dapps[dappID]
+	if !ok || access.credential.CuBudget == 0 || chargeTime.Before(access.periodStart) {
+		return
+	}
+	if cu > access.usedCu {
+		cu = access.usedCu
+	}
+	access.usedCu -= cu
+}
@@ -0,0 +1,148 @@
+package common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func signJwt(secret string, header string, claims string) string {
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func headersGetter(headers map[string]string) func(string) string {
+	return func(name string) string { return headers[name] }
+}
+
+func TestParseAccessControl(t *testing.T) {
+	config := `
+access-control:
+  - type: api-key
+    key: key1
+    dapp-id: team-a
+    cu-budget: 1000
+    cu-budget-period: 1h
+    allowed-methods: [eth_blockNumber, eth_call]
+  - type: hmac
+    key: team-b-key
+    secret: hmac-secret
+    dapp-id: team-b
+`
+	viperConfig := viper.New()
+	viperConfig.SetConfigType("yml")
+	require.NoError(t, viperConfig.ReadConfig(strings.NewReader(config)))
+	credentials, err := ParseAccessControl(viperConfig)
+	require.NoError(t, err)
+	require.Equal(t, []AccessCredential{
+		{Type: CredentialTypeApiKey, Key: "key1", DappID: "team-a", CuBudget: 1000, CuBudgetPeriod: time.Hour, AllowedMethods: []string{"eth_blockNumber", "eth_call"}},
+		{Type: CredentialTypeHmac, Key: "team-b-key", Secret: "hmac-secret", DappID: "team-b"},
+	}, credentials)
+
+	accessControl, err := NewAccessControl(nil)
+	require.NoError(t, err)
+	require.False(t, accessControl.Enabled())
+
+	invalid := [][]AccessCredential{
+		{{Type: "password", Key: "key", DappID: "dapp"}},
+		{{Type: CredentialTypeApiKey, DappID: "dapp"}},
+		{{Type: CredentialTypeApiKey, Key: "key"}},
+		{{Type: CredentialTypeJwt, Key: "kid", DappID: "dapp"}},
+		{{Type: CredentialTypeApiKey, Key: "key1", DappID: "dapp"}, {Type: CredentialTypeApiKey, Key: "key2", DappID: "dapp"}},
+	}
+	for _, credentials := range invalid {
+		_, err := NewAccessControl(credentials)
+		require.Error(t, err, credentials)
+	}
+}
+
+func TestAccessControlAuthenticate(t *testing.T) {
+	accessControl, err := NewAccessControl([]AccessCredential{
+		{Type: CredentialTypeApiKey, Key: "key1", DappID: "team-a"},
+		{Type: CredentialTypeHmac, Key: "hmac1", Secret: "hmac-secret", DappID: "team-b"},
+		{Type: CredentialTypeJwt, Key: "kid1", Secret: "jwt-secret", DappID: "team-c"},
+	})
+	require.NoError(t, err)
+	body := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	staleTimestamp := strconv.FormatInt(time.Now().Add(-2*HmacMaxClockSkew).Unix(), 10)
+	validJwt := signJwt("jwt-secret", `{"alg":"HS256","kid":"kid1"}`, `{"sub":"user","exp":`+strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)+`}`)
+	expiredJwt := signJwt("jwt-secret", `{"alg":"HS256","kid":"kid1"}`, `{"exp":`+strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)+`}`)
+
+	playbook := []struct {
+		name    string
+		headers map[string]string
+		dappID  string
+	}{
+		{name: "no credentials", headers: map[string]string{"dapp-id": "team-a"}},
+		{name: "api key", headers: map[string]string{API_KEY_HEADER_NAME: "key1"}, dappID: "team-a"},
+		{name: "unknown api key", headers: map[string]string{API_KEY_HEADER_NAME: "key2"}},
+		{name: "hmac", headers: map[string]string{HMAC_KEY_ID_HEADER_NAME: "hmac1", HMAC_TIMESTAMP_HEADER_NAME: timestamp, HMAC_SIGNATURE_HEADER_NAME: SignHmacRequest("hmac-secret", timestamp, "/", body)}, dappID: "team-b"},
+		{name: "replayed hmac", headers: map[string]string{HMAC_KEY_ID_HEADER_NAME: "hmac1", HMAC_TIMESTAMP_HEADER_NAME: timestamp, HMAC_SIGNATURE_HEADER_NAME: SignHmacRequest("hmac-secret", timestamp, "/", body)}},
+		{name: "hmac of another path", headers: map[string]string{HMAC_KEY_ID_HEADER_NAME: "hmac1", HMAC_TIMESTAMP_HEADER_NAME: timestamp, HMAC_SIGNATURE_HEADER_NAME: SignHmacRequest("hmac-secret", timestamp, "/other", body)}},
+		{name: "hmac with a wrong secret", headers: map[string]string{HMAC_KEY_ID_HEADER_NAME: "hmac1", HMAC_TIMESTAMP_HEADER_NAME: timestamp, HMAC_SIGNATURE_HEADER_NAME: SignHmacRequest("wrong", timestamp, "/", body)}},
+		{name: "stale hmac", headers: map[string]string{HMAC_KEY_ID_HEADER_NAME: "hmac1", HMAC_TIMESTAMP_HEADER_NAME: staleTimestamp, HMAC_SIGNATURE_HEADER_NAME: SignHmacRequest("hmac-secret", staleTimestamp, "/", body)}},
+		{name: "jwt", headers: map[string]string{AUTHORIZATION_HEADER_NAME: "Bearer " + validJwt}, dappID: "team-c"},
+		{name: "expired jwt", headers: map[string]string{AUTHORIZATION_HEADER_NAME: "Bearer " + expiredJwt}},
+		{name: "jwt with a wrong secret", headers: map[string]string{AUTHORIZATION_HEADER_NAME: "Bearer " + signJwt("wrong", `{"alg":"HS256","kid":"kid1"}`, `{}`)}},
+		{name: "jwt with an unsupported algorithm", headers: map[string]string{AUTHORIZATION_HEADER_NAME: "Bearer " + signJwt("jwt-secret", `{"alg":"none","kid":"kid1"}`, `{}`)}},
+		{name: "basic authorization", headers: map[string]string{AUTHORIZATION_HEADER_NAME: "Basic dXNlcjpwYXNz"}},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			dappID, err := accessControl.Authenticate(headersGetter(play.headers), "/", body)
+			if play.dappID == "" {
+				require.True(t, UnauthenticatedError.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, play.dappID, dappID)
+		})
+	}
+}
+
+func TestAccessControlAllowRelay(t *testing.T) {
+	accessControl, err := NewAccessControl([]AccessCredential{
+		{Type: CredentialTypeApiKey, Key: "key1", DappID: "team-a", CuBudget: 100, AllowedMethods: []string{"eth_blockNumber", "eth_call"}},
+		{Type: CredentialTypeApiKey, Key: "key2", DappID: "team-b"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, accessControl.AllowRelay("team-a", []string{"eth_blockNumber"}, 60))
+	require.True(t, MethodNotAllowedError.Is(accessControl.AllowRelay("team-a", []string{"eth_call", "debug_traceTransaction"}, 10)))
+	// a rejected relay isn't charged
+	require.True(t, CuBudgetExceededError.Is(accessControl.AllowRelay("team-a", []string{"eth_call"}, 50)))
+	require.NoError(t, accessControl.AllowRelay("team-a", []string{"eth_call"}, 40))
+	require.True(t, CuBudgetExceededError.Is(accessControl.AllowRelay("team-a", []string{"eth_call"}, 1)))
+
+	// a failed relay gets its charge back
+	accessControl.RefundRelay("team-a", 40, time.Now())
+	require.NoError(t, accessControl.AllowRelay("team-a", []string{"eth_call"}, 40))
+	chargeTime := time.Now()
+
+	// the budget resets every period
+	accessControl.lock.Lock()
+	accessControl.dapps["team-a"].periodStart = time.Now().Add(-DefaultCuBudgetPeriod)
+	accessControl.lock.Unlock()
+	require.NoError(t, accessControl.AllowRelay("team-a", []string{"eth_call"}, 100))
+	// a charge of the previous period isn't refunded to the new one
+	accessControl.RefundRelay("team-a", 40, chargeTime)
+	require.True(t, CuBudgetExceededError.Is(accessControl.AllowRelay("team-a", []string{"eth_call"}, 1)))
+
+	// no budget or methods list is unlimited, and unknown dapp ids are relays of the consumer itself
+	require.NoError(t, accessControl.AllowRelay("team-b", []string{"debug_traceTransaction"}, 100000))
+	require.NoError(t, accessControl.AllowRelay("DefaultDappID", []string{"eth_call"}, 100000))
+
+	var disabled *AccessControl
+	require.NoError(t, disabled.AllowRelay("team-a", []string{"debug_traceTransaction"}, 100000))
+	disabled.RefundRelay("team-a", 100000, time.Now())
+}
@@ -27,6 +27,14 @@ const (
 	CDNCacheDurationFlag    = "cdn-cache-duration"     // how long to cache the preflight response default 24 hours (in seconds) "86400"
 	RelaysHealthEnableFlag  = "relays-health-enable"   // enable relays health check, default true
 	RelayHealthIntervalFlag = "relays-health-interval" // interval between each relay health check, default 5m
+	// hedged relays related flags
+	HedgeMaxCUPercentFlag      = "hedge-max-cu-percent"     // max percentage of the relayed compute units that can be spent on hedged relays, 0 disables hedging
+	HedgeLatencyPercentileFlag = "hedge-latency-percentile" // the latency percentile of recent relays after which a hedged relay is sent
+	// provider optimizer persistence related flags
+	OptimizerStateDirFlag          = "optimizer-state-dir"           // directory to store the provider optimizer scores in so they survive restarts, empty disables it
+	OptimizerStateSaveIntervalFlag = "optimizer-state-save-interval" // interval between provider optimizer state saves
+	// quorum related flags
+	MaxQuorumFlag = "max-quorum" // max number of providers a relay can be sent to by the quorum directive header
 )
 
 const (
@@ -40,13 +48,19 @@ const (
 
 // helper struct to propagate flags deeper into the code in an organized manner
 type ConsumerCmdFlags struct {
-	HeadersFlag              string        // comma separated list of headers, or * for all, default simple cors specification headers
-	CredentialsFlag          string        // access-control-allow-credentials, defaults to "true"
-	OriginFlag               string        // comma separated list of origins, or * for all, default enabled completely
-	MethodsFlag              string        // whether to allow access control headers *, most proxies have their own access control so its not required
-	CDNCacheDuration         string        // how long to cache the preflight response defaults 24 hours (in seconds) "86400"
-	RelaysHealthEnableFlag   bool          // enables relay health check
-	RelaysHealthIntervalFlag time.Duration // interval for relay health check
+	HeadersFlag              string         // comma separated list of headers, or * for all, default simple cors specification headers
+	CredentialsFlag          string         // access-control-allow-credentials, defaults to "true"
+	OriginFlag               string         // comma separated list of origins, or * for all, default enabled completely
+	MethodsFlag              string         // whether to allow access control headers *, most proxies have their own access control so its not required
+	CDNCacheDuration         string         // how long to cache the preflight response defaults 24 hours (in seconds) "86400"
+	RelaysHealthEnableFlag   bool           // enables relay health check
+	RelaysHealthIntervalFlag time.Duration  // interval for relay health check
+	HedgeMaxCUPercent        uint64         // max percentage of compute units spent on hedged relays, 0 disables hedging
+	HedgeLatencyPercentile   float64        // relays not answered within this latency percentile are hedged
+	OptimizerStateDir        string         // directory of the provider optimizer state files, empty disables persistence
+	OptimizerStateInterval   time.Duration  // interval between provider optimizer state saves
+	AccessControl            *AccessControl // authenticates the listeners requests, nil when no credentials are configured
+	MaxQuorum                int            // max quorum a relay can request with the quorum directive header
 }
 
 // default rolling logs behavior (if enabled) will store 3 files each 100MB for up to 1 day every time.
@@ -7,4 +7,15 @@ var (
 	StatusCodeError504           = sdkerrors.New("Disallowed StatusCode Error", 504, "Disallowed status code error")
 	StatusCodeError429           = sdkerrors.New("Disallowed StatusCode Error", 429, "Disallowed status code error")
 	StatusCodeErrorStrict        = sdkerrors.New("Disallowed StatusCode Error", 800, "Disallowed status code error")
+	UnauthenticatedError         = sdkerrors.New("Access Control Error", 401, "missing or invalid credentials")
+	MethodNotAllowedError        = sdkerrors.New("Access Control Error", 403, "method is not allowed for the dapp")
+	CuBudgetExceededError        = sdkerrors.New("Access Control Error", 429, "compute units budget exceeded")
+	MethodDeniedError            = sdkerrors.New("MethodDenied Error", 687, "method is not allowed on this endpoint")
+	MethodConstraintError        = sdkerrors.New("MethodConstraint Error", 688, "request params exceed the limits of this endpoint")
 )
+
+// IsRejectedWithReply returns true for relays the consumer rejected with a reply in the
+// error format of the api interface (such as the endpoint method filter), which is sent to the client as is
+func IsRejectedWithReply(relayResult *RelayResult, err error) bool {
+	return relayResult.GetReply() != nil && (MethodDeniedError.Is(err) || MethodConstraintError.Is(err))
+}
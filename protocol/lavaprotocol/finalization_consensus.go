@@ -37,7 +37,9 @@ type providerDataContainer struct {
 	BlockHeight           int64
 	RelayNum              uint64
 	LatestBlock           int64
-	// TODO:: keep relay request for conflict reporting
+	// the signed finalization data, used as proof when another provider conflicts with it
+	RelaySession *pairingtypes.RelaySession
+	Reply        *pairingtypes.RelayReply
 }
 
 func NewFinalizationConsensus(specId string) *FinalizationConsensus {
@@ -67,6 +69,8 @@ func (fc *FinalizationConsensus) newProviderHashesConsensus(blockDistanceForFina
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		RelaySession:          req,
+		Reply:                 finalizationProofReply(reply),
 	}
 	providerDataContainers := map[string]providerDataContainer{}
 	providerDataContainers[providerAcc] = newProviderDataContainer
@@ -86,6 +90,8 @@ func (fc *FinalizationConsensus) insertProviderToConsensus(blockDistanceForFinal
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		RelaySession:          req,
+		Reply:                 finalizationProofReply(reply),
 	}
 	consensus.agreeingProviders[providerAcc] = newProviderDataContainer
 
@@ -110,15 +116,18 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 		fc.currentProviderHashesConsensus = append(make([]ProviderHashesConsensus, 0), newHashConsensus)
 	} else {
 		inserted := false
+		var conflictErr error
 		// Looks for discrepancy with current epoch providers
 		// go over all consensus groups, if there is a mismatch add it as a consensus group and send a conflict
 		for _, consensus := range fc.currentProviderHashesConsensus {
-			err := fc.discrepancyChecker(finalizedBlocks, consensus)
+			err := fc.discrepancyChecker(finalizedBlocks, consensus.FinalizedBlocksHashes)
 			if err != nil {
-				// TODO: bring the other data as proof
-				finalizationConflict = &conflicttypes.FinalizationConflict{RelayReply0: reply}
+				if finalizationConflict == nil {
+					finalizationConflict = fc.finalizationConflictProof(finalizedBlocks, consensus, req, reply)
+				}
 				// we need to insert into a new consensus group before returning
 				// or create new consensus group if no consensus matched
+				conflictErr = err
 				continue
 			}
 
@@ -134,18 +143,17 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 			newHashConsensus := fc.newProviderHashesConsensus(blockDistanceForFinalizedData, providerAddress, latestBlock, finalizedBlocks, reply, req)
 			fc.currentProviderHashesConsensus = append(fc.currentProviderHashesConsensus, newHashConsensus)
 		}
-		if finalizationConflict != nil {
-			// means there was a conflict and we need to report
-			return finalizationConflict, utils.LavaFormatError("Simulation: Conflict found in discrepancyChecker", err)
+		if conflictErr != nil {
+			// means there was a conflict and we need to report, the proof is nil if no single provider signed the conflicting hash
+			return finalizationConflict, utils.LavaFormatError("Simulation: Conflict found in discrepancyChecker", conflictErr)
 		}
 
 		// check for discrepancy with old epoch
 		for idx, consensus := range fc.prevEpochProviderHashesConsensus {
-			err := fc.discrepancyChecker(finalizedBlocks, consensus)
+			err := fc.discrepancyChecker(finalizedBlocks, consensus.FinalizedBlocksHashes)
 			if err != nil {
-				// TODO: bring the other data as proof
-				finalizationConflict = &conflicttypes.FinalizationConflict{RelayReply0: reply}
-				return finalizationConflict, utils.LavaFormatError("Simulation: prev epoch Conflict found in discrepancyChecker", err, utils.Attribute{Key: "Consensus idx", Value: strconv.Itoa(idx)}, utils.Attribute{Key: "provider", Value: providerAddress})
+				// replies from different epochs can't be used as proof
+				return nil, utils.LavaFormatError("Simulation: prev epoch Conflict found in discrepancyChecker", err, utils.Attribute{Key: "Consensus idx", Value: strconv.Itoa(idx)}, utils.Attribute{Key: "provider", Value: providerAddress})
 			}
 		}
 	}
@@ -155,15 +163,15 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 	return finalizationConflict, nil
 }
 
-func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]string, consensus ProviderHashesConsensus) (errRet error) {
+func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]string, finalizedBlocksB map[int64]string) (errRet error) {
 	var toIterate map[int64]string   // the smaller map between the two to compare
 	var otherBlocks map[int64]string // the other map
 
-	if len(finalizedBlocksA) < len(consensus.FinalizedBlocksHashes) {
+	if len(finalizedBlocksA) < len(finalizedBlocksB) {
 		toIterate = finalizedBlocksA
-		otherBlocks = consensus.FinalizedBlocksHashes
+		otherBlocks = finalizedBlocksB
 	} else {
-		toIterate = consensus.FinalizedBlocksHashes
+		toIterate = finalizedBlocksB
 		otherBlocks = finalizedBlocksA
 	}
 	// Iterate over smaller array, looks for mismatching hashes between the inputs
@@ -179,6 +187,33 @@ func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]s
 	return nil
 }
 
+// finalizationConflictProof pairs the conflicting reply with the reply of a provider in the consensus group that signed a different hash
+func (fc *FinalizationConsensus) finalizationConflictProof(finalizedBlocks map[int64]string, consensus ProviderHashesConsensus, req *pairingtypes.RelaySession, reply *pairingtypes.RelayReply) *conflicttypes.FinalizationConflict {
+	for _, providerData := range consensus.agreeingProviders {
+		if providerData.RelaySession == nil || providerData.Reply == nil {
+			continue
+		}
+		if fc.discrepancyChecker(finalizedBlocks, providerData.FinalizedBlocksHashes) != nil {
+			return &conflicttypes.FinalizationConflict{
+				RelayReply0:   finalizationProofReply(reply),
+				RelaySession0: req,
+				RelayReply1:   providerData.Reply,
+				RelaySession1: providerData.RelaySession,
+			}
+		}
+	}
+	return nil
+}
+
+// the finalization signature only covers these fields, the rest of the reply is not needed as proof
+func finalizationProofReply(reply *pairingtypes.RelayReply) *pairingtypes.RelayReply {
+	return &pairingtypes.RelayReply{
+		LatestBlock:           reply.LatestBlock,
+		FinalizedBlocksHashes: reply.FinalizedBlocksHashes,
+		SigBlocks:             reply.SigBlocks,
+	}
+}
+
 func (fc *FinalizationConsensus) NewEpoch(epoch uint64) {
 	fc.providerDataContainersMu.Lock()
 	defer fc.providerDataContainersMu.Unlock()
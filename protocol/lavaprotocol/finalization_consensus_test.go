@@ -142,11 +142,17 @@ func TestConsensusHashesInsertion(t *testing.T) {
 				finalizationConsensus.NewEpoch(epoch)
 				// check updating hashes works
 				for _, insertion := range play.finalizationInsertions {
-					_, err := finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), insertion.providerAddr, insertion.finalizedBlocks, insertion.relaySession, insertion.relayReply)
+					finalizationConflict, err := finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), insertion.providerAddr, insertion.finalizedBlocks, insertion.relaySession, insertion.relayReply)
 					if insertion.success {
 						require.NoError(t, err, "failed insertion when was supposed to succeed, provider %s, latest block %d", insertion.providerAddr, insertion.latestBlock)
 					} else {
 						require.Error(t, err)
+						// the proof has the conflicting reply and the reply it conflicts with
+						require.NotNil(t, finalizationConflict)
+						require.Equal(t, insertion.relaySession, finalizationConflict.RelaySession0)
+						require.NotNil(t, finalizationConflict.RelaySession1)
+						require.NotNil(t, finalizationConflict.RelayReply1)
+						require.Equal(t, insertion.relayReply.LatestBlock, finalizationConflict.RelayReply0.LatestBlock)
 					}
 				}
 				require.Len(t, finalizationConsensus.currentProviderHashesConsensus, play.consensusHashesCount)
@@ -62,7 +62,7 @@ func TestSignAndExtractResponse(t *testing.T) {
 	require.NoError(t, err)
 	err = VerifyRelayReply(ctx, reply, relay, provider_address.String())
 	require.NoError(t, err)
-	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+	_, err = VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
 	require.NoError(t, err)
 }
 
@@ -113,6 +113,6 @@ func TestSignAndExtractResponseLatest(t *testing.T) {
 	require.NoError(t, err)
 	err = VerifyRelayReply(ctx, reply, relay, provider_address.String())
 	require.NoError(t, err)
-	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+	_, err = VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
 	require.NoError(t, err)
 }
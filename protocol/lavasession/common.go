@@ -57,6 +57,12 @@ func IsSessionSyncLoss(err error) bool {
 	return code == codes.Code(SessionOutOfSyncError.ABCICode())
 }
 
+// a rate limited relay is not a provider fault, the consumer should try another provider
+func IsRateLimited(err error) bool {
+	code := status.Code(err)
+	return code == codes.Code(RateLimitedError.ABCICode())
+}
+
 func ConnectgRPCClient(ctx context.Context, address string, allowInsecure bool) (*grpc.ClientConn, error) {
 	var tlsConf tls.Config
 	if allowInsecure {
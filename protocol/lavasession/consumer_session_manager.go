@@ -2,6 +2,7 @@ package lavasession
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -332,8 +333,11 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 		currentEpoch: csm.atomicReadCurrentEpoch(),
 	}
 
+	// relays with a pin key are sent to the provider pinned to it when the strategy is privacy
+	pinKey := getProviderPinKey(ctx)
+
 	// Get a valid consumerSessionsWithProvider
-	sessionWithProviderMap, err := csm.getValidConsumerSessionsWithProvider(tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch)
+	sessionWithProviderMap, err := csm.getValidConsumerSessionsWithProvider(tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch, pinKey)
 	if err != nil {
 		return nil, err
 	}
@@ -455,7 +459,7 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 		}
 
 		// If we do not have enough fetch more
-		sessionWithProviderMap, err = csm.getValidConsumerSessionsWithProvider(tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch)
+		sessionWithProviderMap, err = csm.getValidConsumerSessionsWithProvider(tempIgnoredProviders, cuNeededForSession, requestedBlock, addon, extensionNames, stateful, virtualEpoch, pinKey)
 
 		// If error exists but we have sessions, return them
 		if err != nil && len(sessions) != 0 {
@@ -470,7 +474,7 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 }
 
 // Get a valid provider address.
-func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersList map[string]struct{}, cu uint64, requestedBlock int64, addon string, extensions []string, stateful uint32) (addresses []string, err error) {
+func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersList map[string]struct{}, cu uint64, requestedBlock int64, addon string, extensions []string, stateful uint32, pinKey string) (addresses []string, err error) {
 	// cs.Lock must be Rlocked here.
 	ignoredProvidersListLength := len(ignoredProvidersList)
 	validAddresses := csm.getValidAddresses(addon, extensions)
@@ -493,6 +497,8 @@ func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersLis
 	var providers []string
 	if stateful == common.CONSISTENCY_SELECT_ALLPROVIDERS && csm.providerOptimizer.Strategy() != provideroptimizer.STRATEGY_COST {
 		providers = GetAllProviders(validAddresses, ignoredProvidersList)
+	} else if pinKey != "" && csm.providerOptimizer.Strategy() == provideroptimizer.STRATEGY_PRIVACY {
+		providers = []string{csm.providerOptimizer.ChoosePinnedProvider(validAddresses, ignoredProvidersList, pinKey)}
 	} else {
 		providers = csm.providerOptimizer.ChooseProvider(validAddresses, ignoredProvidersList, cu, requestedBlock, OptimizerPerturbation)
 	}
@@ -517,7 +523,7 @@ func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersLis
 	return providers, nil
 }
 
-func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredProviders *ignoredProviders, cuNeededForSession uint64, requestedBlock int64, addon string, extensions []string, stateful uint32, virtualEpoch uint64) (sessionWithProviderMap SessionWithProviderMap, err error) {
+func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredProviders *ignoredProviders, cuNeededForSession uint64, requestedBlock int64, addon string, extensions []string, stateful uint32, virtualEpoch uint64, pinKey string) (sessionWithProviderMap SessionWithProviderMap, err error) {
 	csm.lock.RLock()
 	defer csm.lock.RUnlock()
 	if debug {
@@ -531,7 +537,7 @@ func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredP
 	}
 
 	// Fetch provider addresses
-	providerAddresses, err := csm.getValidProviderAddresses(ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful)
+	providerAddresses, err := csm.getValidProviderAddresses(ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful, pinKey)
 	if err != nil {
 		utils.LavaFormatError("could not get a provider addresses", err)
 		return nil, err
@@ -580,7 +586,7 @@ func (csm *ConsumerSessionManager) getValidConsumerSessionsWithProvider(ignoredP
 		}
 
 		// If we do not have enough fetch more
-		providerAddresses, err = csm.getValidProviderAddresses(ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful)
+		providerAddresses, err = csm.getValidProviderAddresses(ignoredProviders.providers, cuNeededForSession, requestedBlock, addon, extensions, stateful, pinKey)
 
 		// If error exists but we have providers, return them
 		if err != nil && len(sessionWithProviderMap) != 0 {
@@ -839,6 +845,66 @@ func (csm *ConsumerSessionManager) GetReportedProviders(epoch uint64) []*pairing
 	return csm.reportedProviders.GetReportedProviders()
 }
 
+func (csm *ConsumerSessionManager) Strategy() provideroptimizer.Strategy {
+	return csm.providerOptimizer.Strategy()
+}
+
+// the latency under which the given percentile of recent relays were answered, 0 if there is not enough data
+func (csm *ConsumerSessionManager) LatencyPercentile(cu uint64, percentile float64) time.Duration {
+	return csm.providerOptimizer.LatencyPercentile(cu, percentile)
+}
+
+// a snapshot of the pairing and the provider selection state, used for debugging provider selection
+func (csm *ConsumerSessionManager) GetDebugInfo() *ConsumerSessionManagerDebugInfo {
+	csm.lock.RLock()
+	defer csm.lock.RUnlock()
+	validAddresses := make(map[string]struct{}, len(csm.validAddresses))
+	for _, address := range csm.validAddresses {
+		validAddresses[address] = struct{}{}
+	}
+	debugInfo := &ConsumerSessionManagerDebugInfo{
+		ChainID:           csm.rpcEndpoint.ChainID,
+		ApiInterface:      csm.rpcEndpoint.ApiInterface,
+		Epoch:             csm.atomicReadCurrentEpoch(),
+		ValidAddresses:    append([]string{}, csm.validAddresses...),
+		BlockedAddresses:  []string{},
+		AddonAddresses:    make(map[string][]string, len(csm.addonAddresses)),
+		ReportedProviders: csm.reportedProviders.GetReportedProviders(),
+		Providers:         make([]*ProviderDebugInfo, 0, len(csm.pairing)),
+	}
+	for routerKey, addresses := range csm.addonAddresses {
+		debugInfo.AddonAddresses[string(routerKey)] = addresses
+	}
+	for providerAddress, consumerSessionsWithProvider := range csm.pairing {
+		_, valid := validAddresses[providerAddress]
+		if !valid {
+			debugInfo.BlockedAddresses = append(debugInfo.BlockedAddresses, providerAddress)
+		}
+		providerDebugInfo := &ProviderDebugInfo{
+			Address:          providerAddress,
+			Blocked:          !valid,
+			MaxComputeUnits:  consumerSessionsWithProvider.MaxComputeUnits,
+			UsedComputeUnits: consumerSessionsWithProvider.atomicReadUsedComputeUnits(),
+			Scores:           csm.providerOptimizer.GetExcellenceQoSReportForProvider(providerAddress),
+		}
+		consumerSessionsWithProvider.Lock.RLock()
+		for _, endpoint := range consumerSessionsWithProvider.Endpoints {
+			providerDebugInfo.Endpoints = append(providerDebugInfo.Endpoints, &EndpointDebugInfo{
+				NetworkAddress:     endpoint.NetworkAddress,
+				Enabled:            endpoint.Enabled,
+				ConnectionRefusals: endpoint.ConnectionRefusals,
+			})
+		}
+		consumerSessionsWithProvider.Lock.RUnlock()
+		debugInfo.Providers = append(debugInfo.Providers, providerDebugInfo)
+	}
+	sort.Strings(debugInfo.BlockedAddresses)
+	sort.Slice(debugInfo.Providers, func(i, j int) bool {
+		return debugInfo.Providers[i].Address < debugInfo.Providers[j].Address
+	})
+	return debugInfo
+}
+
 // Data Reliability Section:
 
 // Atomically read csm.pairingAddressesLength for data reliability.
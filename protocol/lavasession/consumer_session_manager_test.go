@@ -45,7 +45,7 @@ func CreateConsumerSessionManager() *ConsumerSessionManager {
 	AllowInsecureConnectionToProviders = true // set to allow insecure for tests purposes
 	rand.InitRandomSeed()
 	baseLatency := common.AverageWorldLatency / 2 // we want performance to be half our timeout or better
-	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", false, "/", 0}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil)
+	return NewConsumerSessionManager(&RPCEndpoint{"stub", "stub", "stub", false, "/", 0, nil, nil, nil, nil}, provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, 0, baseLatency, 1), nil)
 }
 
 var grpcServer *grpc.Server
@@ -545,6 +545,35 @@ func TestSessionFailureAndGetReportedProviders(t *testing.T) {
 	}
 }
 
+func TestGetDebugInfo(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.NoError(t, err)
+	css, err := csm.GetSessions(ctx, cuForFirstRequest, nil, servicedBlockNumber, "", nil, common.NOSTATE, 0) // get a session
+	require.NoError(t, err)
+	blockedProvider := ""
+	for providerAddress, cs := range css {
+		blockedProvider = providerAddress
+		err = csm.OnSessionFailure(cs.Session, ReportAndBlockProviderError)
+		require.NoError(t, err)
+	}
+
+	debugInfo := csm.GetDebugInfo()
+	require.Equal(t, uint64(firstEpochHeight), debugInfo.Epoch)
+	require.Len(t, debugInfo.Providers, numberOfProviders)
+	require.Len(t, debugInfo.ValidAddresses, numberOfProviders-1)
+	require.Equal(t, []string{blockedProvider}, debugInfo.BlockedAddresses)
+	require.Len(t, debugInfo.ReportedProviders, 1)
+	require.Equal(t, blockedProvider, debugInfo.ReportedProviders[0].Address)
+	for _, providerDebugInfo := range debugInfo.Providers {
+		require.Equal(t, providerDebugInfo.Address == blockedProvider, providerDebugInfo.Blocked)
+		require.Equal(t, uint64(200), providerDebugInfo.MaxComputeUnits)
+		require.Len(t, providerDebugInfo.Endpoints, 1)
+	}
+}
+
 // Test the basic functionality of the consumerSessionManager
 func TestSessionFailureEpochMisMatch(t *testing.T) {
 	ctx := context.Background()
@@ -762,7 +791,7 @@ func TestNoPairingsError(t *testing.T) {
 	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
 	require.NoError(t, err)
 	time.Sleep(5 * time.Millisecond) // let probes finish
-	_, err = csm.getValidProviderAddresses(map[string]struct{}{}, 10, 100, "invalid", nil, common.NOSTATE)
+	_, err = csm.getValidProviderAddresses(map[string]struct{}{}, 10, 100, "invalid", nil, common.NOSTATE, "")
 	require.Error(t, err)
 	require.True(t, PairingListEmptyError.Is(err))
 }
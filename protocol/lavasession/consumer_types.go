@@ -38,10 +38,24 @@ type ProviderOptimizer interface {
 	AppendRelayFailure(providerAddress string)
 	AppendRelayData(providerAddress string, latency time.Duration, isHangingApi bool, cu, syncBlock uint64)
 	ChooseProvider(allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64, perturbationPercentage float64) (addresses []string)
+	ChoosePinnedProvider(allAddresses []string, ignoredProviders map[string]struct{}, pinKey string) (address string)
 	GetExcellenceQoSReportForProvider(string) *pairingtypes.QualityOfServiceReport
+	LatencyPercentile(cu uint64, percentile float64) time.Duration
 	Strategy() provideroptimizer.Strategy
 }
 
+type providerPinKeyCtxKey struct{}
+
+// relays sent with a pin key are sent to the same provider while it is valid, used by the privacy strategy
+func WithProviderPinKey(ctx context.Context, pinKey string) context.Context {
+	return context.WithValue(ctx, providerPinKeyCtxKey{}, pinKey)
+}
+
+func getProviderPinKey(ctx context.Context) string {
+	pinKey, _ := ctx.Value(providerPinKeyCtxKey{}).(string)
+	return pinKey
+}
+
 type ignoredProviders struct {
 	providers    map[string]struct{}
 	currentEpoch uint64
@@ -90,6 +104,32 @@ type Endpoint struct {
 	Geolocation        planstypes.Geolocation
 }
 
+type ConsumerSessionManagerDebugInfo struct {
+	ChainID           string                           `json:"chain_id"`
+	ApiInterface      string                           `json:"api_interface"`
+	Epoch             uint64                           `json:"epoch"`
+	ValidAddresses    []string                         `json:"valid_addresses"`
+	BlockedAddresses  []string                         `json:"blocked_addresses"` // paired providers that were removed from the valid addresses
+	AddonAddresses    map[string][]string              `json:"addon_addresses"`
+	ReportedProviders []*pairingtypes.ReportedProvider `json:"reported_providers"`
+	Providers         []*ProviderDebugInfo             `json:"providers"`
+}
+
+type ProviderDebugInfo struct {
+	Address          string                               `json:"address"`
+	Blocked          bool                                 `json:"blocked"`
+	MaxComputeUnits  uint64                               `json:"max_compute_units"`
+	UsedComputeUnits uint64                               `json:"used_compute_units"`
+	Scores           *pairingtypes.QualityOfServiceReport `json:"scores"` // the optimizer's latency, availability and sync scores, nil if there is no data yet
+	Endpoints        []*EndpointDebugInfo                 `json:"endpoints"`
+}
+
+type EndpointDebugInfo struct {
+	NetworkAddress     string `json:"network_address"`
+	Enabled            bool   `json:"enabled"`
+	ConnectionRefusals uint64 `json:"connection_refusals"`
+}
+
 type SessionWithProvider struct {
 	SessionsWithProvider *ConsumerSessionsWithProvider
 	CurrentEpoch         uint64
@@ -98,12 +138,27 @@ type SessionWithProvider struct {
 type SessionWithProviderMap map[string]*SessionWithProvider
 
 type RPCEndpoint struct {
-	NetworkAddress  string `yaml:"network-address,omitempty" json:"network-address,omitempty" mapstructure:"network-address"` // HOST:PORT
-	ChainID         string `yaml:"chain-id,omitempty" json:"chain-id,omitempty" mapstructure:"chain-id"`                      // spec chain identifier
-	ApiInterface    string `yaml:"api-interface,omitempty" json:"api-interface,omitempty" mapstructure:"api-interface"`
-	TLSEnabled      bool   `yaml:"tls-enabled,omitempty" json:"tls-enabled,omitempty" mapstructure:"tls-enabled"`
-	HealthCheckPath string `yaml:"health-check-path,omitempty" json:"health-check-path,omitempty" mapstructure:"health-check-path"` // health check status code 200 path, default is "/"
-	Geolocation     uint64 `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
+	NetworkAddress  string         `yaml:"network-address,omitempty" json:"network-address,omitempty" mapstructure:"network-address"` // HOST:PORT
+	ChainID         string         `yaml:"chain-id,omitempty" json:"chain-id,omitempty" mapstructure:"chain-id"`                      // spec chain identifier
+	ApiInterface    string         `yaml:"api-interface,omitempty" json:"api-interface,omitempty" mapstructure:"api-interface"`
+	TLSEnabled      bool           `yaml:"tls-enabled,omitempty" json:"tls-enabled,omitempty" mapstructure:"tls-enabled"`
+	HealthCheckPath string         `yaml:"health-check-path,omitempty" json:"health-check-path,omitempty" mapstructure:"health-check-path"` // health check status code 200 path, default is "/"
+	Geolocation     uint64         `yaml:"geolocation,omitempty" json:"geolocation,omitempty" mapstructure:"geolocation"`
+	ApiQuorum       map[string]int `yaml:"api-quorum,omitempty" json:"api-quorum,omitempty" mapstructure:"api-quorum"` // api name -> number of providers that need to agree on its reply
+	// method names or prefixes ending with *, when set only matching methods are relayed
+	AllowedMethods []string `yaml:"allowed-methods,omitempty" json:"allowed-methods,omitempty" mapstructure:"allowed-methods"`
+	// method names or prefixes ending with *, these are rejected even if allowed
+	DeniedMethods     []string           `yaml:"denied-methods,omitempty" json:"denied-methods,omitempty" mapstructure:"denied-methods"`
+	MethodConstraints []MethodConstraint `yaml:"method-constraints,omitempty" json:"method-constraints,omitempty" mapstructure:"method-constraints"`
+}
+
+// MethodConstraint rejects requests of the matching methods whose params exceed the limits.
+// params are located by a path of indexes and keys, for example ["0", "fromBlock"] for the filter object of eth_getLogs
+type MethodConstraint struct {
+	Method         string   `yaml:"method,omitempty" json:"method,omitempty" mapstructure:"method"` // method name or a prefix ending with *
+	FromBlockParam []string `yaml:"from-block-param,omitempty" json:"from-block-param,omitempty" mapstructure:"from-block-param"`
+	ToBlockParam   []string `yaml:"to-block-param,omitempty" json:"to-block-param,omitempty" mapstructure:"to-block-param"`
+	MaxBlockRange  uint64   `yaml:"max-block-range,omitempty" json:"max-block-range,omitempty" mapstructure:"max-block-range"` // max number of blocks between the from and to blocks, inclusive
 }
 
 func (endpoint *RPCEndpoint) String() (retStr string) {
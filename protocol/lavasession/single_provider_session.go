@@ -32,6 +32,14 @@ func (sps *SingleProviderSession) IsPayingRelay() bool {
 	return sps.LatestRelayCu > 0
 }
 
+// returns the project of the consumer this session belongs to
+func (sps *SingleProviderSession) GetProjectId() string {
+	if sps.userSessionsParent == nil {
+		return ""
+	}
+	return sps.userSessionsParent.consumersProjectId
+}
+
 func (sps *SingleProviderSession) IsBadgeSession() bool {
 	return sps.BadgeUserData != nil
 }
@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
@@ -66,6 +68,15 @@ func ParseBlockFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser)
 	return rpcInput.ParseBlock(resString)
 }
 
+// this function returns the value of a request param as a string
+func ParseFromParams(rpcInput RPCInput, blockParser spectypes.BlockParser) (string, error) {
+	result, err := parse(rpcInput, blockParser, PARSE_PARAMS)
+	if err != nil || result == nil {
+		return "", err
+	}
+	return blockInterfaceToString(result[0]), nil
+}
+
 // This returns the parsed response without decoding
 func ParseFromReply(rpcInput RPCInput, blockParser spectypes.BlockParser) (string, error) {
 	result, err := parse(rpcInput, blockParser, PARSE_RESULT)
@@ -151,21 +162,51 @@ func parseResponseByEncoding(rawResult []byte, encoding string) (string, error)
 	case spectypes.EncodingBase64:
 		return string(rawResult), nil
 	case spectypes.EncodingHex:
-		hexString := strings.TrimPrefix(string(rawResult), "0x") // some protocols return 0x in their hex responses
-		if len(hexString)%2 != 0 {
-			// some hashes are hex but can't be encoded as base 64 without passing
-			hexString = "0" + hexString
+		hexBytes, err := decodeHex(rawResult)
+		if err != nil {
+			return "", err
 		}
-		hexBytes, err := hex.DecodeString(hexString)
+		return base64.StdEncoding.EncodeToString(hexBytes), nil
+	case spectypes.EncodingHexLittleEndian:
+		hexBytes, err := decodeHex(rawResult)
 		if err != nil {
-			return "", utils.LavaFormatError("tried decoding a hex response in parseResponseByEncoding but failed", err, utils.Attribute{Key: "data", Value: hexString})
+			return "", err
+		}
+		for i, j := 0, len(hexBytes)-1; i < j; i, j = i+1, j-1 {
+			hexBytes[i], hexBytes[j] = hexBytes[j], hexBytes[i]
 		}
 		return base64.StdEncoding.EncodeToString(hexBytes), nil
+	case spectypes.EncodingBech32:
+		_, decoded, err := bech32.DecodeAndConvert(string(rawResult))
+		if err != nil {
+			return "", utils.LavaFormatError("tried decoding a bech32 response in parseResponseByEncoding but failed", err, utils.Attribute{Key: "data", Value: string(rawResult)})
+		}
+		return base64.StdEncoding.EncodeToString(decoded), nil
+	case spectypes.EncodingBase58:
+		decoded := base58.Decode(string(rawResult))
+		if len(decoded) == 0 && len(rawResult) != 0 {
+			// base58.Decode returns an empty slice on invalid characters
+			return "", utils.LavaFormatError("tried decoding a base58 response in parseResponseByEncoding but failed", nil, utils.Attribute{Key: "data", Value: string(rawResult)})
+		}
+		return base64.StdEncoding.EncodeToString(decoded), nil
 	default:
 		return string(rawResult), nil
 	}
 }
 
+func decodeHex(rawResult []byte) ([]byte, error) {
+	hexString := strings.TrimPrefix(string(rawResult), "0x") // some protocols return 0x in their hex responses
+	if len(hexString)%2 != 0 {
+		// some hashes are hex but can't be encoded as base 64 without passing
+		hexString = "0" + hexString
+	}
+	hexBytes, err := hex.DecodeString(hexString)
+	if err != nil {
+		return nil, utils.LavaFormatError("tried decoding a hex response in parseResponseByEncoding but failed", err, utils.Attribute{Key: "data", Value: hexString})
+	}
+	return hexBytes, nil
+}
+
 // Move to RPCInput
 func getDataToParse(rpcInput RPCInput, dataSource int) (interface{}, error) {
 	switch dataSource {
@@ -144,6 +144,25 @@ func TestParseResponseByEncoding(t *testing.T) {
 	// returned form evmos evm-jsonrpc vs rest
 	testData = []data{{bytes: []byte("0x968ec00fd34eedc03b0577ee8116f74c75127b7d775e51c7a72519f760b821a8"), encoding: spectypes.EncodingHex}, {bytes: []byte("lo7AD9NO7cA7BXfugRb3THUSe313XlHHpyUZ92C4Iag="), encoding: spectypes.EncodingBase64}}
 	testInputs(testData)
+	// byte reversed hex, bitcoin style block hashes
+	testData = []data{{bytes: []byte("1dc93281cc342f8bd6dbe82287f0e752c413eff037026e9a4f25ae36c0ed9192"), encoding: spectypes.EncodingHexLittleEndian}, {bytes: []byte("kpHtwDauJU+abgI38O8TxFLn8Ici6NvWiy80zIEyyR0="), encoding: spectypes.EncodingBase64}}
+	testInputs(testData)
+	testData = []data{{bytes: []byte("lava1j2g7mspk4cj5lxnwqgmlpmcnc3fw0uy8yt5dh45t9u6veqfjeyws22hvfr"), encoding: spectypes.EncodingBech32}, {bytes: []byte("9291EDC036AE254F9A6E0237F0EF13C452E7F08722E8DBD68B2F34CC8132C91D"), encoding: spectypes.EncodingHex}}
+	testInputs(testData)
+	// solana style block hashes
+	testData = []data{{bytes: []byte("As9asU7fJqHDX7affXL5aKCpPRZ4sRi6ukWRZbj8CsUG"), encoding: spectypes.EncodingBase58}, {bytes: []byte("kpHtwDauJU+abgI38O8TxFLn8Ici6NvWiy80zIEyyR0="), encoding: spectypes.EncodingBase64}}
+	testInputs(testData)
+
+	// invalid inputs
+	for _, invalid := range []data{
+		{bytes: []byte("0xnothex"), encoding: spectypes.EncodingHex},
+		{bytes: []byte("0xnothex"), encoding: spectypes.EncodingHexLittleEndian},
+		{bytes: []byte("lava1j2g7mspk4cj5lxnwqgmlpmcnc3fw0uy8yt5dh45t9u6veqfjeyws22hvfx"), encoding: spectypes.EncodingBech32},
+		{bytes: []byte("0OIl"), encoding: spectypes.EncodingBase58},
+	} {
+		_, err := parseResponseByEncoding(invalid.bytes, invalid.encoding)
+		require.Error(t, err, invalid.encoding)
+	}
 }
 
 func TestParseBlockHappyFlow(t *testing.T) {
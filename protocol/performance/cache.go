@@ -2,15 +2,38 @@ package performance
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/lavanet/lava/ecosystem/cache/format"
+	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	CacheHealthCheckInterval = 10 * time.Second
+	cacheHealthCheckTimeout  = time.Second
+	CacheAddressesSeparator  = ","
+)
+
+type cacheNode struct {
+	address string
+	client  pairingtypes.RelayerCacheClient // nil until connected
+	healthy bool
+}
+
+// Cache is a client of one or more cache servers, entries are sharded between the servers with consistent hashing.
+// servers that fail their health check are removed from the ring until they recover, their keys move to the next servers on the ring
 type Cache struct {
-	client  pairingtypes.RelayerCacheClient
+	lock    sync.RWMutex
+	nodes   map[string]*cacheNode
+	ring    *hashRing // contains only the healthy nodes
 	address string
 }
 
@@ -27,25 +50,195 @@ func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string
 	return &c, nil
 }
 
+// addr is a comma separated list of cache servers, an error is returned if none of them could be connected.
+// servers that fail to connect are retried on every health check
 func InitCache(ctx context.Context, addr string) (*Cache, error) {
-	relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, addr)
+	cache := &Cache{nodes: map[string]*cacheNode{}, ring: newHashRing(nil), address: addr}
+	var connectErr error
+	for _, address := range strings.Split(addr, CacheAddressesSeparator) {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+		if err := cache.addNode(ctx, address); err != nil {
+			connectErr = err
+		}
+	}
+	go cache.healthCheckLoop(ctx)
+	if len(cache.ring.hashes) == 0 {
+		if connectErr == nil {
+			connectErr = NotConnectedError.Wrapf("no cache address in: %s", addr)
+		}
+		return cache, connectErr
+	}
+	return cache, nil
+}
+
+// AddServer adds a cache server to the ring, keys are rebalanced so only the keys the new server owns move to it
+func (cache *Cache) AddServer(ctx context.Context, address string) error {
+	if cache == nil {
+		return NotInitialisedError
+	}
+	return cache.addNode(ctx, address)
+}
+
+func (cache *Cache) addNode(ctx context.Context, address string) error {
+	cache.lock.Lock()
+	if _, ok := cache.nodes[address]; ok {
+		cache.lock.Unlock()
+		return nil
+	}
+	node := &cacheNode{address: address}
+	cache.nodes[address] = node
+	cache.lock.Unlock()
+	relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, address)
 	if err != nil {
-		return &Cache{client: nil, address: addr}, err
+		utils.LavaFormatWarning("failed connecting to cache server, will retry", err, utils.Attribute{Key: "address", Value: address})
+		return err
+	}
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	node.client = *relayerCacheClient
+	node.healthy = true
+	cache.rebuildRing()
+	return nil
+}
+
+// assumes the lock is held
+func (cache *Cache) rebuildRing() {
+	addresses := []string{}
+	for address, node := range cache.nodes {
+		if node.healthy {
+			addresses = append(addresses, address)
+		}
+	}
+	cache.ring = newHashRing(addresses)
+}
+
+func (cache *Cache) setNodeHealth(address string, healthy bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	node, ok := cache.nodes[address]
+	if !ok || node.healthy == healthy {
+		return
+	}
+	node.healthy = healthy
+	cache.rebuildRing()
+	if healthy {
+		utils.LavaFormatInfo("cache server is healthy, adding it back to the ring", utils.Attribute{Key: "address", Value: address})
+	} else {
+		utils.LavaFormatWarning("cache server is unhealthy, removing it from the ring", nil, utils.Attribute{Key: "address", Value: address})
+	}
+}
+
+func (cache *Cache) healthCheckLoop(ctx context.Context) {
+	ticker := time.NewTicker(CacheHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cache.checkNodesHealth(ctx)
+		}
 	}
-	cache := Cache{client: *relayerCacheClient, address: addr}
-	return &cache, nil
+}
+
+func (cache *Cache) checkNodesHealth(ctx context.Context) {
+	cache.lock.RLock()
+	nodes := make([]cacheNode, 0, len(cache.nodes))
+	for _, node := range cache.nodes {
+		nodes = append(nodes, *node)
+	}
+	cache.lock.RUnlock()
+	for _, node := range nodes {
+		if node.client == nil {
+			// never connected, try again
+			relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, node.address)
+			if err != nil {
+				continue
+			}
+			cache.lock.Lock()
+			cache.nodes[node.address].client = *relayerCacheClient
+			cache.lock.Unlock()
+			cache.setNodeHealth(node.address, true)
+			continue
+		}
+		healthCtx, cancel := context.WithTimeout(ctx, cacheHealthCheckTimeout)
+		_, err := node.client.Health(healthCtx, &emptypb.Empty{})
+		cancel()
+		cache.setNodeHealth(node.address, err == nil)
+	}
+}
+
+// returns the clients of the nodes responsible for the key, by order of preference
+func (cache *Cache) clientsForKey(key string) []*cacheNode {
+	cache.lock.RLock()
+	defer cache.lock.RUnlock()
+	// the owner and the next node on the ring for failover
+	addresses := cache.ring.get(key, 2)
+	nodes := make([]*cacheNode, 0, len(addresses))
+	for _, address := range addresses {
+		nodes = append(nodes, &cacheNode{address: address, client: cache.nodes[address].client})
+	}
+	return nodes
+}
+
+// the key entries are sharded by, it is the key the cache server stores the entry with except for the requested and seen blocks,
+// latest block requests are resolved on the cache server so all the blocks of a request must be on the same server
+func cacheShardKey(request *pairingtypes.RelayPrivateData, chainID string, provider string) string {
+	inputFormatter, _ := format.FormatterForRelayRequestAndResponse(request.ApiInterface)
+	shardRequest := pairingtypes.RelayPrivateData{
+		ConnectionType: request.ConnectionType,
+		ApiUrl:         format.FormatterForRelayRequestApiUrl(request.ApiInterface)(request.ApiUrl),
+		Data:           inputFormatter(request.Data),
+		ApiInterface:   request.ApiInterface,
+		Metadata:       request.Metadata,
+		Addon:          request.Addon,
+		Extensions:     request.Extensions,
+	}
+	return chainID + ";" + shardRequest.String() + ";" + provider
+}
+
+// returns true for call errors meaning the cache server is unreachable or broken (e.g. a timeout or a reset connection)
+// rather than an error of the request itself such as a cache miss
+func isCacheNodeFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+// runs the call on the node owning the key, and on the next node if the owner is unreachable
+func (cache *Cache) callShard(ctx context.Context, key string, call func(client pairingtypes.RelayerCacheClient) error) error {
+	nodes := cache.clientsForKey(key)
+	if len(nodes) == 0 {
+		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	}
+	var err error
+	for _, node := range nodes {
+		err = call(node.client)
+		// a call cancelled or timed out by the caller isn't the server's fault
+		if !isCacheNodeFailure(err) || ctx.Err() != nil {
+			return err
+		}
+		cache.setNodeHealth(node.address, false)
+	}
+	return err
 }
 
 func (cache *Cache) GetEntry(ctx context.Context, request *pairingtypes.RelayPrivateData, blockHash []byte, chainID string, finalized bool, provider string) (reply *pairingtypes.CacheRelayReply, err error) {
 	if cache == nil {
-		// TODO: try to connect again once in a while
 		return nil, NotInitialisedError
 	}
-	if cache.client == nil {
-		return nil, NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
-	}
-	// TODO: handle disconnections and error types here
-	return cache.client.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, BlockHash: blockHash, ChainID: chainID, Finalized: finalized, Provider: provider})
+	err = cache.callShard(ctx, cacheShardKey(request, chainID, provider), func(client pairingtypes.RelayerCacheClient) error {
+		var errGet error
+		reply, errGet = client.GetRelay(ctx, &pairingtypes.RelayCacheGet{Request: request, BlockHash: blockHash, ChainID: chainID, Finalized: finalized, Provider: provider})
+		return errGet
+	})
+	return reply, err
 }
 
 func (cache *Cache) CacheActive() bool {
@@ -54,21 +247,18 @@ func (cache *Cache) CacheActive() bool {
 
 func (cache *Cache) SetEntry(ctx context.Context, request *pairingtypes.RelayPrivateData, blockHash []byte, chainID string, reply *pairingtypes.RelayReply, finalized bool, provider string, optionalMetadata []pairingtypes.Metadata) error {
 	if cache == nil {
-		// TODO: try to connect again once in a while
 		return NotInitialisedError
 	}
-	if cache.client == nil {
-		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
-	}
-	// TODO: handle disconnections and SetRelay error types here
-	_, err := cache.client.SetRelay(ctx, &pairingtypes.RelayCacheSet{
-		Request:          request,
-		BlockHash:        blockHash,
-		ChainID:          chainID,
-		Response:         reply,
-		Finalized:        finalized,
-		Provider:         provider,
-		OptionalMetadata: optionalMetadata,
+	return cache.callShard(ctx, cacheShardKey(request, chainID, provider), func(client pairingtypes.RelayerCacheClient) error {
+		_, err := client.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			Request:          request,
+			BlockHash:        blockHash,
+			ChainID:          chainID,
+			Response:         reply,
+			Finalized:        finalized,
+			Provider:         provider,
+			OptionalMetadata: optionalMetadata,
+		})
+		return err
 	})
-	return err
 }
@@ -0,0 +1,61 @@
+package performance
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+)
+
+// each cache node is placed on the ring this many times so keys are spread evenly between nodes
+const cacheRingVirtualNodes = 100
+
+// hashRing maps keys to nodes with consistent hashing, adding or removing a node only moves the keys of that node
+type hashRing struct {
+	hashes []uint64
+	owners map[uint64]string
+}
+
+func ringHash(key string) uint64 {
+	hash := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(hash[:8])
+}
+
+func newHashRing(addresses []string) *hashRing {
+	ring := &hashRing{
+		hashes: make([]uint64, 0, len(addresses)*cacheRingVirtualNodes),
+		owners: make(map[uint64]string, len(addresses)*cacheRingVirtualNodes),
+	}
+	for _, address := range addresses {
+		for idx := 0; idx < cacheRingVirtualNodes; idx++ {
+			hash := ringHash(address + "#" + strconv.Itoa(idx))
+			if _, ok := ring.owners[hash]; ok {
+				continue
+			}
+			ring.owners[hash] = address
+			ring.hashes = append(ring.hashes, hash)
+		}
+	}
+	sort.Slice(ring.hashes, func(i, j int) bool { return ring.hashes[i] < ring.hashes[j] })
+	return ring
+}
+
+// returns the nodes responsible for the key by order of preference, the first one is the owner and the rest are used on failover
+func (hr *hashRing) get(key string, count int) []string {
+	if len(hr.hashes) == 0 || count <= 0 {
+		return nil
+	}
+	hash := ringHash(key)
+	start := sort.Search(len(hr.hashes), func(i int) bool { return hr.hashes[i] >= hash })
+	nodes := []string{}
+	seen := map[string]struct{}{}
+	for idx := 0; idx < len(hr.hashes) && len(nodes) < count; idx++ {
+		address := hr.owners[hr.hashes[(start+idx)%len(hr.hashes)]]
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		nodes = append(nodes, address)
+	}
+	return nodes
+}
@@ -0,0 +1,168 @@
+package performance
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type stubCacheClient struct {
+	pairingtypes.RelayerCacheClient
+	entries  map[string]*pairingtypes.RelayReply
+	downCode codes.Code // the error code returned while the server is down, codes.OK when it's up
+}
+
+func (scc *stubCacheClient) downError(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if scc.downCode != codes.OK {
+		return status.Error(scc.downCode, "down")
+	}
+	return nil
+}
+
+func (scc *stubCacheClient) GetRelay(ctx context.Context, in *pairingtypes.RelayCacheGet, opts ...grpc.CallOption) (*pairingtypes.CacheRelayReply, error) {
+	if err := scc.downError(ctx); err != nil {
+		return nil, err
+	}
+	reply, ok := scc.entries[string(in.Request.Data)]
+	if !ok {
+		return nil, status.Error(codes.Unknown, "Cache miss")
+	}
+	return &pairingtypes.CacheRelayReply{Reply: reply}, nil
+}
+
+func (scc *stubCacheClient) SetRelay(ctx context.Context, in *pairingtypes.RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if err := scc.downError(ctx); err != nil {
+		return nil, err
+	}
+	scc.entries[string(in.Request.Data)] = in.Response
+	return &emptypb.Empty{}, nil
+}
+
+func newStubCache(addresses ...string) (*Cache, map[string]*stubCacheClient) {
+	cache := &Cache{nodes: map[string]*cacheNode{}}
+	clients := map[string]*stubCacheClient{}
+	for _, address := range addresses {
+		clients[address] = &stubCacheClient{entries: map[string]*pairingtypes.RelayReply{}}
+		cache.nodes[address] = &cacheNode{address: address, client: clients[address], healthy: true}
+	}
+	cache.rebuildRing()
+	return cache, clients
+}
+
+func TestHashRingRebalance(t *testing.T) {
+	keys := make([]string, 1000)
+	for idx := range keys {
+		keys[idx] = "key" + strconv.Itoa(idx)
+	}
+	ring := newHashRing([]string{"a", "b", "c"})
+	owners := map[string]string{}
+	perNode := map[string]int{}
+	for _, key := range keys {
+		owners[key] = ring.get(key, 1)[0]
+		perNode[owners[key]]++
+	}
+	for _, count := range perNode {
+		// keys are spread between the nodes
+		require.Greater(t, count, len(keys)/6)
+	}
+
+	// adding a node only moves keys to the new node
+	ring = newHashRing([]string{"a", "b", "c", "d"})
+	moved := 0
+	for _, key := range keys {
+		owner := ring.get(key, 1)[0]
+		if owner != owners[key] {
+			require.Equal(t, "d", owner)
+			moved++
+		}
+	}
+	require.Greater(t, moved, 0)
+	require.Less(t, moved, len(keys)/2)
+
+	// the failover node is always a different node
+	for _, key := range keys {
+		nodes := ring.get(key, 2)
+		require.Len(t, nodes, 2)
+		require.NotEqual(t, nodes[0], nodes[1])
+	}
+	require.Empty(t, newHashRing(nil).get("key", 2))
+}
+
+func TestCacheShardingFailover(t *testing.T) {
+	ctx := context.Background()
+	cache, clients := newStubCache("a", "b", "c")
+	request := &pairingtypes.RelayPrivateData{ApiInterface: "jsonrpc", Data: []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)}
+	require.NoError(t, cache.SetEntry(ctx, request, nil, "chain", &pairingtypes.RelayReply{Data: []byte("0x1")}, true, "provider", nil))
+	stored := 0
+	owner := ""
+	for address, client := range clients {
+		if len(client.entries) > 0 {
+			stored++
+			owner = address
+		}
+	}
+	require.Equal(t, 1, stored)
+
+	// a request with a different id and requested block goes to the same server
+	sameRequest := &pairingtypes.RelayPrivateData{ApiInterface: "jsonrpc", Data: []byte(`{"jsonrpc":"2.0","id":7,"method":"eth_chainId"}`), RequestBlock: 10}
+	require.Equal(t, cacheShardKey(request, "chain", "provider"), cacheShardKey(sameRequest, "chain", "provider"))
+	reply, err := cache.GetEntry(ctx, request, nil, "chain", true, "provider")
+	require.NoError(t, err)
+	require.Equal(t, "0x1", string(reply.Reply.Data))
+
+	// the owner goes down, requests fail over to the next server and the owner is removed from the ring
+	clients[owner].downCode = codes.Unavailable
+	_, err = cache.GetEntry(ctx, request, nil, "chain", true, "provider")
+	require.Error(t, err)
+	require.NotEqual(t, codes.Unavailable, status.Code(err))
+	require.False(t, cache.nodes[owner].healthy)
+	require.NotEqual(t, owner, cache.ring.get(cacheShardKey(request, "chain", "provider"), 1)[0])
+	require.NoError(t, cache.SetEntry(ctx, request, nil, "chain", &pairingtypes.RelayReply{Data: []byte("0x1")}, true, "provider", nil))
+	reply, err = cache.GetEntry(ctx, request, nil, "chain", true, "provider")
+	require.NoError(t, err)
+	require.Equal(t, "0x1", string(reply.Reply.Data))
+
+	// the owner recovers and gets its keys back
+	clients[owner].downCode = codes.OK
+	cache.setNodeHealth(owner, true)
+	reply, err = cache.GetEntry(ctx, request, nil, "chain", true, "provider")
+	require.NoError(t, err)
+	require.Equal(t, "0x1", string(reply.Reply.Data))
+}
+
+func TestCacheFailoverOnServerErrors(t *testing.T) {
+	request := &pairingtypes.RelayPrivateData{ApiInterface: "jsonrpc", Data: []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)}
+	key := cacheShardKey(request, "chain", "provider")
+	for _, downCode := range []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Internal} {
+		t.Run(downCode.String(), func(t *testing.T) {
+			ctx := context.Background()
+			cache, clients := newStubCache("a", "b", "c")
+			owner := cache.ring.get(key, 1)[0]
+			clients[owner].downCode = downCode
+			require.NoError(t, cache.SetEntry(ctx, request, nil, "chain", &pairingtypes.RelayReply{Data: []byte("0x1")}, true, "provider", nil))
+			require.False(t, cache.nodes[owner].healthy)
+			reply, err := cache.GetEntry(ctx, request, nil, "chain", true, "provider")
+			require.NoError(t, err)
+			require.Equal(t, "0x1", string(reply.Reply.Data))
+		})
+	}
+
+	// the caller giving up doesn't mark the server as unhealthy
+	cache, _ := newStubCache("a", "b", "c")
+	owner := cache.ring.get(key, 1)[0]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := cache.GetEntry(ctx, request, nil, "chain", true, "provider")
+	require.Equal(t, codes.Canceled, status.Code(err))
+	require.True(t, cache.nodes[owner].healthy)
+}
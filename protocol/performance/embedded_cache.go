@@ -0,0 +1,237 @@
+package performance
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/lavanet/lava/ecosystem/cache/format"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	EmbeddedCacheSizeFlagName     = "embedded-cache-size-mb"
+	embeddedCacheNumCounters      = 10000000 // expect 1M items
+	embeddedCacheBufferItems      = 64
+	embeddedCacheBytesPerMegaByte = 1024 * 1024
+)
+
+type embeddedCacheEntry struct {
+	chainID   string
+	block     int64
+	key       string
+	blockHash []byte
+	data      []byte // marshaled CacheRelayReply, so every read gets its own copy
+}
+
+// EmbeddedCache is an in process cache of finalized relay responses, it is used by the provider before the external cache.
+// entries are indexed by their block so they can be evicted when the chain tracker detects a fork
+type EmbeddedCache struct {
+	cache *ristretto.Cache
+	lock  sync.Mutex
+	index map[string]map[int64]map[string]*embeddedCacheEntry // chainID -> block -> key
+}
+
+// returns nil when maxSizeMB is 0, a nil cache is inactive and all of its methods are no-ops
+func NewEmbeddedCache(maxSizeMB uint64) (*EmbeddedCache, error) {
+	if maxSizeMB == 0 {
+		return nil, nil
+	}
+	embeddedCache := &EmbeddedCache{index: map[string]map[int64]map[string]*embeddedCacheEntry{}}
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: embeddedCacheNumCounters,
+		MaxCost:     int64(maxSizeMB * embeddedCacheBytesPerMegaByte),
+		BufferItems: embeddedCacheBufferItems,
+		OnExit:      embeddedCache.onExit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	embeddedCache.cache = cache
+	return embeddedCache, nil
+}
+
+func (ec *EmbeddedCache) CacheActive() bool {
+	return ec != nil
+}
+
+// returns the stored reply with the jsonrpc id of the given request, a stored entry with a different block hash is removed
+func (ec *EmbeddedCache) GetEntry(request *pairingtypes.RelayPrivateData, blockHash []byte, chainID string) (*pairingtypes.CacheRelayReply, bool) {
+	if ec == nil {
+		return nil, false
+	}
+	key := embeddedCacheKey(request, chainID)
+	value, ok := ec.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	entry, ok := value.(*embeddedCacheEntry)
+	if !ok {
+		return nil, false
+	}
+	if blockHash != nil && entry.blockHash != nil && !bytes.Equal(blockHash, entry.blockHash) {
+		ec.cache.Del(key)
+		return nil, false
+	}
+	cacheReply := &pairingtypes.CacheRelayReply{}
+	if err := cacheReply.Unmarshal(entry.data); err != nil {
+		utils.LavaFormatError("failed unmarshaling embedded cache entry", err, utils.Attribute{Key: "chainID", Value: chainID})
+		return nil, false
+	}
+	if cacheReply.Reply != nil {
+		// the stored data has the id of the request that stored it, the input formatter extracts the id of this request
+		inputFormatter, outputFormatter := format.FormatterForRelayRequestAndResponse(request.ApiInterface)
+		inputFormatter(request.Data)
+		cacheReply.Reply.Data = outputFormatter(cacheReply.Reply.Data)
+	}
+	return cacheReply, true
+}
+
+// stores a reply of a finalized request, existing entries are kept. node error replies are not stored
+// as the node can answer differently on a retry
+func (ec *EmbeddedCache) SetEntry(request *pairingtypes.RelayPrivateData, blockHash []byte, chainID string, reply *pairingtypes.RelayReply, optionalMetadata []pairingtypes.Metadata) {
+	if ec == nil || reply == nil || isNodeErrorReply(request.ApiInterface, reply.Data) {
+		return
+	}
+	key := embeddedCacheKey(request, chainID)
+	if _, ok := ec.cache.Get(key); ok {
+		return
+	}
+	data, err := (&pairingtypes.CacheRelayReply{Reply: reply, OptionalMetadata: optionalMetadata}).Marshal()
+	if err != nil {
+		utils.LavaFormatError("failed marshaling embedded cache entry", err, utils.Attribute{Key: "chainID", Value: chainID})
+		return
+	}
+	entry := &embeddedCacheEntry{chainID: chainID, block: request.RequestBlock, key: key, blockHash: blockHash, data: data}
+	ec.lock.Lock()
+	blocks, ok := ec.index[chainID]
+	if !ok {
+		blocks = map[int64]map[string]*embeddedCacheEntry{}
+		ec.index[chainID] = blocks
+	}
+	keys, ok := blocks[entry.block]
+	if !ok {
+		keys = map[string]*embeddedCacheEntry{}
+		blocks[entry.block] = keys
+	}
+	keys[key] = entry
+	ec.lock.Unlock()
+	ec.cache.Set(key, entry, int64(len(data)))
+}
+
+// removes the entries of all the blocks from fromBlock and above, used when the chain tracker detects a fork
+func (ec *EmbeddedCache) EvictFromBlock(chainID string, fromBlock int64) {
+	if ec == nil {
+		return
+	}
+	evicted := []string{}
+	ec.lock.Lock()
+	for block, keys := range ec.index[chainID] {
+		if block < fromBlock {
+			continue
+		}
+		for key := range keys {
+			evicted = append(evicted, key)
+		}
+		delete(ec.index[chainID], block)
+	}
+	ec.lock.Unlock()
+	// ristretto calls onExit from Del so the lock can't be held here
+	for _, key := range evicted {
+		ec.cache.Del(key)
+	}
+	if len(evicted) > 0 {
+		utils.LavaFormatDebug("evicted embedded cache entries on fork", utils.Attribute{Key: "chainID", Value: chainID}, utils.Attribute{Key: "fromBlock", Value: fromBlock}, utils.Attribute{Key: "entries", Value: len(evicted)})
+	}
+}
+
+// blocks until pending sets are applied
+func (ec *EmbeddedCache) Wait() {
+	if ec == nil {
+		return
+	}
+	ec.cache.Wait()
+}
+
+// called by ristretto when a value is evicted, rejected, deleted or replaced
+func (ec *EmbeddedCache) onExit(value interface{}) {
+	entry, ok := value.(*embeddedCacheEntry)
+	if !ok {
+		return
+	}
+	ec.lock.Lock()
+	defer ec.lock.Unlock()
+	keys := ec.index[entry.chainID][entry.block]
+	// a replaced value exits after the new one was indexed, only the exiting entry is removed
+	if keys[entry.key] != entry {
+		return
+	}
+	delete(keys, entry.key)
+	if len(keys) == 0 {
+		delete(ec.index[entry.chainID], entry.block)
+	}
+	if len(ec.index[entry.chainID]) == 0 {
+		delete(ec.index, entry.chainID)
+	}
+}
+
+func embeddedCacheKey(request *pairingtypes.RelayPrivateData, chainID string) string {
+	inputFormatter, _ := format.FormatterForRelayRequestAndResponse(request.ApiInterface)
+	keyRequest := pairingtypes.RelayPrivateData{
+		ConnectionType: request.ConnectionType,
+		ApiUrl:         format.FormatterForRelayRequestApiUrl(request.ApiInterface)(request.ApiUrl),
+		Data:           inputFormatter(request.Data),
+		RequestBlock:   request.RequestBlock,
+		ApiInterface:   request.ApiInterface,
+		Metadata:       request.Metadata,
+		Addon:          request.Addon,
+		Extensions:     request.Extensions,
+	}
+	key := sha256.Sum256([]byte(chainID + ";" + keyRequest.String()))
+	return string(key[:])
+}
+
+// checks the reply data for the error formats of each api interface, batch replies are errors if any of their elements is
+func isNodeErrorReply(apiInterface string, data []byte) bool {
+	switch apiInterface {
+	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC:
+		type jsonrpcReply struct {
+			Error json.RawMessage `json:"error,omitempty"`
+		}
+		hasError := func(reply jsonrpcReply) bool {
+			return len(reply.Error) > 0 && !bytes.Equal(reply.Error, []byte("null"))
+		}
+		var reply jsonrpcReply
+		if json.Unmarshal(data, &reply) == nil {
+			return hasError(reply)
+		}
+		var batchReply []jsonrpcReply
+		if json.Unmarshal(data, &batchReply) == nil {
+			for _, reply := range batchReply {
+				if hasError(reply) {
+					return true
+				}
+			}
+		}
+		return false
+	case spectypes.APIInterfaceRest:
+		// cosmos rest errors, e.g. {"code":3,"message":"...","details":[]}
+		var reply struct {
+			Code    int     `json:"code"`
+			Message *string `json:"message"`
+		}
+		return json.Unmarshal(data, &reply) == nil && reply.Code != 0 && reply.Message != nil
+	case spectypes.APIInterfaceGrpc:
+		// the provider returns node errors as a json GrpcNodeErrorResponse instead of a marshaled proto
+		var reply struct {
+			ErrorMessage string `json:"error_message"`
+			ErrorCode    uint32 `json:"error_code"`
+		}
+		return json.Unmarshal(data, &reply) == nil && (reply.ErrorCode != 0 || reply.ErrorMessage != "")
+	}
+	return false
+}
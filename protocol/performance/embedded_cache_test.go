@@ -0,0 +1,119 @@
+package performance
+
+import (
+	"testing"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func jsonrpcRelayData(id string, block int64) *pairingtypes.RelayPrivateData {
+	return &pairingtypes.RelayPrivateData{
+		ConnectionType: "POST",
+		ApiInterface:   spectypes.APIInterfaceJsonRPC,
+		Data:           []byte(`{"jsonrpc":"2.0","id":` + id + `,"method":"eth_getBlockByNumber","params":["0x10",false]}`),
+		RequestBlock:   block,
+	}
+}
+
+func TestEmbeddedCacheDisabled(t *testing.T) {
+	embeddedCache, err := NewEmbeddedCache(0)
+	require.NoError(t, err)
+	require.False(t, embeddedCache.CacheActive())
+	embeddedCache.SetEntry(jsonrpcRelayData("1", 16), nil, "LAV1", &pairingtypes.RelayReply{Data: []byte("{}")}, nil)
+	_, found := embeddedCache.GetEntry(jsonrpcRelayData("1", 16), nil, "LAV1")
+	require.False(t, found)
+	embeddedCache.EvictFromBlock("LAV1", 0)
+}
+
+func TestEmbeddedCacheGetSet(t *testing.T) {
+	embeddedCache, err := NewEmbeddedCache(1)
+	require.NoError(t, err)
+	require.True(t, embeddedCache.CacheActive())
+
+	hash := []byte("hash16")
+	metadata := []pairingtypes.Metadata{{Name: "header", Value: "value"}}
+	embeddedCache.SetEntry(jsonrpcRelayData("1", 16), hash, "LAV1", &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`)}, metadata)
+	embeddedCache.Wait()
+
+	t.Run("hit restores the request id", func(t *testing.T) {
+		cacheReply, found := embeddedCache.GetEntry(jsonrpcRelayData("7", 16), hash, "LAV1")
+		require.True(t, found)
+		require.JSONEq(t, `{"jsonrpc":"2.0","id":7,"result":"0x10"}`, string(cacheReply.Reply.Data))
+		require.Equal(t, metadata, cacheReply.OptionalMetadata)
+	})
+
+	t.Run("miss on a different chain or block", func(t *testing.T) {
+		_, found := embeddedCache.GetEntry(jsonrpcRelayData("1", 16), hash, "LAV2")
+		require.False(t, found)
+		_, found = embeddedCache.GetEntry(jsonrpcRelayData("1", 17), hash, "LAV1")
+		require.False(t, found)
+	})
+
+	t.Run("a different hash removes the entry", func(t *testing.T) {
+		_, found := embeddedCache.GetEntry(jsonrpcRelayData("1", 16), []byte("other"), "LAV1")
+		require.False(t, found)
+		_, found = embeddedCache.GetEntry(jsonrpcRelayData("1", 16), hash, "LAV1")
+		require.False(t, found)
+	})
+}
+
+func TestEmbeddedCacheEvictFromBlock(t *testing.T) {
+	embeddedCache, err := NewEmbeddedCache(1)
+	require.NoError(t, err)
+
+	for block := int64(10); block < 20; block++ {
+		embeddedCache.SetEntry(jsonrpcRelayData("1", block), nil, "LAV1", &pairingtypes.RelayReply{Data: []byte("{}")}, nil)
+		embeddedCache.SetEntry(jsonrpcRelayData("1", block), nil, "LAV2", &pairingtypes.RelayReply{Data: []byte("{}")}, nil)
+	}
+	embeddedCache.Wait()
+
+	embeddedCache.EvictFromBlock("LAV1", 15)
+	for block := int64(10); block < 20; block++ {
+		_, found := embeddedCache.GetEntry(jsonrpcRelayData("1", block), nil, "LAV1")
+		require.Equal(t, block < 15, found, block)
+		// other chains are not affected
+		_, found = embeddedCache.GetEntry(jsonrpcRelayData("1", block), nil, "LAV2")
+		require.True(t, found, block)
+	}
+	embeddedCache.lock.Lock()
+	require.Len(t, embeddedCache.index["LAV1"], 5)
+	embeddedCache.lock.Unlock()
+}
+
+func TestEmbeddedCacheSkipsNodeErrors(t *testing.T) {
+	embeddedCache, err := NewEmbeddedCache(1)
+	require.NoError(t, err)
+
+	playbook := []struct {
+		name         string
+		apiInterface string
+		data         string
+		stored       bool
+	}{
+		{name: "jsonrpc result", apiInterface: spectypes.APIInterfaceJsonRPC, data: `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, stored: true},
+		{name: "jsonrpc null error", apiInterface: spectypes.APIInterfaceJsonRPC, data: `{"jsonrpc":"2.0","id":1,"result":"0x10","error":null}`, stored: true},
+		{name: "jsonrpc error", apiInterface: spectypes.APIInterfaceJsonRPC, data: `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`, stored: false},
+		{name: "jsonrpc batch with an error", apiInterface: spectypes.APIInterfaceJsonRPC, data: `[{"jsonrpc":"2.0","id":1,"result":"0x10"},{"jsonrpc":"2.0","id":2,"error":{"code":-32000,"message":"header not found"}}]`, stored: false},
+		{name: "tendermintrpc error", apiInterface: spectypes.APIInterfaceTendermintRPC, data: `{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"height is not available"}}`, stored: false},
+		{name: "rest result", apiInterface: spectypes.APIInterfaceRest, data: `{"block":{"header":{"height":"16"}}}`, stored: true},
+		{name: "rest error", apiInterface: spectypes.APIInterfaceRest, data: `{"code":3,"message":"height is not available","details":[]}`, stored: false},
+		{name: "grpc result", apiInterface: spectypes.APIInterfaceGrpc, data: "\x0a\x02\x10\x10", stored: true},
+		{name: "grpc error", apiInterface: spectypes.APIInterfaceGrpc, data: `{"error_message":"height is not available","error_code":3}`, stored: false},
+	}
+	for idx, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			request := &pairingtypes.RelayPrivateData{
+				ConnectionType: "POST",
+				ApiInterface:   play.apiInterface,
+				Data:           []byte(`{"jsonrpc":"2.0","id":1,"method":"test","params":[]}`),
+				RequestBlock:   int64(idx),
+			}
+			embeddedCache.SetEntry(request, nil, "LAV1", &pairingtypes.RelayReply{Data: []byte(play.data)}, nil)
+			embeddedCache.Wait()
+			_, found := embeddedCache.GetEntry(request, nil, "LAV1")
+			require.Equal(t, play.stored, found)
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package provideroptimizer
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +32,8 @@ const (
 	DEFAULT_EXPLORATION_CHANCE = 0.1
 	COST_EXPLORATION_CHANCE    = 0.01
 	WANTED_PRECISION           = int64(8)
+	LATENCY_SAMPLES_WINDOW     = 1000 // number of recent relay latencies kept for percentile calculations
+	MIN_LATENCY_SAMPLES        = 20   // below this amount percentiles aren't meaningful
 )
 
 type ConcurrentBlockStore struct {
@@ -37,6 +42,45 @@ type ConcurrentBlockStore struct {
 	Block uint64
 }
 
+// a rolling window of relay latencies, each sample is the latency divided by the expected latency of the relay
+// so samples of relays with different compute units can be compared
+type latencySamples struct {
+	lock    sync.Mutex
+	samples []float64
+	next    int
+}
+
+func (ls *latencySamples) add(sample float64) {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	if len(ls.samples) < LATENCY_SAMPLES_WINDOW {
+		ls.samples = append(ls.samples, sample)
+		return
+	}
+	ls.samples[ls.next] = sample
+	ls.next = (ls.next + 1) % LATENCY_SAMPLES_WINDOW
+}
+
+// returns false if there aren't enough samples
+func (ls *latencySamples) percentile(percentile float64) (float64, bool) {
+	ls.lock.Lock()
+	if len(ls.samples) < MIN_LATENCY_SAMPLES {
+		ls.lock.Unlock()
+		return 0, false
+	}
+	sorted := make([]float64, len(ls.samples))
+	copy(sorted, ls.samples)
+	ls.lock.Unlock()
+	sort.Float64s(sorted)
+	idx := int(math.Ceil(percentile*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	} else if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx], true
+}
+
 type cacheInf interface {
 	Get(key interface{}) (interface{}, bool)
 	Set(key, value interface{}, cost int64) bool
@@ -50,6 +94,9 @@ type ProviderOptimizer struct {
 	baseWorldLatency                time.Duration
 	wantedNumProvidersInConcurrency uint
 	latestSyncData                  ConcurrentBlockStore
+	latencySamples                  latencySamples
+	providersAddresses              sync.Map // addresses of the providers with stored data, the storage can't be iterated
+	stateFileLock                   sync.Mutex
 }
 
 type ProviderData struct {
@@ -91,6 +138,7 @@ func (po *ProviderOptimizer) appendRelayData(providerAddress string, latency tim
 				baseLatency += po.averageBlockTime / 2 // hanging apis take longer
 			}
 			providerData = po.updateProbeEntryLatency(providerData, latency, baseLatency, RELAY_UPDATE_WEIGHT, halfTime, sampleTime)
+			po.latencySamples.add(latency.Seconds() / baseLatency.Seconds())
 		}
 		if syncBlock > providerData.SyncBlock {
 			// do not allow providers to go back
@@ -99,7 +147,7 @@ func (po *ProviderOptimizer) appendRelayData(providerAddress string, latency tim
 		syncLag := po.calculateSyncLag(latestSync, timeSync, providerData.SyncBlock, sampleTime)
 		providerData = po.updateProbeEntrySync(providerData, syncLag, po.averageBlockTime, halfTime, sampleTime)
 	}
-	po.providersStorage.Set(providerAddress, providerData, 1)
+	po.setProviderData(providerAddress, providerData)
 	po.updateRelayTime(providerAddress, sampleTime)
 	if debug {
 		utils.LavaFormatDebug("relay update", utils.Attribute{Key: "providerData", Value: providerData}, utils.Attribute{Key: "syncBlock", Value: syncBlock}, utils.Attribute{Key: "cu", Value: cu}, utils.Attribute{Key: "providerAddress", Value: providerAddress}, utils.Attribute{Key: "latency", Value: latency}, utils.Attribute{Key: "success", Value: success})
@@ -115,7 +163,7 @@ func (po *ProviderOptimizer) AppendProbeRelayData(providerAddress string, latenc
 		// base latency for a probe is the world latency
 		providerData = po.updateProbeEntryLatency(providerData, latency, po.baseWorldLatency, PROBE_UPDATE_WEIGHT, halfTime, sampleTime)
 	}
-	po.providersStorage.Set(providerAddress, providerData, 1)
+	po.setProviderData(providerAddress, providerData)
 	if debug {
 		utils.LavaFormatDebug("probe update", utils.Attribute{Key: "providerAddress", Value: providerAddress}, utils.Attribute{Key: "latency", Value: latency}, utils.Attribute{Key: "success", Value: success})
 	}
@@ -178,6 +226,35 @@ func (po *ProviderOptimizer) ChooseProvider(allAddresses []string, ignoredProvid
 	return returnedProviders
 }
 
+// returns the relay latency below which the given percentile of recent relays with this amount of compute units were answered,
+// returns 0 when there isn't enough relay data
+func (po *ProviderOptimizer) LatencyPercentile(cu uint64, percentile float64) time.Duration {
+	ratio, ok := po.latencySamples.percentile(percentile)
+	if !ok {
+		return 0
+	}
+	baseLatency := po.baseWorldLatency + common.BaseTimePerCU(cu)/2
+	return time.Duration(float64(baseLatency) * ratio)
+}
+
+// returns the provider a pin key is pinned to, used by the privacy strategy so each dapp is exposed to a single provider.
+// providers are ranked by a hash of the key and their address (rendezvous hashing), so a pin only moves
+// when its provider is ignored or leaves the pairing
+func (po *ProviderOptimizer) ChoosePinnedProvider(allAddresses []string, ignoredProviders map[string]struct{}, pinKey string) (address string) {
+	var bestRank []byte
+	for _, providerAddress := range allAddresses {
+		if _, ok := ignoredProviders[providerAddress]; ok {
+			continue
+		}
+		rank := sha256.Sum256([]byte(pinKey + providerAddress))
+		if bestRank == nil || bytes.Compare(rank[:], bestRank) > 0 {
+			bestRank = rank[:]
+			address = providerAddress
+		}
+	}
+	return address
+}
+
 // calculate the expected average time until this provider catches up with the given latestSync block
 // for the first block difference we take the minimum between the time passed since block arrived and the average block time
 // for any other block we take the averageBlockTime
@@ -233,7 +310,8 @@ func (po *ProviderOptimizer) isBetterProviderScore(latencyScore, latencyScoreCur
 	switch po.strategy {
 	case STRATEGY_LATENCY:
 		latencyWeight = 0.7
-	case STRATEGY_SYNC_FRESHNESS:
+	case STRATEGY_SYNC_FRESHNESS, STRATEGY_ACCURACY:
+		// with accuracy replies are compared between providers, a provider lagging behind will disagree with the rest
 		latencyWeight = 0.2
 	case STRATEGY_PRIVACY:
 		// pick at random regardless of score
@@ -353,6 +431,11 @@ func (po *ProviderOptimizer) getProviderData(providerAddress string) (providerDa
 	return providerData, found
 }
 
+func (po *ProviderOptimizer) setProviderData(providerAddress string, providerData ProviderData) {
+	po.providersStorage.Set(providerAddress, providerData, 1)
+	po.providersAddresses.Store(providerAddress, struct{}{})
+}
+
 func (po *ProviderOptimizer) updateProbeEntrySync(providerData ProviderData, sync, baseSync, halfTime time.Duration, sampleTime time.Time) ProviderData {
 	newScore := score.NewScoreStore(sync.Seconds(), baseSync.Seconds(), sampleTime)
 	oldScore := providerData.Sync
@@ -436,8 +519,8 @@ func NewProviderOptimizer(strategy Strategy, averageBlockTIme, baseWorldLatency
 	if err != nil {
 		utils.LavaFormatFatal("failed setting up cache for queries", err)
 	}
-	if strategy == STRATEGY_PRIVACY {
-		// overwrite
+	if strategy == STRATEGY_PRIVACY || strategy == STRATEGY_COST {
+		// overwrite, privacy exposes a relay to a single provider and cost doesn't pay for the same relay twice
 		wantedNumProvidersInConcurrency = 1
 	}
 	return &ProviderOptimizer{strategy: strategy, providersStorage: cache, averageBlockTime: averageBlockTIme, baseWorldLatency: baseWorldLatency, providerRelayStats: relayCache, wantedNumProvidersInConcurrency: wantedNumProvidersInConcurrency}
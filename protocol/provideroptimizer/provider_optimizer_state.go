@@ -0,0 +1,106 @@
+package provideroptimizer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+)
+
+const STATE_SAVE_INTERVAL = time.Minute
+
+// the stored scores of the providers, the scores keep their time so their weight decays by the time passed since they were saved
+type providersState struct {
+	Providers map[string]ProviderData `json:"providers"`
+}
+
+// SaveState writes the scores of all the providers to a file, the file is replaced atomically so a crash doesn't leave a partial state
+func (po *ProviderOptimizer) SaveState(filePath string) error {
+	po.stateFileLock.Lock()
+	defer po.stateFileLock.Unlock()
+	state := providersState{Providers: map[string]ProviderData{}}
+	po.providersAddresses.Range(func(key, _ any) bool {
+		providerAddress, ok := key.(string)
+		if !ok {
+			return true
+		}
+		if providerData, found := po.getProviderData(providerAddress); found {
+			state.Providers[providerAddress] = providerData
+		}
+		return true
+	})
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	tmpFilePath := filePath + ".tmp"
+	err = os.WriteFile(tmpFilePath, data, 0o600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpFilePath, filePath)
+}
+
+// LoadState restores the scores saved with SaveState, a missing file is not an error.
+// scores older than the initial data staleness carry no more weight than the defaults and are dropped
+func (po *ProviderOptimizer) LoadState(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	state := providersState{}
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+	staleTime := time.Now().Add(-1 * INITIAL_DATA_STALENESS * time.Hour)
+	loaded := 0
+	for providerAddress, providerData := range state.Providers {
+		if providerData.Availability.Time.Before(staleTime) || providerData.Availability.Denom <= 0 {
+			continue
+		}
+		if _, found := po.getProviderData(providerAddress); found {
+			// data from this run is newer than the stored data
+			continue
+		}
+		po.setProviderData(providerAddress, providerData)
+		loaded++
+	}
+	utils.LavaFormatInfo("loaded provider optimizer state", utils.LogAttr("file", filePath), utils.LogAttr("providers", loaded), utils.LogAttr("stored", len(state.Providers)))
+	return nil
+}
+
+// loads the stored state and saves the state every interval until the context is done
+func (po *ProviderOptimizer) StartStatePersistence(ctx context.Context, filePath string, interval time.Duration) {
+	if err := po.LoadState(filePath); err != nil {
+		utils.LavaFormatWarning("failed loading provider optimizer state, starting without it", err, utils.LogAttr("file", filePath))
+	}
+	if interval <= 0 {
+		interval = STATE_SAVE_INTERVAL
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := po.SaveState(filePath); err != nil {
+					utils.LavaFormatWarning("failed saving provider optimizer state", err, utils.LogAttr("file", filePath))
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -1,13 +1,16 @@
 package provideroptimizer
 
 import (
+	"path/filepath"
 	"strconv"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/score"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -539,3 +542,154 @@ func TestExcellence(t *testing.T) {
 	require.NotNil(t, report2)
 	require.Equal(t, report, report2)
 }
+
+func TestProviderOptimizerLatencyPercentile(t *testing.T) {
+	providerOptimizer := setupProviderOptimizer(1)
+	providersGen := (&providersGenerator{}).setupProvidersForTest(10)
+	requestCU := uint64(10)
+	syncBlock := uint64(1000)
+	// not enough data yet
+	require.Zero(t, providerOptimizer.LatencyPercentile(requestCU, 0.9))
+
+	baseLatency := TEST_BASE_WORLD_LATENCY + common.BaseTimePerCU(requestCU)/2
+	sampleTime := time.Now()
+	for i := 0; i < MIN_LATENCY_SAMPLES*5; i++ {
+		// 1x to 10x of the expected latency
+		providerOptimizer.appendRelayData(providersGen.providersAddresses[i%10], baseLatency*time.Duration(i%10+1), false, true, requestCU, syncBlock, sampleTime)
+	}
+	require.InDelta(t, float64(baseLatency*9), float64(providerOptimizer.LatencyPercentile(requestCU, 0.9)), float64(time.Millisecond))
+	require.InDelta(t, float64(baseLatency*5), float64(providerOptimizer.LatencyPercentile(requestCU, 0.5)), float64(time.Millisecond))
+	// percentiles scale with the compute units of the relay
+	require.Greater(t, providerOptimizer.LatencyPercentile(requestCU*100, 0.9), providerOptimizer.LatencyPercentile(requestCU, 0.9))
+
+	// old samples are rolled out of the window
+	for i := 0; i < LATENCY_SAMPLES_WINDOW; i++ {
+		providerOptimizer.appendRelayData(providersGen.providersAddresses[i%10], baseLatency, false, true, requestCU, syncBlock, sampleTime)
+	}
+	require.InDelta(t, float64(baseLatency), float64(providerOptimizer.LatencyPercentile(requestCU, 0.9)), float64(time.Millisecond))
+}
+
+func TestProviderOptimizerStrategyPrivacyPinning(t *testing.T) {
+	providerOptimizer := NewProviderOptimizer(STRATEGY_PRIVACY, TEST_AVERAGE_BLOCK_TIME, TEST_BASE_WORLD_LATENCY, 3)
+	require.Equal(t, uint(1), providerOptimizer.wantedNumProvidersInConcurrency)
+	providersGen := (&providersGenerator{}).setupProvidersForTest(10)
+
+	// the same dapp is always pinned to the same provider
+	pinned := providerOptimizer.ChoosePinnedProvider(providersGen.providersAddresses, nil, "dapp1")
+	require.NotEmpty(t, pinned)
+	for i := 0; i < 100; i++ {
+		require.Equal(t, pinned, providerOptimizer.ChoosePinnedProvider(providersGen.providersAddresses, nil, "dapp1"))
+	}
+	// the order of the pairing doesn't matter
+	reversed := make([]string, len(providersGen.providersAddresses))
+	for idx, address := range providersGen.providersAddresses {
+		reversed[len(reversed)-1-idx] = address
+	}
+	require.Equal(t, pinned, providerOptimizer.ChoosePinnedProvider(reversed, nil, "dapp1"))
+
+	// different dapps are spread across providers
+	chosen := map[string]struct{}{}
+	for i := 0; i < 100; i++ {
+		chosen[providerOptimizer.ChoosePinnedProvider(providersGen.providersAddresses, nil, "dapp"+strconv.Itoa(i))] = struct{}{}
+	}
+	require.Greater(t, len(chosen), 5)
+
+	// when the pinned provider is ignored the dapp moves to a stable fallback
+	ignored := map[string]struct{}{pinned: {}}
+	fallback := providerOptimizer.ChoosePinnedProvider(providersGen.providersAddresses, ignored, "dapp1")
+	require.NotEqual(t, pinned, fallback)
+	require.Equal(t, fallback, providerOptimizer.ChoosePinnedProvider(providersGen.providersAddresses, ignored, "dapp1"))
+
+	// a provider leaving the pairing only moves the dapps pinned to it
+	moved := 0
+	withoutLast := providersGen.providersAddresses[:len(providersGen.providersAddresses)-1]
+	for i := 0; i < 100; i++ {
+		pinKey := "dapp" + strconv.Itoa(i)
+		before := providerOptimizer.ChoosePinnedProvider(providersGen.providersAddresses, nil, pinKey)
+		after := providerOptimizer.ChoosePinnedProvider(withoutLast, nil, pinKey)
+		if before != after {
+			require.Equal(t, providersGen.providersAddresses[len(providersGen.providersAddresses)-1], before)
+			moved++
+		}
+	}
+	require.Less(t, moved, 30)
+
+	require.Empty(t, providerOptimizer.ChoosePinnedProvider(providersGen.providersAddresses[:1], map[string]struct{}{providersGen.providersAddresses[0]: {}}, "dapp1"))
+}
+
+func TestProviderOptimizerStrategyCost(t *testing.T) {
+	rand.InitRandomSeed()
+	providersGen := (&providersGenerator{}).setupProvidersForTest(5)
+	requestCU := uint64(10)
+	requestBlock := int64(1000)
+	pertrubationPercentage := 0.0
+
+	// latency sends the relay to several providers in parallel, each of them is paid for
+	latencyOptimizer := NewProviderOptimizer(STRATEGY_LATENCY, TEST_AVERAGE_BLOCK_TIME, TEST_BASE_WORLD_LATENCY, 3)
+	returnedProviders := latencyOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, pertrubationPercentage)
+	require.Equal(t, 3, len(returnedProviders))
+
+	// cost never pays for the same relay twice
+	costOptimizer := NewProviderOptimizer(STRATEGY_COST, TEST_AVERAGE_BLOCK_TIME, TEST_BASE_WORLD_LATENCY, 3)
+	for i := 0; i < 1000; i++ {
+		returnedProviders = costOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, pertrubationPercentage)
+		require.Equal(t, 1, len(returnedProviders))
+	}
+
+	// the single provider paid for is the best scored one
+	costOptimizer.providersStorage = &providerOptimizerSyncCache{value: map[interface{}]interface{}{}}
+	sampleTime := time.Now()
+	for i := 0; i < 10; i++ {
+		for idx, address := range providersGen.providersAddresses {
+			if idx == 3 {
+				costOptimizer.appendRelayData(address, TEST_BASE_WORLD_LATENCY, false, true, requestCU, uint64(requestBlock), sampleTime)
+			} else {
+				costOptimizer.appendRelayData(address, TEST_BASE_WORLD_LATENCY*3, false, false, requestCU, uint64(requestBlock-5), sampleTime)
+			}
+		}
+		sampleTime = sampleTime.Add(10 * time.Millisecond)
+	}
+	returnedProviders = costOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, pertrubationPercentage)
+	require.Equal(t, []string{providersGen.providersAddresses[3]}, returnedProviders)
+}
+
+func TestProviderOptimizerStatePersistence(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "optimizer.json")
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.providersStorage = &providerOptimizerSyncCache{value: map[interface{}]interface{}{}}
+	providersGen := (&providersGenerator{}).setupProvidersForTest(3)
+	// a missing state file is not an error
+	require.NoError(t, providerOptimizer.LoadState(filePath))
+	for i := 0; i < 10; i++ {
+		providerOptimizer.AppendRelayData(providersGen.providersAddresses[0], TEST_BASE_WORLD_LATENCY, false, 10, 1000)
+		providerOptimizer.AppendRelayFailure(providersGen.providersAddresses[1])
+	}
+	staleTime := time.Now().Add(-2 * INITIAL_DATA_STALENESS * time.Hour)
+	providerOptimizer.setProviderData(providersGen.providersAddresses[2], ProviderData{
+		Availability: score.NewScoreStore(1, 1, staleTime),
+		Latency:      score.NewScoreStore(1, 1, staleTime),
+		Sync:         score.NewScoreStore(1, 1, staleTime),
+	})
+	require.NoError(t, providerOptimizer.SaveState(filePath))
+
+	restartedOptimizer := setupProviderOptimizer(1)
+	restartedOptimizer.providersStorage = &providerOptimizerSyncCache{value: map[interface{}]interface{}{}}
+	require.NoError(t, restartedOptimizer.LoadState(filePath))
+	for _, address := range providersGen.providersAddresses[:2] {
+		stored, found := providerOptimizer.getProviderData(address)
+		require.True(t, found)
+		loaded, found := restartedOptimizer.getProviderData(address)
+		require.True(t, found)
+		require.Equal(t, stored.SyncBlock, loaded.SyncBlock)
+		require.InDelta(t, stored.Availability.Num, loaded.Availability.Num, 1e-9)
+		require.InDelta(t, stored.Latency.Denom, loaded.Latency.Denom, 1e-9)
+		// the stored time is kept so the loaded scores decay from when they were sampled
+		require.True(t, stored.Availability.Time.Equal(loaded.Availability.Time))
+	}
+	// stale scores are not loaded
+	_, found := restartedOptimizer.getProviderData(providersGen.providersAddresses[2])
+	require.False(t, found)
+	// the failing provider is not the one chosen after the restart
+	returnedProviders := restartedOptimizer.ChooseProvider(providersGen.providersAddresses[:2], nil, 10, 1000, 0)
+	require.Equal(t, providersGen.providersAddresses[0], returnedProviders[0])
+}
@@ -0,0 +1,365 @@
+package rpcconsumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const jsonRPCInternalErrorCode = -32603
+
+// elements of a jsonrpc batch that are routed to the same addon and extensions
+type subBatch struct {
+	routingKey    string
+	indexes       []int // positions of the elements in the original batch
+	elements      []json.RawMessage
+	chainMessages []chainlib.ChainMessage // the parsed elements, the batch is checked with them before it is split
+}
+
+// a single element is sent as is, since a batch of one is relayed and answered as a single request
+func (sb *subBatch) request() string {
+	if len(sb.elements) == 1 {
+		return string(sb.elements[0])
+	}
+	elements := make([]string, len(sb.elements))
+	for idx, element := range sb.elements {
+		elements[idx] = string(element)
+	}
+	return "[" + strings.Join(elements, ",") + "]"
+}
+
+type subBatchResponse struct {
+	relayResult *common.RelayResult
+	err         error
+}
+
+// returns the elements of a jsonrpc batch request, nil if the request is not a batch of more than one element
+func splitBatchRequest(req string) []json.RawMessage {
+	trimmed := strings.TrimSpace(req)
+	if !strings.HasPrefix(trimmed, "[") {
+		return nil
+	}
+	var elements []json.RawMessage
+	if err := json.Unmarshal([]byte(trimmed), &elements); err != nil || len(elements) < 2 {
+		return nil
+	}
+	return elements
+}
+
+// groups the elements by their routing key keeping the order of the first appearance of every key
+func groupBatchElements(elements []json.RawMessage, routingKey func(idx int, element json.RawMessage) (string, error)) ([]*subBatch, error) {
+	batches := []*subBatch{}
+	batchesByKey := map[string]*subBatch{}
+	for idx, element := range elements {
+		key, err := routingKey(idx, element)
+		if err != nil {
+			return nil, err
+		}
+		batch, ok := batchesByKey[key]
+		if !ok {
+			batch = &subBatch{routingKey: key}
+			batchesByKey[key] = batch
+			batches = append(batches, batch)
+		}
+		batch.indexes = append(batch.indexes, idx)
+		batch.elements = append(batch.elements, element)
+	}
+	return batches, nil
+}
+
+// splits a jsonrpc batch whose elements need different addons or extensions, returns nil when the batch can be relayed as a whole
+func (rpccs *RPCConsumerServer) splitBatchByRouting(url string, req string, connectionType string, metadata []pairingtypes.Metadata) []*subBatch {
+	apiInterface := rpccs.listenEndpoint.ApiInterface
+	if url != "" || (apiInterface != spectypes.APIInterfaceJsonRPC && apiInterface != spectypes.APIInterfaceTendermintRPC) {
+		return nil
+	}
+	elements := splitBatchRequest(req)
+	if elements == nil {
+		return nil
+	}
+	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
+	extensionInfo := rpccs.getExtensionsFromDirectiveHeaders(rpccs.getLatestBlock(), directiveHeaders)
+	chainMessages := make([]chainlib.ChainMessage, len(elements))
+	batches, err := groupBatchElements(elements, func(idx int, element json.RawMessage) (string, error) {
+		chainMessage, err := rpccs.chainParser.ParseMsg(url, element, connectionType, metadata, extensionInfo)
+		if err != nil {
+			return "", err
+		}
+		chainMessages[idx] = chainMessage
+		return batchElementRoutingKey(chainMessage), nil
+	})
+	if err != nil || len(batches) < 2 {
+		// a batch with an invalid element is parsed as a whole so it fails with the same error as before
+		return nil
+	}
+	for _, batch := range batches {
+		for _, idx := range batch.indexes {
+			batch.chainMessages = append(batch.chainMessages, chainMessages[idx])
+		}
+	}
+	return batches
+}
+
+func batchElementRoutingKey(chainMessage chainlib.ChainMessage) string {
+	return chainlib.GetAddon(chainMessage) + ";" + strings.Join(common.GetExtensionNames(chainMessage.GetExtensions()), ",")
+}
+
+// checks the method filter and access control of the whole batch, then relays the sub batches concurrently and reassembles
+// their replies in the order of the original batch. a failed sub batch is answered with jsonrpc errors for its elements and
+// isn't charged, the relay fails only if all of them failed
+func (rpccs *RPCConsumerServer) sendSplitBatch(
+	ctx context.Context,
+	batches []*subBatch,
+	url string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	metadata []pairingtypes.Metadata,
+) (*common.RelayResult, error) {
+	total := 0
+	for _, batch := range batches {
+		total += len(batch.elements)
+	}
+	requests := make([]filteredRequest, total)
+	methods := make([]string, 0, total)
+	batchComputeUnits := make([]uint64, len(batches))
+	totalComputeUnits := uint64(0)
+	for idx, batch := range batches {
+		for elementIdx, chainMessage := range batch.chainMessages {
+			requests[batch.indexes[elementIdx]] = getFilteredRequests(rpccs.listenEndpoint.ApiInterface, string(batch.elements[elementIdx]), chainMessage)[0]
+			api := chainMessage.GetApi()
+			methods = append(methods, strings.Split(api.Name, chainlib.SEP)...)
+			batchComputeUnits[idx] += api.ComputeUnits
+		}
+		totalComputeUnits += batchComputeUnits[idx]
+	}
+	if rejection, err := rpccs.filterRequests(requests); err != nil {
+		return rejection, err
+	}
+	if err := rpccs.checkAccess(dappID, methods, totalComputeUnits); err != nil {
+		return accessDeniedRelayResult(err), err
+	}
+	chargeTime := time.Now()
+
+	utils.LavaFormatDebug("splitting batch by addons and extensions", utils.LogAttr("GUID", ctx), utils.LogAttr("subBatches", len(batches)))
+	relaySentTime := time.Now()
+	responses := make([]subBatchResponse, len(batches))
+	computeUnits := make([]uint64, len(batches))
+	var wg sync.WaitGroup
+	for idx, batch := range batches {
+		wg.Add(1)
+		go func(idx int, batch *subBatch) {
+			defer wg.Done()
+			var subAnalytics *metrics.RelayMetrics
+			if analytics != nil {
+				analyticsCopy := *analytics
+				subAnalytics = &analyticsCopy
+			}
+			relayResult, err := rpccs.sendSubBatch(ctx, batch, url, connectionType, dappID, consumerIp, subAnalytics, metadata)
+			responses[idx] = subBatchResponse{relayResult: relayResult, err: err}
+			if subAnalytics != nil {
+				computeUnits[idx] = subAnalytics.ComputeUnits
+			}
+		}(idx, batch)
+	}
+	wg.Wait()
+
+	var baseResult *common.RelayResult
+	finalized := true
+	refundComputeUnits := uint64(0)
+	for idx, response := range responses {
+		if response.err != nil {
+			refundComputeUnits += batchComputeUnits[idx]
+			finalized = false
+			continue
+		}
+		if baseResult == nil {
+			baseResult = response.relayResult
+		}
+		finalized = finalized && response.relayResult.Finalized
+	}
+	if refundComputeUnits > 0 {
+		rpccs.refundAccess(dappID, refundComputeUnits, chargeTime)
+	}
+	if baseResult == nil {
+		return responses[0].relayResult, responses[0].err
+	}
+	data, complete, err := assembleBatchReplies(batches, responses)
+	if err != nil {
+		return nil, err
+	}
+	if analytics != nil {
+		analytics.Latency = time.Since(relaySentTime).Milliseconds()
+		analytics.ComputeUnits = 0
+		for _, cu := range computeUnits {
+			analytics.ComputeUnits += cu
+		}
+	}
+	relayResult := *baseResult
+	relayResult.Reply = &pairingtypes.RelayReply{Data: data, Metadata: baseResult.GetReply().GetMetadata()}
+	// a batch answered partly with errors isn't finalized, so it isn't cached or compared as finalized data
+	relayResult.Finalized = finalized && complete
+	return &relayResult, nil
+}
+
+// the sub batch was already checked as part of the whole batch, it is parsed on its own as its routing differs from the rest
+func (rpccs *RPCConsumerServer) sendSubBatch(
+	ctx context.Context,
+	batch *subBatch,
+	url string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	metadata []pairingtypes.Metadata,
+) (*common.RelayResult, error) {
+	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
+	relaySentTime := time.Now()
+	req := batch.request()
+	chainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType, metadata, rpccs.getExtensionsFromDirectiveHeaders(rpccs.getLatestBlock(), directiveHeaders))
+	if err != nil {
+		return nil, err
+	}
+	return rpccs.sendAllowedRelay(ctx, url, req, connectionType, dappID, consumerIp, analytics, metadata, directiveHeaders, relaySentTime, chainMessage)
+}
+
+// places the replies of every sub batch in the positions of its elements in the original batch, notifications have no reply.
+// complete is false if a sub batch was answered with errors
+func assembleBatchReplies(batches []*subBatch, responses []subBatchResponse) (data []byte, complete bool, err error) {
+	total := 0
+	for _, batch := range batches {
+		total += len(batch.elements)
+	}
+	replies := make([]json.RawMessage, total)
+	complete = true
+	for idx, batch := range batches {
+		batchReplies, err := subBatchReplies(batch, responses[idx])
+		if err != nil {
+			utils.LavaFormatWarning("sub batch failed, answering its elements with errors", err, utils.LogAttr("routingKey", batch.routingKey))
+			complete = false
+			batchReplies, err = subBatchErrorReplies(batch, err)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		for elementIdx, originalIdx := range batch.indexes {
+			replies[originalIdx] = batchReplies[elementIdx]
+		}
+	}
+	answered := make([]json.RawMessage, 0, total)
+	for _, reply := range replies {
+		if reply != nil {
+			answered = append(answered, reply)
+		}
+	}
+	data, err = json.Marshal(answered)
+	return data, complete, err
+}
+
+// the id of a jsonrpc request or reply in a comparable form, empty for notifications
+func batchElementID(element json.RawMessage) (string, error) {
+	message := struct {
+		ID json.RawMessage `json:"id"`
+	}{}
+	if err := json.Unmarshal(element, &message); err != nil {
+		return "", err
+	}
+	if len(message.ID) == 0 {
+		return "", nil
+	}
+	id := &bytes.Buffer{}
+	if err := json.Compact(id, message.ID); err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// matches the replies to the elements by their id, the reply of a notification is nil
+func subBatchReplies(batch *subBatch, response subBatchResponse) ([]json.RawMessage, error) {
+	if response.err != nil {
+		return nil, response.err
+	}
+	ids := make([]string, len(batch.elements))
+	notifications := 0
+	for idx, element := range batch.elements {
+		id, err := batchElementID(element)
+		if err != nil {
+			return nil, err
+		}
+		ids[idx] = id
+		if id == "" {
+			notifications++
+		}
+	}
+	matched := make([]json.RawMessage, len(batch.elements))
+	if notifications == len(batch.elements) {
+		return matched, nil
+	}
+	data := response.relayResult.GetReply().GetData()
+	var replies []json.RawMessage
+	if len(batch.elements) == 1 {
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("invalid json reply for a batch element")
+		}
+		replies = []json.RawMessage{data}
+	} else if err := json.Unmarshal(data, &replies); err != nil {
+		return nil, fmt.Errorf("sub batch reply is not a json array: %w", err)
+	}
+	repliesByID := map[string][]json.RawMessage{}
+	for _, reply := range replies {
+		id, err := batchElementID(reply)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reply in sub batch: %w", err)
+		}
+		repliesByID[id] = append(repliesByID[id], reply)
+	}
+	for idx, id := range ids {
+		if id == "" {
+			continue
+		}
+		if len(repliesByID[id]) == 0 {
+			return nil, fmt.Errorf("sub batch of %d elements has no reply for id %s", len(batch.elements), id)
+		}
+		matched[idx] = repliesByID[id][0]
+		repliesByID[id] = repliesByID[id][1:]
+	}
+	return matched, nil
+}
+
+func subBatchErrorReplies(batch *subBatch, err error) ([]json.RawMessage, error) {
+	message := "failed relaying the request"
+	if metrics.ReturnMaskedErrors == "false" {
+		message = err.Error()
+	}
+	replies := make([]json.RawMessage, len(batch.elements))
+	for idx, element := range batch.elements {
+		request := rpcInterfaceMessages.JsonrpcMessage{}
+		if unmarshalErr := json.Unmarshal(element, &request); unmarshalErr != nil {
+			return nil, unmarshalErr
+		}
+		if len(request.ID) == 0 {
+			// notifications are not answered
+			continue
+		}
+		reply, marshalErr := json.Marshal(rpcInterfaceMessages.JsonrpcMessage{Version: rpcclient.Vsn, ID: request.ID, Error: &rpcclient.JsonError{Code: jsonRPCInternalErrorCode, Message: message}})
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		replies[idx] = reply
+	}
+	return replies, nil
+}
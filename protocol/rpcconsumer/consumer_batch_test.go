@@ -0,0 +1,124 @@
+package rpcconsumer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/metrics"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitBatchRequest(t *testing.T) {
+	require.Nil(t, splitBatchRequest(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`))
+	require.Nil(t, splitBatchRequest(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}]`))
+	require.Nil(t, splitBatchRequest(`[{"jsonrpc":"2.0","id":1,`))
+	require.Len(t, splitBatchRequest(` [{"id":1,"method":"eth_blockNumber"},{"id":2,"method":"eth_chainId"}]`), 2)
+}
+
+func TestGroupBatchElements(t *testing.T) {
+	elements := splitBatchRequest(`[
+		{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},
+		{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction","params":["0x1"]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_getBalance","params":["0x1","0x1"]},
+		{"jsonrpc":"2.0","id":4,"method":"eth_chainId"}
+	]`)
+	routingKeys := map[string]string{"eth_blockNumber": ";", "debug_traceTransaction": "debug;", "eth_getBalance": ";archive", "eth_chainId": ";"}
+	routingKey := func(idx int, element json.RawMessage) (string, error) {
+		request := struct{ Method string }{}
+		require.NoError(t, json.Unmarshal(element, &request))
+		key, ok := routingKeys[request.Method]
+		if !ok {
+			return "", fmt.Errorf("unsupported method %s", request.Method)
+		}
+		return key, nil
+	}
+	batches, err := groupBatchElements(elements, routingKey)
+	require.NoError(t, err)
+	require.Len(t, batches, 3)
+	require.Equal(t, []int{0, 3}, batches[0].indexes)
+	require.Equal(t, []int{1}, batches[1].indexes)
+	require.Equal(t, []int{2}, batches[2].indexes)
+	// a single element is relayed as a single request
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction","params":["0x1"]}`, batches[1].request())
+	require.JSONEq(t, `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":4,"method":"eth_chainId"}]`, batches[0].request())
+
+	delete(routingKeys, "eth_chainId")
+	_, err = groupBatchElements(elements, routingKey)
+	require.Error(t, err)
+}
+
+func TestAssembleBatchReplies(t *testing.T) {
+	batches := []*subBatch{
+		{indexes: []int{0, 3}, elements: splitBatchRequest(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"four","method":"eth_chainId"}]`)},
+		{indexes: []int{1}, elements: []json.RawMessage{json.RawMessage(`{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}`)}},
+		{indexes: []int{2}, elements: []json.RawMessage{json.RawMessage(`{"jsonrpc":"2.0","id":3,"method":"eth_getBalance"}`)}},
+	}
+	reply := func(data string) subBatchResponse {
+		return subBatchResponse{relayResult: &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte(data)}}}
+	}
+	responses := []subBatchResponse{
+		reply(`[{"jsonrpc":"2.0","id":1,"result":"0x10"},{"jsonrpc":"2.0","id":"four","result":"0x1"}]`),
+		reply(`{"jsonrpc":"2.0","id":2,"result":{}}`),
+		{err: fmt.Errorf("no providers with the archive extension")},
+	}
+	defer func(returnMaskedErrors string) { metrics.ReturnMaskedErrors = returnMaskedErrors }(metrics.ReturnMaskedErrors)
+	metrics.ReturnMaskedErrors = "false"
+	data, complete, err := assembleBatchReplies(batches, responses)
+	require.NoError(t, err)
+	require.False(t, complete)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"result":"0x10"},
+		{"jsonrpc":"2.0","id":2,"result":{}},
+		{"jsonrpc":"2.0","id":3,"error":{"code":-32603,"message":"no providers with the archive extension"}},
+		{"jsonrpc":"2.0","id":"four","result":"0x1"}
+	]`, string(data))
+
+	// a sub batch reply that doesn't match its elements is answered with errors
+	responses[0] = reply(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}}`)
+	data, _, err = assembleBatchReplies(batches, responses)
+	require.NoError(t, err)
+	var replies []map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &replies))
+	require.Len(t, replies, 4)
+	require.Equal(t, float64(1), replies[0]["id"])
+	require.Equal(t, "four", replies[3]["id"])
+	require.True(t, strings.Contains(fmt.Sprint(replies[3]["error"]), "not a json array"))
+}
+
+func TestAssembleBatchRepliesWithNotifications(t *testing.T) {
+	batches := []*subBatch{
+		{indexes: []int{0, 1, 3}, elements: splitBatchRequest(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","method":"eth_chainId"},{"jsonrpc":"2.0","id":"four","method":"eth_chainId"}]`)},
+		{indexes: []int{2}, elements: []json.RawMessage{json.RawMessage(`{"jsonrpc":"2.0","method":"debug_traceTransaction"}`)}},
+	}
+	reply := func(data string) subBatchResponse {
+		return subBatchResponse{relayResult: &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte(data)}}}
+	}
+	// notifications are not answered and replies can come in any order
+	responses := []subBatchResponse{
+		reply(`[{"jsonrpc":"2.0","id":"four","result":"0x1"},{"jsonrpc":"2.0","id":1,"result":"0x10"}]`),
+		reply(``),
+	}
+	data, complete, err := assembleBatchReplies(batches, responses)
+	require.NoError(t, err)
+	require.True(t, complete)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"result":"0x10"},
+		{"jsonrpc":"2.0","id":"four","result":"0x1"}
+	]`, string(data))
+
+	// a missing reply fails the sub batch, its notifications are still not answered
+	responses[0] = reply(`[{"jsonrpc":"2.0","id":1,"result":"0x10"}]`)
+	data, complete, err = assembleBatchReplies(batches, responses)
+	require.NoError(t, err)
+	require.False(t, complete)
+	var replies []map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &replies))
+	require.Len(t, replies, 2)
+	require.Equal(t, float64(1), replies[0]["id"])
+	require.NotNil(t, replies[0]["error"])
+	require.Equal(t, "four", replies[1]["id"])
+}
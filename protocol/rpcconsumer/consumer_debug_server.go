@@ -0,0 +1,104 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/utils"
+)
+
+const (
+	DebugServerListenFlagName = "debug-server-listen-address"
+	DebugProvidersPath        = "/debug/providers"
+	debugServerReadTimeout    = 10 * time.Second
+)
+
+type ConsumerDebugInfo struct {
+	Strategy  string                                         `json:"strategy"`
+	Endpoints []*lavasession.ConsumerSessionManagerDebugInfo `json:"endpoints"`
+}
+
+// ConsumerDebugServer exposes the pairing and the provider optimizer state of each endpoint over http,
+// so it is possible to see why relays were sent to a provider
+type ConsumerDebugServer struct {
+	lock            sync.RWMutex
+	strategy        provideroptimizer.Strategy
+	sessionManagers map[string]*lavasession.ConsumerSessionManager // key is the rpc endpoint key
+}
+
+func NewConsumerDebugServer(strategy provideroptimizer.Strategy) *ConsumerDebugServer {
+	return &ConsumerDebugServer{strategy: strategy, sessionManagers: map[string]*lavasession.ConsumerSessionManager{}}
+}
+
+func (cds *ConsumerDebugServer) RegisterSessionManager(consumerSessionManager *lavasession.ConsumerSessionManager) {
+	if cds == nil {
+		return
+	}
+	rpcEndpoint := consumerSessionManager.RPCEndpoint()
+	cds.lock.Lock()
+	defer cds.lock.Unlock()
+	cds.sessionManagers[rpcEndpoint.Key()] = consumerSessionManager
+}
+
+// returns the debug info of the endpoints matching the chain id and api interface, empty values match all endpoints
+func (cds *ConsumerDebugServer) GetDebugInfo(chainID string, apiInterface string) *ConsumerDebugInfo {
+	cds.lock.RLock()
+	defer cds.lock.RUnlock()
+	debugInfo := &ConsumerDebugInfo{Strategy: strategyNames[cds.strategy], Endpoints: []*lavasession.ConsumerSessionManagerDebugInfo{}}
+	for _, consumerSessionManager := range cds.sessionManagers {
+		rpcEndpoint := consumerSessionManager.RPCEndpoint()
+		if (chainID != "" && rpcEndpoint.ChainID != chainID) || (apiInterface != "" && rpcEndpoint.ApiInterface != apiInterface) {
+			continue
+		}
+		debugInfo.Endpoints = append(debugInfo.Endpoints, consumerSessionManager.GetDebugInfo())
+	}
+	sort.Slice(debugInfo.Endpoints, func(i, j int) bool {
+		if debugInfo.Endpoints[i].ChainID != debugInfo.Endpoints[j].ChainID {
+			return debugInfo.Endpoints[i].ChainID < debugInfo.Endpoints[j].ChainID
+		}
+		return debugInfo.Endpoints[i].ApiInterface < debugInfo.Endpoints[j].ApiInterface
+	})
+	return debugInfo
+}
+
+func (cds *ConsumerDebugServer) handleProviders(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	query := request.URL.Query()
+	debugInfo := cds.GetDebugInfo(query.Get("chain-id"), query.Get("api-interface"))
+	writer.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(debugInfo); err != nil {
+		utils.LavaFormatWarning("failed writing debug info response", err)
+	}
+}
+
+// starts listening in the background, the server is shut down when the context is done
+func (cds *ConsumerDebugServer) Start(ctx context.Context, listenAddress string) {
+	if cds == nil || listenAddress == "" || listenAddress == metrics.DisabledFlagOption {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(DebugProvidersPath, cds.handleProviders)
+	server := &http.Server{Addr: listenAddress, Handler: mux, ReadHeaderTimeout: debugServerReadTimeout}
+	go func() {
+		utils.LavaFormatInfo("debug server listening", utils.LogAttr("address", listenAddress), utils.LogAttr("path", DebugProvidersPath))
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			utils.LavaFormatError("debug server failed", err, utils.LogAttr("address", listenAddress))
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+}
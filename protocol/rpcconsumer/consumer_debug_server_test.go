@@ -0,0 +1,48 @@
+package rpcconsumer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumerDebugServerProviders(t *testing.T) {
+	debugServer := NewConsumerDebugServer(provideroptimizer.STRATEGY_LATENCY)
+	for _, rpcEndpoint := range []*lavasession.RPCEndpoint{
+		{ChainID: "LAV1", ApiInterface: "rest"},
+		{ChainID: "LAV1", ApiInterface: "tendermintrpc"},
+		{ChainID: "ETH1", ApiInterface: "jsonrpc"},
+	} {
+		optimizer := provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_LATENCY, time.Second, time.Second, 1)
+		debugServer.RegisterSessionManager(lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer, nil))
+	}
+
+	getDebugInfo := func(url string) *ConsumerDebugInfo {
+		recorder := httptest.NewRecorder()
+		debugServer.handleProviders(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+		debugInfo := &ConsumerDebugInfo{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), debugInfo))
+		return debugInfo
+	}
+	debugInfo := getDebugInfo(DebugProvidersPath)
+	require.Equal(t, "latency", debugInfo.Strategy)
+	require.Len(t, debugInfo.Endpoints, 3)
+	require.Equal(t, "ETH1", debugInfo.Endpoints[0].ChainID)
+
+	debugInfo = getDebugInfo(DebugProvidersPath + "?chain-id=LAV1")
+	require.Len(t, debugInfo.Endpoints, 2)
+	debugInfo = getDebugInfo(DebugProvidersPath + "?chain-id=LAV1&api-interface=rest")
+	require.Len(t, debugInfo.Endpoints, 1)
+	require.Equal(t, "rest", debugInfo.Endpoints[0].ApiInterface)
+
+	recorder := httptest.NewRecorder()
+	debugServer.handleProviders(recorder, httptest.NewRequest(http.MethodPost, DebugProvidersPath, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
@@ -0,0 +1,135 @@
+package rpcconsumer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/protocopy"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+var HedgeBudgetExceededError = sdkerrors.New("HedgeBudgetExceeded Error", 686, "compute units budget for hedged relays is exhausted")
+
+type relayAttempt struct {
+	relayResult *common.RelayResult
+	err         error
+	hedged      bool
+}
+
+// hedgeBudget limits the compute units spent on hedged relays to a percentage of all the relayed compute units,
+// a hedged relay is paid for even when its reply is not used
+type hedgeBudget struct {
+	lock         sync.Mutex
+	maxCUPercent uint64
+	totalCU      uint64
+	hedgeCU      uint64
+}
+
+func newHedgeBudget(maxCUPercent uint64) *hedgeBudget {
+	return &hedgeBudget{maxCUPercent: maxCUPercent}
+}
+
+func (hb *hedgeBudget) addRelayCU(cu uint64) {
+	if hb == nil {
+		return
+	}
+	hb.lock.Lock()
+	defer hb.lock.Unlock()
+	hb.totalCU += cu
+}
+
+func (hb *hedgeBudget) canHedge(cu uint64) bool {
+	if hb == nil || hb.maxCUPercent == 0 {
+		return false
+	}
+	hb.lock.Lock()
+	defer hb.lock.Unlock()
+	return (hb.hedgeCU+cu)*100 <= (hb.totalCU+cu)*hb.maxCUPercent
+}
+
+// reserves the compute units of a hedged relay, returns false if they exceed the budget
+func (hb *hedgeBudget) tryHedge(cu uint64) bool {
+	if hb == nil || hb.maxCUPercent == 0 {
+		return false
+	}
+	hb.lock.Lock()
+	defer hb.lock.Unlock()
+	if (hb.hedgeCU+cu)*100 > (hb.totalCU+cu)*hb.maxCUPercent {
+		return false
+	}
+	hb.hedgeCU += cu
+	hb.totalCU += cu
+	return true
+}
+
+// returns how long to wait for a reply before sending a hedged relay, 0 means the relay is not hedged
+func (rpccs *RPCConsumerServer) getHedgeThreshold(chainMessage chainlib.ChainMessage, relayTimeout time.Duration) time.Duration {
+	if rpccs.hedgeBudget == nil || rpccs.hedgeBudget.maxCUPercent == 0 || chainlib.IsHangingApi(chainMessage) {
+		// hanging apis wait for new blocks by design, a slow reply doesn't mean a slow provider
+		return 0
+	}
+	threshold := rpccs.consumerSessionManager.LatencyPercentile(chainlib.GetComputeUnits(chainMessage), rpccs.hedgeLatencyPercentile)
+	if threshold >= relayTimeout {
+		return 0
+	}
+	return threshold
+}
+
+// acquires sessions for a relay attempt and sends it in the background, the result is written to attempts.
+// hedged attempts run in parallel to an attempt in flight so they are sent on a copy of the relay data, and only if the hedge budget allows it.
+// the returned cancel stops the attempt once another attempt's reply is used
+func (rpccs *RPCConsumerServer) sendRelayAttempt(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	consumerIp string,
+	unwantedProviders map[string]struct{},
+	timeouts int,
+	hedged bool,
+	attempts chan<- *relayAttempt,
+) (context.CancelFunc, error) {
+	cu := chainlib.GetComputeUnits(chainMessage)
+	relayData := relayRequestData
+	if hedged {
+		if !rpccs.hedgeBudget.canHedge(cu) {
+			return nil, HedgeBudgetExceededError
+		}
+		relayData = &pairingtypes.RelayPrivateData{}
+		err := protocopy.DeepCopyProtoObject(relayRequestData, relayData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sessions, err := rpccs.getSessionsForRelay(ctx, chainMessage, relayData, unwantedProviders)
+	if err != nil {
+		return nil, err
+	}
+	sessionsCU := cu * uint64(len(sessions))
+	if hedged {
+		if !rpccs.hedgeBudget.tryHedge(sessionsCU) {
+			// the hedge is not sent, release the sessions and let the providers be picked on retries
+			for providerAddress, sessionInfo := range sessions {
+				delete(unwantedProviders, providerAddress)
+				if errUnused := rpccs.consumerSessionManager.OnSessionUnUsed(sessionInfo.Session); errUnused != nil {
+					utils.LavaFormatError("failed releasing unused hedge session", errUnused, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+				}
+			}
+			return nil, HedgeBudgetExceededError
+		}
+	} else {
+		rpccs.hedgeBudget.addRelayCU(sessionsCU)
+	}
+	// the attempt is only cancelled once another attempt's reply is used, not when the client goes away
+	attemptCtx, cancel := context.WithCancel(detachedRelayContext(ctx))
+	go func() {
+		relayResult, err := rpccs.sendRelayToSessions(attemptCtx, chainMessage, relayData, dappID, consumerIp, sessions, timeouts)
+		attempts <- &relayAttempt{relayResult: relayResult, err: err, hedged: hedged}
+	}()
+	return cancel, nil
+}
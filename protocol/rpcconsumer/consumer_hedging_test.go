@@ -0,0 +1,31 @@
+package rpcconsumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHedgeBudget(t *testing.T) {
+	var nilBudget *hedgeBudget
+	require.False(t, nilBudget.canHedge(1))
+	require.False(t, nilBudget.tryHedge(1))
+	nilBudget.addRelayCU(10)
+
+	disabled := newHedgeBudget(0)
+	disabled.addRelayCU(1000)
+	require.False(t, disabled.tryHedge(1))
+
+	budget := newHedgeBudget(10)
+	// nothing was relayed yet
+	require.False(t, budget.tryHedge(10))
+	budget.addRelayCU(90)
+	require.True(t, budget.canHedge(10))
+	require.True(t, budget.tryHedge(10)) // 10 out of 100
+	require.False(t, budget.canHedge(10))
+	require.False(t, budget.tryHedge(10))
+	budget.addRelayCU(90)
+	require.True(t, budget.tryHedge(10)) // 20 out of 200
+	require.Equal(t, uint64(20), budget.hedgeCU)
+	require.Equal(t, uint64(200), budget.totalCU)
+}
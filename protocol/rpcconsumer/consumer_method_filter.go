@@ -0,0 +1,252 @@
+package rpcconsumer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/parser"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	jsonRPCMethodNotFoundCode = -32601
+	jsonRPCInvalidParamsCode  = -32602
+)
+
+// one request of a relay, a batch has one per element
+type filteredRequest struct {
+	method   string
+	id       json.RawMessage // jsonrpc id, empty for other interfaces
+	rpcInput parser.RPCInput // nil when the params can't be parsed
+}
+
+// methodFilter enforces the allowed and denied methods and the method constraints of a listener endpoint
+type methodFilter struct {
+	allowedMethods    []string
+	deniedMethods     []string
+	methodConstraints []lavasession.MethodConstraint
+}
+
+// returns nil when the endpoint has no filters configured
+func newMethodFilter(endpoint *lavasession.RPCEndpoint) (*methodFilter, error) {
+	if len(endpoint.AllowedMethods) == 0 && len(endpoint.DeniedMethods) == 0 && len(endpoint.MethodConstraints) == 0 {
+		return nil, nil
+	}
+	patterns := append(append([]string{}, endpoint.AllowedMethods...), endpoint.DeniedMethods...)
+	for _, constraint := range endpoint.MethodConstraints {
+		patterns = append(patterns, constraint.Method)
+		if constraint.MaxBlockRange == 0 {
+			return nil, fmt.Errorf("method constraint of %q has no max-block-range", constraint.Method)
+		}
+		if len(constraint.FromBlockParam) == 0 || len(constraint.ToBlockParam) == 0 {
+			return nil, fmt.Errorf("method constraint of %q needs both from-block-param and to-block-param", constraint.Method)
+		}
+	}
+	for _, pattern := range patterns {
+		if pattern == "" || strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+			return nil, fmt.Errorf("invalid method pattern %q, only a trailing * is supported", pattern)
+		}
+	}
+	return &methodFilter{
+		allowedMethods:    endpoint.AllowedMethods,
+		deniedMethods:     endpoint.DeniedMethods,
+		methodConstraints: endpoint.MethodConstraints,
+	}, nil
+}
+
+func matchesMethodPattern(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+		} else if method == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// a relay is rejected as a whole if any of its requests is, latestBlock resolves "latest" in block ranges and is 0 when unknown
+func (mf *methodFilter) check(requests []filteredRequest, latestBlock uint64) error {
+	if mf == nil {
+		return nil
+	}
+	for _, request := range requests {
+		if len(mf.allowedMethods) > 0 && !matchesMethodPattern(mf.allowedMethods, request.method) {
+			return sdkerrors.Wrapf(common.MethodDeniedError, "%s is not in the allowed methods", request.method)
+		}
+		if matchesMethodPattern(mf.deniedMethods, request.method) {
+			return sdkerrors.Wrapf(common.MethodDeniedError, "%s is denied", request.method)
+		}
+		for _, constraint := range mf.methodConstraints {
+			if !matchesMethodPattern([]string{constraint.Method}, request.method) || request.rpcInput == nil {
+				continue
+			}
+			if blockRange, ok := requestedBlockRange(request.rpcInput, constraint, latestBlock); ok && blockRange > constraint.MaxBlockRange {
+				return sdkerrors.Wrapf(common.MethodConstraintError, "%s requested a range of %d blocks, the max is %d", request.method, blockRange, constraint.MaxBlockRange)
+			}
+		}
+	}
+	return nil
+}
+
+// returns false when the range can't be resolved, in that case the node handles the request params
+func requestedBlockRange(rpcInput parser.RPCInput, constraint lavasession.MethodConstraint, latestBlock uint64) (uint64, bool) {
+	fromBlock, ok := resolveBlockParam(rpcInput, constraint.FromBlockParam, latestBlock)
+	if !ok {
+		return 0, false
+	}
+	toBlock, ok := resolveBlockParam(rpcInput, constraint.ToBlockParam, latestBlock)
+	if !ok || toBlock < fromBlock {
+		return 0, false
+	}
+	return toBlock - fromBlock + 1, true
+}
+
+// a missing param defaults to the latest block like in eth_getLogs
+func resolveBlockParam(rpcInput parser.RPCInput, paramPath []string, latestBlock uint64) (uint64, bool) {
+	block := spectypes.LATEST_BLOCK
+	if value, found := findParam(rpcInput.GetParams(), paramPath); found {
+		var err error
+		block, err = rpcInput.ParseBlock(value)
+		if err != nil {
+			return 0, false
+		}
+	}
+	switch {
+	case block == spectypes.EARLIEST_BLOCK:
+		return 0, true
+	case block >= 0:
+		return uint64(block), true
+	case latestBlock == 0:
+		return 0, false
+	default:
+		// latest, pending, safe and finalized are all treated as the latest block
+		return latestBlock, true
+	}
+}
+
+// walks the params by indexes of ordered params and keys of dictionaries, like the canonical parser
+func findParam(params interface{}, paramPath []string) (string, bool) {
+	current := params
+	for _, key := range paramPath {
+		switch typed := current.(type) {
+		case []interface{}:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(typed) {
+				return "", false
+			}
+			current = typed[idx]
+		case map[string]interface{}:
+			value, ok := typed[key]
+			if !ok {
+				return "", false
+			}
+			current = value
+		default:
+			return "", false
+		}
+	}
+	switch value := current.(type) {
+	case string:
+		return value, true
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// jsonrpc bodies are split to their batch elements so their params and ids are available, other interfaces are a single request
+func getFilteredRequests(apiInterface string, req string, chainMessage chainlib.ChainMessage) []filteredRequest {
+	methods := strings.Split(chainMessage.GetApi().Name, chainlib.SEP)
+	if apiInterface == spectypes.APIInterfaceJsonRPC || apiInterface == spectypes.APIInterfaceTendermintRPC {
+		msgs, err := rpcInterfaceMessages.ParseJsonRPCMsg([]byte(req))
+		if err == nil && len(msgs) == len(methods) {
+			requests := make([]filteredRequest, len(msgs))
+			for idx := range msgs {
+				requests[idx] = filteredRequest{method: methods[idx], id: msgs[idx].ID, rpcInput: msgs[idx]}
+			}
+			return requests
+		}
+	}
+	request := filteredRequest{method: methods[0]}
+	if paramsMessage, ok := chainMessage.(interface{ GetRPCInput() parser.RPCInput }); ok {
+		request.rpcInput = paramsMessage.GetRPCInput()
+	}
+	return []filteredRequest{request}
+}
+
+// returns the rejection error along with a reply in the error format of the api interface, or nil if the relay is allowed
+func (rpccs *RPCConsumerServer) filterMethods(req string, chainMessage chainlib.ChainMessage) (*common.RelayResult, error) {
+	if rpccs.methodFilter == nil {
+		return nil, nil
+	}
+	return rpccs.filterRequests(getFilteredRequests(rpccs.listenEndpoint.ApiInterface, req, chainMessage))
+}
+
+func (rpccs *RPCConsumerServer) filterRequests(requests []filteredRequest) (*common.RelayResult, error) {
+	if rpccs.methodFilter == nil {
+		return nil, nil
+	}
+	apiInterface := rpccs.listenEndpoint.ApiInterface
+	err := rpccs.methodFilter.check(requests, rpccs.getLatestBlock())
+	if err == nil {
+		return nil, nil
+	}
+	utils.LavaFormatDebug("relay rejected by the endpoint method filter", utils.LogAttr("endpoint", rpccs.listenEndpoint.Key()), utils.LogAttr("reason", err.Error()))
+	relayResult, marshalErr := methodFilterRejection(apiInterface, requests, err)
+	if marshalErr != nil {
+		utils.LavaFormatError("failed marshaling method filter rejection", marshalErr)
+		return &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte(err.Error())}, StatusCode: http.StatusForbidden}, err
+	}
+	return relayResult, err
+}
+
+func methodFilterRejection(apiInterface string, requests []filteredRequest, err error) (*common.RelayResult, error) {
+	var data []byte
+	var marshalErr error
+	switch apiInterface {
+	case spectypes.APIInterfaceJsonRPC, spectypes.APIInterfaceTendermintRPC:
+		code := jsonRPCMethodNotFoundCode
+		if common.MethodConstraintError.Is(err) {
+			code = jsonRPCInvalidParamsCode
+		}
+		replies := make([]rpcInterfaceMessages.JsonrpcMessage, len(requests))
+		for idx, request := range requests {
+			id := request.id
+			if len(id) == 0 {
+				id = json.RawMessage("-1") // tendermint uri requests have no id
+			}
+			replies[idx] = rpcInterfaceMessages.JsonrpcMessage{Version: rpcclient.Vsn, ID: id, Error: &rpcclient.JsonError{Code: code, Message: err.Error()}}
+		}
+		if len(replies) == 1 {
+			data, marshalErr = json.Marshal(replies[0])
+		} else {
+			data, marshalErr = json.Marshal(replies)
+		}
+	case spectypes.APIInterfaceGrpc:
+		// the grpc listener returns node error replies as a grpc status
+		data, marshalErr = json.Marshal(chainlib.GrpcNodeErrorResponse{ErrorMessage: err.Error(), ErrorCode: uint32(codes.PermissionDenied)})
+	default:
+		// the error format of cosmos rest gateways
+		data, marshalErr = json.Marshal(map[string]interface{}{"code": codes.PermissionDenied, "message": err.Error(), "details": []interface{}{}})
+	}
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: data}, StatusCode: http.StatusForbidden}, nil
+}
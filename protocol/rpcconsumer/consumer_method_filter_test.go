@@ -0,0 +1,101 @@
+package rpcconsumer
+
+import (
+	"net/http"
+	"testing"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMethodFilter(t *testing.T) {
+	filter, err := newMethodFilter(&lavasession.RPCEndpoint{})
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	invalid := []*lavasession.RPCEndpoint{
+		{DeniedMethods: []string{"debug_*_block"}},
+		{AllowedMethods: []string{""}},
+		{MethodConstraints: []lavasession.MethodConstraint{{Method: "eth_getLogs", FromBlockParam: []string{"0", "fromBlock"}, ToBlockParam: []string{"0", "toBlock"}}}},
+		{MethodConstraints: []lavasession.MethodConstraint{{Method: "eth_getLogs", FromBlockParam: []string{"0", "fromBlock"}, MaxBlockRange: 100}}},
+	}
+	for _, endpoint := range invalid {
+		_, err := newMethodFilter(endpoint)
+		require.Error(t, err, endpoint)
+	}
+}
+
+func TestMethodFilterCheck(t *testing.T) {
+	filter, err := newMethodFilter(&lavasession.RPCEndpoint{
+		ApiInterface:   spectypes.APIInterfaceJsonRPC,
+		AllowedMethods: []string{"eth_*", "net_version"},
+		DeniedMethods:  []string{"eth_sign*"},
+		MethodConstraints: []lavasession.MethodConstraint{
+			{Method: "eth_getLogs", FromBlockParam: []string{"0", "fromBlock"}, ToBlockParam: []string{"0", "toBlock"}, MaxBlockRange: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	playbook := []struct {
+		name   string
+		api    string
+		req    string
+		denied *sdkerrors.Error
+	}{
+		{name: "allowed", api: "eth_blockNumber", req: `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`},
+		{name: "not allowed", api: "debug_traceBlockByNumber", req: `{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber","params":["0x10"]}`, denied: common.MethodDeniedError},
+		{name: "denied prefix", api: "eth_signTransaction", req: `{"jsonrpc":"2.0","id":1,"method":"eth_signTransaction","params":[{}]}`, denied: common.MethodDeniedError},
+		{name: "range within the limit", api: "eth_getLogs", req: `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x100","toBlock":"0x163"}]}`},
+		{name: "range over the limit", api: "eth_getLogs", req: `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x100","toBlock":"0x164"}]}`, denied: common.MethodConstraintError},
+		{name: "earliest to latest", api: "eth_getLogs", req: `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"earliest"}]}`, denied: common.MethodConstraintError},
+		{name: "missing blocks are latest", api: "eth_getLogs", req: `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"address":"0x1"}]}`},
+		{name: "from a block to latest", api: "eth_getLogs", req: `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x3e7"}]}`},
+		{name: "batch with a denied method", api: "eth_blockNumber&eth_sign", req: `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]},{"jsonrpc":"2.0","id":2,"method":"eth_sign","params":[]}]`, denied: common.MethodDeniedError},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			chainMessage := &quorumChainMessage{api: &spectypes.Api{Name: play.api}}
+			err := filter.check(getFilteredRequests(spectypes.APIInterfaceJsonRPC, play.req, chainMessage), 1000)
+			if play.denied == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, play.denied.Is(err), err)
+		})
+	}
+
+	// "latest" can't be resolved without a known latest block, the node handles the request
+	chainMessage := &quorumChainMessage{api: &spectypes.Api{Name: "eth_getLogs"}}
+	require.NoError(t, filter.check(getFilteredRequests(spectypes.APIInterfaceJsonRPC, `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x1"}]}`, chainMessage), 0))
+}
+
+func TestMethodFilterRejection(t *testing.T) {
+	batch := &quorumChainMessage{api: &spectypes.Api{Name: "eth_blockNumber&eth_sign"}}
+	requests := getFilteredRequests(spectypes.APIInterfaceJsonRPC, `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"b","method":"eth_sign"}]`, batch)
+	relayResult, err := methodFilterRejection(spectypes.APIInterfaceJsonRPC, requests, common.MethodDeniedError)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, relayResult.StatusCode)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method is not allowed on this endpoint"}},
+		{"jsonrpc":"2.0","id":"b","error":{"code":-32601,"message":"method is not allowed on this endpoint"}}
+	]`, string(relayResult.Reply.Data))
+
+	uri := &quorumChainMessage{api: &spectypes.Api{Name: "block"}}
+	relayResult, err = methodFilterRejection(spectypes.APIInterfaceTendermintRPC, getFilteredRequests(spectypes.APIInterfaceTendermintRPC, "", uri), common.MethodConstraintError)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32602,"message":"request params exceed the limits of this endpoint"}}`, string(relayResult.Reply.Data))
+
+	rest := &quorumChainMessage{api: &spectypes.Api{Name: "/cosmos/tx/v1beta1/txs"}}
+	relayResult, err = methodFilterRejection(spectypes.APIInterfaceRest, getFilteredRequests(spectypes.APIInterfaceRest, "", rest), common.MethodDeniedError)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, relayResult.StatusCode)
+	require.JSONEq(t, `{"code":7,"message":"method is not allowed on this endpoint","details":[]}`, string(relayResult.Reply.Data))
+
+	grpc := &quorumChainMessage{api: &spectypes.Api{Name: "cosmos.tx.v1beta1.Service/BroadcastTx"}}
+	relayResult, err = methodFilterRejection(spectypes.APIInterfaceGrpc, getFilteredRequests(spectypes.APIInterfaceGrpc, "", grpc), common.MethodDeniedError)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"error_code":7,"error_message":"method is not allowed on this endpoint"}`, string(relayResult.Reply.Data))
+}
@@ -0,0 +1,245 @@
+package rpcconsumer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	// the quorum used by the accuracy strategy, a single disagreeing provider can't change the reply
+	AccuracyQuorumSize          = 3
+	quorumConflictReportTimeout = time.Minute
+)
+
+// returns how many providers need to answer a relay, the directive header overrides the endpoint's per api configuration
+func (rpccs *RPCConsumerServer) getQuorumSize(chainMessage chainlib.ChainMessage, directiveHeaders map[string]string) int {
+	quorumSize := rpccs.requiredResponses
+	if rpccs.strategy == provideroptimizer.STRATEGY_ACCURACY && quorumSize < AccuracyQuorumSize && chainMessage.GetApi().Category.Deterministic {
+		// only replies on a specific block can be compared, latest block relays are verified with data reliability instead
+		if reqBlock, _ := chainMessage.RequestedBlock(); reqBlock >= 0 {
+			quorumSize = AccuracyQuorumSize
+		}
+	}
+	apiName := chainMessage.GetApi().Name
+	for name, apiQuorum := range rpccs.listenEndpoint.ApiQuorum {
+		// config keys are lower cased when loaded by viper
+		if strings.EqualFold(name, apiName) {
+			quorumSize = apiQuorum
+			break
+		}
+	}
+	if quorumStr, ok := directiveHeaders[common.QUORUM_HEADER_NAME]; ok {
+		headerQuorum, err := strconv.Atoi(quorumStr)
+		if err == nil {
+			quorumSize = headerQuorum
+			if quorumSize > rpccs.maxQuorum {
+				// the header is set by the client, it can't fan a relay out to every provider
+				utils.LavaFormatDebug("quorum directive header over the max quorum", utils.LogAttr("value", quorumStr), utils.LogAttr("maxQuorum", rpccs.maxQuorum))
+				quorumSize = rpccs.maxQuorum
+			}
+		} else {
+			utils.LavaFormatWarning("invalid quorum directive header", err, utils.LogAttr("value", quorumStr))
+		}
+	}
+	if quorumSize < 1 {
+		return 1
+	}
+	return quorumSize
+}
+
+// sends the relay to quorumSize different providers in parallel, and returns the reply the majority of them agree on.
+// providers that disagree with the majority on finalized data are reported through a conflict detection
+func (rpccs *RPCConsumerServer) sendQuorumRelay(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	consumerIp string,
+	unwantedProviders map[string]struct{},
+	quorumSize int,
+) (relayResult *common.RelayResult, retries uint64, errRet error) {
+	type relayResponse struct {
+		relayResult *common.RelayResult
+		err         error
+	}
+	relayResults := []*common.RelayResult{}
+	relayErrors := &RelayErrors{}
+	errorRelayResult := &common.RelayResult{} // returned on error
+	relayTimeout := chainlib.GetRelayTimeout(chainMessage, rpccs.chainParser, 0)
+	for ; retries < MaxRelayRetries && len(relayResults) < quorumSize; retries++ {
+		sessions, err := rpccs.getQuorumSessions(ctx, chainMessage, relayRequestData, unwantedProviders, quorumSize-len(relayResults))
+		if len(sessions) == 0 {
+			relayErrors.relayErrors = append(relayErrors.relayErrors, RelayError{err: err})
+			break
+		}
+		responses := make(chan *relayResponse, len(sessions))
+		for providerPublicAddress, sessionInfo := range sessions {
+			go func(providerPublicAddress string, sessionInfo *lavasession.SessionInfo) {
+				// quorum compares the providers' answers so the cache must not answer for them
+				localRelayResult, errResponse := rpccs.relayToSession(detachedRelayContext(ctx), chainMessage, relayRequestData, dappID, consumerIp, providerPublicAddress, sessionInfo, relayTimeout, false)
+				responses <- &relayResponse{relayResult: localRelayResult, err: errResponse}
+			}(providerPublicAddress, sessionInfo)
+		}
+		for range sessions {
+			response := <-responses
+			if response.err != nil {
+				if response.relayResult.GetStatusCode() != 0 {
+					errorRelayResult.StatusCode = response.relayResult.GetStatusCode()
+				}
+				relayErrors.relayErrors = append(relayErrors.relayErrors, RelayError{err: response.err, ProviderInfo: response.relayResult.ProviderInfo})
+				continue
+			}
+			relayResults = append(relayResults, response.relayResult)
+		}
+	}
+
+	majority, minority := quorumMajority(relayResults)
+	requiredAgreement := quorumSize/2 + 1
+	if len(majority) < requiredAgreement {
+		if len(relayErrors.relayErrors) > 0 {
+			return errorRelayResult, retries, utils.LavaFormatError("Failed reaching quorum", nil, utils.LogAttr("GUID", ctx), utils.LogAttr("quorum", quorumSize), utils.LogAttr("agreeing", len(majority)), utils.LogAttr("responses", len(relayResults)), relayErrors.GetBestErrorMessageForUser())
+		}
+		return errorRelayResult, retries, utils.LavaFormatError("Failed reaching quorum", nil, utils.LogAttr("GUID", ctx), utils.LogAttr("quorum", quorumSize), utils.LogAttr("agreeing", len(majority)), utils.LogAttr("responses", len(relayResults)))
+	}
+	relayResult = majority[0]
+	for _, disagreeingResult := range minority {
+		// the request context ends with the relay, the report outlives it
+		go func(disagreeingResult *common.RelayResult) {
+			guid, _ := utils.GetUniqueIdentifier(ctx)
+			reportCtx, cancel := context.WithTimeout(utils.AppendUniqueIdentifier(context.Background(), guid), quorumConflictReportTimeout)
+			defer cancel()
+			rpccs.reportQuorumConflict(reportCtx, chainMessage, relayResult, disagreeingResult)
+		}(disagreeingResult)
+	}
+	if relayResult.Reply != nil {
+		rpccs.consumerConsistency.SetSeenBlock(relayResult.Reply.LatestBlock, dappID, consumerIp)
+	}
+	return relayResult, retries, nil
+}
+
+// acquires locked sessions on up to needed providers that are not unwanted, chosen providers are added to unwantedProviders
+func (rpccs *RPCConsumerServer) getQuorumSessions(ctx context.Context, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, unwantedProviders map[string]struct{}, needed int) (lavasession.ConsumerSessionsMap, error) {
+	reqBlock, _ := chainMessage.RequestedBlock()
+	if reqBlock == spectypes.LATEST_BLOCK && relayRequestData.SeenBlock != 0 {
+		reqBlock = relayRequestData.SeenBlock
+	}
+	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
+	sessions := lavasession.ConsumerSessionsMap{}
+	for len(sessions) < needed {
+		newSessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), unwantedProviders, reqBlock, chainlib.GetAddon(chainMessage), chainMessage.GetExtensions(), chainlib.GetStateful(chainMessage), virtualEpoch)
+		if err != nil {
+			return sessions, err
+		}
+		added := false
+		for providerAddress, sessionInfo := range newSessions {
+			unwantedProviders[providerAddress] = struct{}{}
+			if _, ok := sessions[providerAddress]; ok || len(sessions) >= needed {
+				if errUnused := rpccs.consumerSessionManager.OnSessionUnUsed(sessionInfo.Session); errUnused != nil {
+					utils.LavaFormatError("failed releasing unused quorum session", errUnused, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+				}
+				continue
+			}
+			sessions[providerAddress] = sessionInfo
+			added = true
+		}
+		if !added {
+			break
+		}
+	}
+	return sessions, nil
+}
+
+// groups the results by their normalized reply, the largest group is the majority
+func quorumMajority(relayResults []*common.RelayResult) (majority, minority []*common.RelayResult) {
+	groups := [][]*common.RelayResult{}
+	groupHashes := [][]byte{}
+	for _, relayResult := range relayResults {
+		hash := quorumReplyHash(relayResult.GetReply().GetData())
+		found := false
+		for idx, groupHash := range groupHashes {
+			if bytes.Equal(groupHash, hash) {
+				groups[idx] = append(groups[idx], relayResult)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groupHashes = append(groupHashes, hash)
+			groups = append(groups, []*common.RelayResult{relayResult})
+		}
+	}
+	majorityIdx := -1
+	for idx, group := range groups {
+		if majorityIdx == -1 || len(group) > len(groups[majorityIdx]) {
+			majorityIdx = idx
+		}
+	}
+	for idx, group := range groups {
+		if idx == majorityIdx {
+			majority = group
+		} else {
+			minority = append(minority, group...)
+		}
+	}
+	return majority, minority
+}
+
+// hashes the reply data after removing fields that differ between identical answers (the json-rpc id), and ordering json keys
+func quorumReplyHash(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // keep big numbers intact
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err == nil {
+		switch parsedReply := parsed.(type) {
+		case map[string]interface{}:
+			delete(parsedReply, "id")
+		case []interface{}:
+			// batch reply
+			for _, entry := range parsedReply {
+				if entryMap, ok := entry.(map[string]interface{}); ok {
+					delete(entryMap, "id")
+				}
+			}
+		}
+		if normalized, err := json.Marshal(parsed); err == nil {
+			hash := sha256.Sum256(normalized)
+			return hash[:]
+		}
+	}
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+func (rpccs *RPCConsumerServer) reportQuorumConflict(ctx context.Context, chainMessage chainlib.ChainMessage, majorityResult, disagreeingResult *common.RelayResult) {
+	utils.LavaFormatWarning("provider disagreed with quorum majority", nil, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", disagreeingResult.GetProvider()), utils.LogAttr("majorityProvider", majorityResult.GetProvider()))
+	if !chainMessage.GetApi().Category.Deterministic || !majorityResult.Finalized || !disagreeingResult.Finalized {
+		// replies on non finalized or non deterministic data can differ between honest providers
+		return
+	}
+	if len(majorityResult.Request.RelayData.Extensions) > 0 {
+		// TODO: remove this check when we fix the missing extensions information on conflict detection transaction
+		return
+	}
+	conflict := lavaprotocol.VerifyReliabilityResults(ctx, majorityResult, disagreeingResult, chainMessage.GetApiCollection(), rpccs.chainParser)
+	if conflict == nil {
+		return
+	}
+	err := rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil, disagreeingResult.ConflictHandler)
+	if err != nil {
+		utils.LavaFormatError("could not send quorum detection Transaction", err, utils.LogAttr("GUID", ctx), utils.LogAttr("conflict", conflict))
+	}
+}
@@ -0,0 +1,100 @@
+package rpcconsumer
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+type quorumChainMessage struct {
+	chainlib.ChainMessage
+	api            *spectypes.Api
+	requestedBlock int64
+}
+
+func (qcm *quorumChainMessage) GetApi() *spectypes.Api {
+	return qcm.api
+}
+
+func (qcm *quorumChainMessage) RequestedBlock() (latest int64, earliest int64) {
+	return qcm.requestedBlock, qcm.requestedBlock
+}
+
+func quorumRelayResult(provider string, data string) *common.RelayResult {
+	return &common.RelayResult{
+		Reply:        &pairingtypes.RelayReply{Data: []byte(data)},
+		ProviderInfo: common.ProviderInfo{ProviderAddress: provider},
+	}
+}
+
+func TestQuorumMajority(t *testing.T) {
+	relayResults := []*common.RelayResult{
+		quorumRelayResult("provider1", `{"jsonrpc":"2.0","id":1,"result":"0x10"}`),
+		quorumRelayResult("provider2", `{"jsonrpc":"2.0","id":2,"result":"0x11"}`),
+		// same reply with a different id and keys order
+		quorumRelayResult("provider3", `{"result":"0x10","id":3,"jsonrpc":"2.0"}`),
+	}
+	majority, minority := quorumMajority(relayResults)
+	require.Len(t, majority, 2)
+	require.Equal(t, "provider1", majority[0].GetProvider())
+	require.Equal(t, "provider3", majority[1].GetProvider())
+	require.Len(t, minority, 1)
+	require.Equal(t, "provider2", minority[0].GetProvider())
+
+	majority, minority = quorumMajority(nil)
+	require.Empty(t, majority)
+	require.Empty(t, minority)
+}
+
+func TestQuorumReplyHash(t *testing.T) {
+	// batch replies ignore the ids of each entry
+	require.Equal(t, quorumReplyHash([]byte(`[{"id":1,"result":"0x1"},{"id":2,"result":"0x2"}]`)), quorumReplyHash([]byte(`[{"id":5,"result":"0x1"},{"id":6,"result":"0x2"}]`)))
+	require.NotEqual(t, quorumReplyHash([]byte(`[{"id":1,"result":"0x1"},{"id":2,"result":"0x2"}]`)), quorumReplyHash([]byte(`[{"id":1,"result":"0x2"},{"id":2,"result":"0x1"}]`)))
+	// big numbers are not rounded
+	require.NotEqual(t, quorumReplyHash([]byte(`{"result":123456789012345678901}`)), quorumReplyHash([]byte(`{"result":123456789012345678902}`)))
+	// non json replies are compared as is
+	require.Equal(t, quorumReplyHash([]byte("plain")), quorumReplyHash([]byte("plain")))
+	require.NotEqual(t, quorumReplyHash([]byte("plain")), quorumReplyHash([]byte("plain2")))
+}
+
+func TestGetQuorumSize(t *testing.T) {
+	rpccs := &RPCConsumerServer{
+		requiredResponses: 1,
+		maxQuorum:         5,
+		listenEndpoint:    &lavasession.RPCEndpoint{ApiQuorum: map[string]int{"eth_getbalance": 3}},
+	}
+	balance := &quorumChainMessage{api: &spectypes.Api{Name: "eth_getBalance"}}
+	blockNumber := &quorumChainMessage{api: &spectypes.Api{Name: "eth_blockNumber"}}
+	require.Equal(t, 1, rpccs.getQuorumSize(blockNumber, map[string]string{}))
+	require.Equal(t, 3, rpccs.getQuorumSize(balance, map[string]string{}))
+	require.Equal(t, 5, rpccs.getQuorumSize(blockNumber, map[string]string{common.QUORUM_HEADER_NAME: "5"}))
+	// the header can't go over the max quorum
+	require.Equal(t, 5, rpccs.getQuorumSize(blockNumber, map[string]string{common.QUORUM_HEADER_NAME: "1000"}))
+	require.Equal(t, 3, rpccs.getQuorumSize(balance, map[string]string{common.QUORUM_HEADER_NAME: "bad"}))
+	require.Equal(t, 1, rpccs.getQuorumSize(balance, map[string]string{common.QUORUM_HEADER_NAME: "0"}))
+}
+
+func TestGetQuorumSizeAccuracyStrategy(t *testing.T) {
+	rpccs := &RPCConsumerServer{
+		requiredResponses: 1,
+		listenEndpoint:    &lavasession.RPCEndpoint{},
+		strategy:          provideroptimizer.STRATEGY_ACCURACY,
+	}
+	deterministic := spectypes.SpecCategory{Deterministic: true}
+	balance := &quorumChainMessage{api: &spectypes.Api{Name: "eth_getBalance", Category: deterministic}, requestedBlock: 100}
+	latestBalance := &quorumChainMessage{api: &spectypes.Api{Name: "eth_getBalance", Category: deterministic}, requestedBlock: spectypes.LATEST_BLOCK}
+	gasPrice := &quorumChainMessage{api: &spectypes.Api{Name: "eth_gasPrice"}, requestedBlock: 100}
+	require.Equal(t, AccuracyQuorumSize, rpccs.getQuorumSize(balance, map[string]string{}))
+	// latest replies can't be compared and are verified with data reliability
+	require.Equal(t, 1, rpccs.getQuorumSize(latestBalance, map[string]string{}))
+	// non deterministic replies can't be compared
+	require.Equal(t, 1, rpccs.getQuorumSize(gasPrice, map[string]string{}))
+	// the directive header still overrides the strategy
+	require.Equal(t, 1, rpccs.getQuorumSize(balance, map[string]string{common.QUORUM_HEADER_NAME: "1"}))
+}
@@ -0,0 +1,91 @@
+package rpcconsumer
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+// the token providers use to keep a consistent view for a consumer, with the privacy strategy it is salted
+// and changes every epoch so a dapp can't be followed across epochs and providers
+func (rpccs *RPCConsumerServer) getConsumerToken(dappID string, consumerIp string, epoch uint64) string {
+	if rpccs.strategy == provideroptimizer.STRATEGY_PRIVACY {
+		return common.GetUniqueToken(dappID+rpccs.privacySalt+strconv.FormatUint(epoch, 10), consumerIp)
+	}
+	return common.GetUniqueToken(dappID, consumerIp)
+}
+
+// with the cost strategy, returns the relay parsed without the extensions that were added automatically and multiply its compute units (e.g archive).
+// returns nil if there is no cheaper route for the relay
+func (rpccs *RPCConsumerServer) getCheaperChainMessage(url string, req string, connectionType string, metadata []pairingtypes.Metadata, directiveHeaders map[string]string, chainMessage chainlib.ChainMessage) chainlib.ChainMessage {
+	if rpccs.strategy != provideroptimizer.STRATEGY_COST || chainlib.IsSubscription(chainMessage) {
+		return nil
+	}
+	if _, ok := directiveHeaders[common.EXTENSION_OVERRIDE_HEADER_NAME]; ok {
+		// extensions requested explicitly are always used
+		return nil
+	}
+	multiplied := false
+	for _, extension := range chainMessage.GetExtensions() {
+		if extension.GetCuMultiplier() > 1 {
+			multiplied = true
+			break
+		}
+	}
+	if !multiplied {
+		return nil
+	}
+	cheaperChainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType, metadata, extensionslib.ExtensionInfo{LatestBlock: rpccs.getLatestBlock(), ExtensionOverride: []string{}})
+	if err != nil {
+		return nil
+	}
+	rpccs.HandleDirectiveHeadersForMessage(cheaperChainMessage, directiveHeaders)
+	return cheaperChainMessage
+}
+
+// node errors that mean the node doesn't have the requested data, e.g a pruned node asked for an old block
+var missingDataNodeErrors = []string{
+	"missing trie node",
+	"header not found",
+	"pruned",
+	"is not available, lowest height is",
+	"historical state",
+	"state is not available",
+	"missing in long-term storage",
+}
+
+// node errors are relayed as successful replies, a relay without extensions is retried with them only if the node
+// didn't have the data the extensions provide, any other node error would be returned by the extended node as well
+func replyMissingExtensionData(relayResult *common.RelayResult) bool {
+	if !replyHasNodeError(relayResult) {
+		return false
+	}
+	data := strings.ToLower(string(relayResult.GetReply().GetData()))
+	for _, nodeError := range missingDataNodeErrors {
+		if strings.Contains(data, nodeError) {
+			return true
+		}
+	}
+	return false
+}
+
+// node errors are relayed as successful replies, a relay without extensions that returned one is retried with them
+func replyHasNodeError(relayResult *common.RelayResult) bool {
+	if relayResult.GetStatusCode() >= http.StatusBadRequest {
+		return true
+	}
+	reply := struct {
+		Error json.RawMessage `json:"error"`
+	}{}
+	if err := json.Unmarshal(relayResult.GetReply().GetData(), &reply); err != nil {
+		return false
+	}
+	return len(reply.Error) > 0 && string(reply.Error) != "null"
+}
@@ -0,0 +1,48 @@
+package rpcconsumer
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplyHasNodeError(t *testing.T) {
+	reply := func(data string, statusCode int) *common.RelayResult {
+		return &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte(data)}, StatusCode: statusCode}
+	}
+	require.False(t, replyHasNodeError(reply(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`, 0)))
+	require.False(t, replyHasNodeError(reply(`{"jsonrpc":"2.0","id":1,"result":"0x1","error":null}`, 0)))
+	require.True(t, replyHasNodeError(reply(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"missing trie node"}}`, 0)))
+	require.True(t, replyHasNodeError(reply(`{"code":3,"message":"height is not available"}`, 400)))
+	require.False(t, replyHasNodeError(reply(`not json`, 200)))
+}
+
+func TestReplyMissingExtensionData(t *testing.T) {
+	reply := func(data string, statusCode int) *common.RelayResult {
+		return &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte(data)}, StatusCode: statusCode}
+	}
+	require.True(t, replyMissingExtensionData(reply(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"missing trie node 0x1 (path )"}}`, 0)))
+	require.True(t, replyMissingExtensionData(reply(`{"code":3,"message":"height 10 is not available, lowest height is 1000"}`, 400)))
+	// other node errors would fail on an extended node too
+	require.False(t, replyMissingExtensionData(reply(`{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid argument 0: hex string without 0x prefix"}}`, 0)))
+	require.False(t, replyMissingExtensionData(reply(`{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}}`, 0)))
+	// a successful reply mentioning the words is not an error
+	require.False(t, replyMissingExtensionData(reply(`{"jsonrpc":"2.0","id":1,"result":"header not found"}`, 0)))
+}
+
+func TestGetConsumerToken(t *testing.T) {
+	rpccs := &RPCConsumerServer{}
+	require.Equal(t, common.GetUniqueToken("dapp", "1.1.1.1"), rpccs.getConsumerToken("dapp", "1.1.1.1", 10))
+	require.Equal(t, rpccs.getConsumerToken("dapp", "1.1.1.1", 10), rpccs.getConsumerToken("dapp", "1.1.1.1", 20))
+
+	privacy := &RPCConsumerServer{strategy: provideroptimizer.STRATEGY_PRIVACY, privacySalt: "salt"}
+	token := privacy.getConsumerToken("dapp", "1.1.1.1", 10)
+	require.NotEqual(t, common.GetUniqueToken("dapp", "1.1.1.1"), token)
+	// stable in an epoch so providers keep a consistent view
+	require.Equal(t, token, privacy.getConsumerToken("dapp", "1.1.1.1", 10))
+	require.NotEqual(t, token, privacy.getConsumerToken("dapp", "1.1.1.1", 11))
+	require.NotEqual(t, token, privacy.getConsumerToken("dapp2", "1.1.1.1", 10))
+}
@@ -0,0 +1,306 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"google.golang.org/grpc/metadata"
+)
+
+// opens a RelaySubscribe stream on a paired provider that is not in unwantedProviders and returns it along with the first reply (the subscription id reply)
+type subscriptionOpener func(ctx context.Context, unwantedProviders map[string]struct{}) (stream pairingtypes.Relayer_RelaySubscribeClient, cancel context.CancelFunc, providerAddress string, firstReply *pairingtypes.RelayReply, err error)
+
+// ConsumerSubscription implements pairingtypes.Relayer_RelaySubscribeClient for the chain listeners.
+// it wraps a provider RelaySubscribe stream, and when that stream breaks it re-subscribes on another paired provider.
+// notifications of the new stream carry the id the client got on the first reply, so the switch is transparent to the client
+type ConsumerSubscription struct {
+	ctx             context.Context
+	recvLock        sync.Mutex // serializes Recv, which blocks on the stream and on re-subscribing
+	lock            sync.Mutex // guards the stream swap, never held while blocking
+	stream          pairingtypes.Relayer_RelaySubscribeClient
+	streamCancel    context.CancelFunc
+	providerAddress string
+	firstReply      *pairingtypes.RelayReply // returned on the first Recv, holds the subscription id
+	originalID      string
+	currentID       string
+	openStream      subscriptionOpener
+}
+
+func NewConsumerSubscription(ctx context.Context, unwantedProviders map[string]struct{}, openStream subscriptionOpener) (*ConsumerSubscription, error) {
+	if unwantedProviders == nil {
+		unwantedProviders = map[string]struct{}{}
+	}
+	cs := &ConsumerSubscription{
+		ctx:        ctx,
+		openStream: openStream,
+	}
+	var lastErr error
+	for retries := 0; retries < MaxRelayRetries; retries++ {
+		stream, cancel, providerAddress, firstReply, err := openStream(ctx, unwantedProviders)
+		if err != nil {
+			lastErr = err
+			if providerAddress == "" || lavasession.PairingListEmptyError.Is(err) {
+				break
+			}
+			unwantedProviders[providerAddress] = struct{}{}
+			continue
+		}
+		cs.setStream(stream, cancel, providerAddress)
+		cs.firstReply = firstReply
+		cs.originalID = extractSubscriptionID(firstReply.GetData())
+		cs.currentID = cs.originalID
+		return cs, nil
+	}
+	return nil, lastErr
+}
+
+func (cs *ConsumerSubscription) setStream(stream pairingtypes.Relayer_RelaySubscribeClient, cancel context.CancelFunc, providerAddress string) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	if cs.streamCancel != nil {
+		cs.streamCancel()
+	}
+	cs.stream = stream
+	cs.streamCancel = cancel
+	cs.providerAddress = providerAddress
+}
+
+// the provider currently serving the subscription
+func (cs *ConsumerSubscription) ProviderAddress() string {
+	_, providerAddress := cs.currentStream()
+	return providerAddress
+}
+
+func (cs *ConsumerSubscription) currentStream() (pairingtypes.Relayer_RelaySubscribeClient, string) {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.stream, cs.providerAddress
+}
+
+func (cs *ConsumerSubscription) Recv() (*pairingtypes.RelayReply, error) {
+	cs.recvLock.Lock()
+	defer cs.recvLock.Unlock()
+	if cs.firstReply != nil {
+		reply := cs.firstReply
+		cs.firstReply = nil
+		return reply, nil
+	}
+	// only the providers that broke during this Recv are skipped, so the set doesn't grow over the life of the subscription
+	unwantedProviders := map[string]struct{}{}
+	for resubscribes := 0; ; resubscribes++ {
+		stream, providerAddress := cs.currentStream()
+		reply, err := stream.Recv()
+		if err == nil {
+			if cs.currentID != cs.originalID {
+				reply.Data = replaceSubscriptionID(reply.Data, cs.currentID, cs.originalID)
+			}
+			return reply, nil
+		}
+		if cs.ctx.Err() != nil {
+			// the client closed the subscription, nothing to recover
+			return nil, err
+		}
+		if resubscribes >= MaxRelayRetries {
+			return nil, utils.LavaFormatError("subscription stream broke too many times", err, utils.LogAttr("GUID", cs.ctx), utils.LogAttr("resubscribes", resubscribes))
+		}
+		utils.LavaFormatWarning("subscription stream broke, re-subscribing on another provider", err, utils.LogAttr("GUID", cs.ctx), utils.LogAttr("provider", providerAddress))
+		unwantedProviders[providerAddress] = struct{}{}
+		if resubscribeErr := cs.resubscribe(unwantedProviders); resubscribeErr != nil {
+			return nil, utils.LavaFormatError("failed re-subscribing after stream broke", resubscribeErr, utils.LogAttr("GUID", cs.ctx), utils.LogAttr("original error", err.Error()))
+		}
+	}
+}
+
+// must be called while holding the recv lock
+func (cs *ConsumerSubscription) resubscribe(unwantedProviders map[string]struct{}) error {
+	var lastErr error
+	for retries := 0; retries < MaxRelayRetries; retries++ {
+		stream, cancel, providerAddress, firstReply, err := cs.openStream(cs.ctx, unwantedProviders)
+		if err != nil {
+			lastErr = err
+			if providerAddress == "" || lavasession.PairingListEmptyError.Is(err) {
+				return err
+			}
+			unwantedProviders[providerAddress] = struct{}{}
+			continue
+		}
+		cs.setStream(stream, cancel, providerAddress)
+		cs.currentID = extractSubscriptionID(firstReply.GetData())
+		utils.LavaFormatDebug("re-subscribed on a new provider", utils.LogAttr("GUID", cs.ctx), utils.LogAttr("provider", providerAddress), utils.LogAttr("subscriptionID", cs.currentID))
+		return nil
+	}
+	return lastErr
+}
+
+func (cs *ConsumerSubscription) RecvMsg(m interface{}) error {
+	reply, err := cs.Recv()
+	if err != nil {
+		return err
+	}
+	relayReply, ok := m.(*pairingtypes.RelayReply)
+	if !ok {
+		return utils.LavaFormatError("invalid message type for subscription RecvMsg", nil, utils.LogAttr("type", m))
+	}
+	*relayReply = *reply
+	return nil
+}
+
+func (cs *ConsumerSubscription) Header() (metadata.MD, error) {
+	stream, _ := cs.currentStream()
+	return stream.Header()
+}
+
+func (cs *ConsumerSubscription) Trailer() metadata.MD {
+	stream, _ := cs.currentStream()
+	return stream.Trailer()
+}
+
+func (cs *ConsumerSubscription) CloseSend() error {
+	stream, _ := cs.currentStream()
+	return stream.CloseSend()
+}
+
+func (cs *ConsumerSubscription) Context() context.Context {
+	return cs.ctx
+}
+
+func (cs *ConsumerSubscription) SendMsg(m interface{}) error {
+	stream, _ := cs.currentStream()
+	return stream.SendMsg(m)
+}
+
+func (rpccs *RPCConsumerServer) sendSubscriptionRelay(ctx context.Context, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, unwantedProviders map[string]struct{}) (*common.RelayResult, error) {
+	openStream := func(ctx context.Context, unwantedProviders map[string]struct{}) (pairingtypes.Relayer_RelaySubscribeClient, context.CancelFunc, string, *pairingtypes.RelayReply, error) {
+		return rpccs.subscribeToProvider(ctx, chainMessage, relayRequestData, unwantedProviders)
+	}
+	subscription, err := NewConsumerSubscription(ctx, unwantedProviders, openStream)
+	if err != nil {
+		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, err
+	}
+	var replyServer pairingtypes.Relayer_RelaySubscribeClient = subscription
+	return &common.RelayResult{
+		ProviderInfo: common.ProviderInfo{ProviderAddress: subscription.ProviderAddress()},
+		ReplyServer:  &replyServer,
+		Reply:        &pairingtypes.RelayReply{},
+	}, nil
+}
+
+// opens a RelaySubscribe stream on a single provider and waits for the reply holding the subscription id
+func (rpccs *RPCConsumerServer) subscribeToProvider(ctx context.Context, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, unwantedProviders map[string]struct{}) (stream pairingtypes.Relayer_RelaySubscribeClient, cancel context.CancelFunc, providerAddress string, firstReply *pairingtypes.RelayReply, err error) {
+	reqBlock, _ := chainMessage.RequestedBlock()
+	if reqBlock == spectypes.LATEST_BLOCK && relayRequestData.SeenBlock != 0 {
+		reqBlock = relayRequestData.SeenBlock
+	}
+	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
+	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), unwantedProviders, reqBlock, chainlib.GetAddon(chainMessage), chainMessage.GetExtensions(), chainlib.GetStateful(chainMessage), virtualEpoch)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+	// a subscription is served by a single provider, release the rest
+	var sessionInfo *lavasession.SessionInfo
+	for address, info := range sessions {
+		if sessionInfo == nil {
+			providerAddress = address
+			sessionInfo = info
+			continue
+		}
+		if errUnused := rpccs.consumerSessionManager.OnSessionUnUsed(info.Session); errUnused != nil {
+			utils.LavaFormatError("failed releasing unused subscription session", errUnused, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", address))
+		}
+	}
+
+	localRelayRequestData := *relayRequestData
+	relayRequest, err := lavaprotocol.ConstructRelayRequest(ctx, rpccs.privKey, rpccs.lavaChainID, rpccs.listenEndpoint.ChainID, &localRelayRequestData, providerAddress, sessionInfo.Session, int64(sessionInfo.Epoch), sessionInfo.ReportedProviders)
+	if err != nil {
+		if errUnused := rpccs.consumerSessionManager.OnSessionUnUsed(sessionInfo.Session); errUnused != nil {
+			utils.LavaFormatError("failed releasing unused subscription session", errUnused, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+		}
+		return nil, nil, providerAddress, nil, err
+	}
+	relayResult := &common.RelayResult{
+		Request:      relayRequest,
+		ProviderInfo: common.ProviderInfo{ProviderAddress: providerAddress, ProviderStake: sessionInfo.StakeSize, ProviderQoSExcellenceSummery: sessionInfo.QoSSummeryResult},
+	}
+	// the stream lives as long as the client keeps the subscription open, or until we replace it with another provider
+	streamCtx, streamCancel := context.WithCancel(ctx)
+	relayResult, err = rpccs.relaySubscriptionInner(streamCtx, *sessionInfo.Session.Endpoint.Client, sessionInfo.Session, relayResult)
+	if err != nil {
+		streamCancel()
+		return nil, nil, providerAddress, nil, err
+	}
+	stream = *relayResult.ReplyServer
+
+	type firstReplyResponse struct {
+		reply *pairingtypes.RelayReply
+		err   error
+	}
+	firstReplyChan := make(chan firstReplyResponse, 1)
+	go func() {
+		reply, err := stream.Recv()
+		firstReplyChan <- firstReplyResponse{reply: reply, err: err}
+	}()
+	relayTimeout := chainlib.GetRelayTimeout(chainMessage, rpccs.chainParser, 0)
+	select {
+	case response := <-firstReplyChan:
+		if response.err != nil {
+			streamCancel()
+			return nil, nil, providerAddress, nil, utils.LavaFormatWarning("failed receiving subscription id from provider", response.err, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+		}
+		return stream, streamCancel, providerAddress, response.reply, nil
+	case <-time.After(relayTimeout):
+		streamCancel()
+		return nil, nil, providerAddress, nil, utils.LavaFormatWarning("timeout waiting for subscription id from provider", context.DeadlineExceeded, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", providerAddress))
+	}
+}
+
+// returns the subscription id from a subscribe reply, empty if the reply doesn't hold one (e.g. tendermint replies with an empty result)
+func extractSubscriptionID(data []byte) string {
+	reply := struct {
+		Result json.RawMessage `json:"result"`
+	}{}
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return ""
+	}
+	var subscriptionID string
+	if err := json.Unmarshal(reply.Result, &subscriptionID); err != nil {
+		return ""
+	}
+	return subscriptionID
+}
+
+// rewrites params.subscription of a subscription notification from one id to another
+func replaceSubscriptionID(data []byte, from, to string) []byte {
+	msg := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return data
+	}
+	params := map[string]json.RawMessage{}
+	if err := json.Unmarshal(msg["params"], &params); err != nil {
+		return data
+	}
+	var subscriptionID string
+	if err := json.Unmarshal(params["subscription"], &subscriptionID); err != nil || subscriptionID != from {
+		return data
+	}
+	var err error
+	if params["subscription"], err = json.Marshal(to); err != nil {
+		return data
+	}
+	if msg["params"], err = json.Marshal(params); err != nil {
+		return data
+	}
+	replaced, err := json.Marshal(msg)
+	if err != nil {
+		return data
+	}
+	return replaced
+}
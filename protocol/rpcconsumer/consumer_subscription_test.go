@@ -0,0 +1,143 @@
+package rpcconsumer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type mockSubscribeStream struct {
+	grpc.ClientStream
+	replies []*pairingtypes.RelayReply
+}
+
+func (mss *mockSubscribeStream) Recv() (*pairingtypes.RelayReply, error) {
+	if len(mss.replies) == 0 {
+		return nil, io.EOF
+	}
+	reply := mss.replies[0]
+	mss.replies = mss.replies[1:]
+	return reply, nil
+}
+
+func notification(subscriptionID string, block string) *pairingtypes.RelayReply {
+	return &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"` + subscriptionID + `","result":{"number":"` + block + `"}}}`)}
+}
+
+func TestConsumerSubscriptionResubscribes(t *testing.T) {
+	streams := map[string]*mockSubscribeStream{
+		"provider1": {replies: []*pairingtypes.RelayReply{notification("0x1", "0x10")}},
+		"provider2": {replies: []*pairingtypes.RelayReply{notification("0x2", "0x11"), notification("0x2", "0x12")}},
+	}
+	order := []string{"provider1", "provider2"}
+	openStream := func(ctx context.Context, unwantedProviders map[string]struct{}) (pairingtypes.Relayer_RelaySubscribeClient, context.CancelFunc, string, *pairingtypes.RelayReply, error) {
+		for _, provider := range order {
+			if _, ok := unwantedProviders[provider]; ok {
+				continue
+			}
+			id := "0x1"
+			if provider == "provider2" {
+				id = "0x2"
+			}
+			return streams[provider], func() {}, provider, &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":"` + id + `"}`)}, nil
+		}
+		return nil, nil, "", nil, errors.New("no providers")
+	}
+
+	subscription, err := NewConsumerSubscription(context.Background(), nil, openStream)
+	require.NoError(t, err)
+	require.Equal(t, "provider1", subscription.ProviderAddress())
+
+	var reply pairingtypes.RelayReply
+	require.NoError(t, subscription.RecvMsg(&reply))
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, string(reply.Data))
+	require.NoError(t, subscription.RecvMsg(&reply))
+	require.JSONEq(t, string(notification("0x1", "0x10").Data), string(reply.Data))
+
+	// provider1 stream ends, the subscription moves to provider2 and keeps the original id
+	require.NoError(t, subscription.RecvMsg(&reply))
+	require.Equal(t, "provider2", subscription.ProviderAddress())
+	require.JSONEq(t, string(notification("0x1", "0x11").Data), string(reply.Data))
+	require.NoError(t, subscription.RecvMsg(&reply))
+	require.JSONEq(t, string(notification("0x1", "0x12").Data), string(reply.Data))
+
+	// no more providers to move to
+	require.Error(t, subscription.RecvMsg(&reply))
+}
+
+func TestConsumerSubscriptionStopsOnClientCancel(t *testing.T) {
+	opened := 0
+	openStream := func(ctx context.Context, unwantedProviders map[string]struct{}) (pairingtypes.Relayer_RelaySubscribeClient, context.CancelFunc, string, *pairingtypes.RelayReply, error) {
+		opened++
+		return &mockSubscribeStream{}, func() {}, "provider1", &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)}, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	subscription, err := NewConsumerSubscription(ctx, nil, openStream)
+	require.NoError(t, err)
+	_, err = subscription.Recv()
+	require.NoError(t, err)
+	cancel()
+	_, err = subscription.Recv()
+	require.Error(t, err)
+	require.Equal(t, 1, opened)
+}
+
+type blockingSubscribeStream struct {
+	grpc.ClientStream
+	closed chan struct{}
+}
+
+func (bss *blockingSubscribeStream) Recv() (*pairingtypes.RelayReply, error) {
+	<-bss.closed
+	return nil, io.EOF
+}
+
+func (bss *blockingSubscribeStream) CloseSend() error {
+	close(bss.closed)
+	return nil
+}
+
+func TestConsumerSubscriptionCloseSendWhileReceiving(t *testing.T) {
+	stream := &blockingSubscribeStream{closed: make(chan struct{})}
+	openStream := func(ctx context.Context, unwantedProviders map[string]struct{}) (pairingtypes.Relayer_RelaySubscribeClient, context.CancelFunc, string, *pairingtypes.RelayReply, error) {
+		if _, ok := unwantedProviders["provider1"]; ok {
+			return nil, nil, "", nil, errors.New("no providers")
+		}
+		return stream, func() {}, "provider1", &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)}, nil
+	}
+	subscription, err := NewConsumerSubscription(context.Background(), nil, openStream)
+	require.NoError(t, err)
+	_, err = subscription.Recv()
+	require.NoError(t, err)
+
+	recvErr := make(chan error, 1)
+	go func() {
+		_, err := subscription.Recv()
+		recvErr <- err
+	}()
+	// the blocked Recv must not hold the stream lock
+	time.Sleep(10 * time.Millisecond)
+	closed := make(chan error, 1)
+	go func() { closed <- subscription.CloseSend() }()
+	select {
+	case err := <-closed:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("CloseSend blocked on a pending Recv")
+	}
+	require.Error(t, <-recvErr)
+}
+
+func TestReplaceSubscriptionID(t *testing.T) {
+	require.JSONEq(t, string(notification("0xa", "0x1").Data), string(replaceSubscriptionID(notification("0xb", "0x1").Data, "0xb", "0xa")))
+	// other subscription ids are not touched
+	require.Equal(t, notification("0xc", "0x1").Data, replaceSubscriptionID(notification("0xc", "0x1").Data, "0xb", "0xa"))
+	require.Equal(t, "0xa", extractSubscriptionID([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xa"}`)))
+	require.Equal(t, "", extractSubscriptionID([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`)))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,6 +48,10 @@ var (
 	DebugRelaysFlag                = false
 	RelaysHealthEnableFlagDefault  = true
 	RelayHealthIntervalFlagDefault = 5 * time.Minute
+	HedgeMaxCUPercentDefault       = uint64(0)
+	HedgeLatencyPercentileDefault  = 0.9
+	OptimizerStateIntervalDefault  = provideroptimizer.STATE_SAVE_INTERVAL
+	MaxQuorumDefault               = 5
 )
 
 type strategyValue struct {
@@ -96,8 +101,9 @@ type ConsumerStateTrackerInf interface {
 }
 
 type AnalyticsServerAddressess struct {
-	MetricsListenAddress string
-	RelayServerAddress   string
+	MetricsListenAddress     string
+	RelayServerAddress       string
+	DebugServerListenAddress string
 }
 type RPCConsumer struct {
 	consumerStateTracker ConsumerStateTrackerInf
@@ -112,6 +118,9 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 	consumerMetricsManager := metrics.NewConsumerMetricsManager(analyticsServerAddressess.MetricsListenAddress)     // start up prometheus metrics
 	consumerUsageserveManager := metrics.NewConsumerRelayServerClient(analyticsServerAddressess.RelayServerAddress) // start up relay server reporting
 
+	debugServer := NewConsumerDebugServer(strategy)
+	debugServer.Start(ctx, analyticsServerAddressess.DebugServerListenAddress)
+
 	rpcConsumerMetrics, err := metrics.NewRPCConsumerLogs(consumerMetricsManager, consumerUsageserveManager)
 	if err != nil {
 		utils.LavaFormatFatal("failed creating RPCConsumer logs", err)
@@ -213,6 +222,9 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 					// doesn't exist for this chain create a new one
 					baseLatency := common.AverageWorldLatency / 2 // we want performance to be half our timeout or better
 					optimizer = provideroptimizer.NewProviderOptimizer(strategy, averageBlockTime, baseLatency, maxConcurrentProviders)
+					if cmdFlags.OptimizerStateDir != "" {
+						optimizer.StartStatePersistence(ctx, optimizerStateFilePath(cmdFlags.OptimizerStateDir, chainID), cmdFlags.OptimizerStateInterval)
+					}
 					optimizers.Store(chainID, optimizer)
 				} else {
 					var ok bool
@@ -267,6 +279,7 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 			// Register For Updates
 			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer, consumerMetricsManager)
 			rpcc.consumerStateTracker.RegisterConsumerSessionManagerForPairingUpdates(ctx, consumerSessionManager)
+			debugServer.RegisterSessionManager(consumerSessionManager)
 
 			var relaysMonitor *metrics.RelaysMonitor
 			if cmdFlags.RelaysHealthEnableFlag {
@@ -310,9 +323,27 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, txFactory tx.Factory, client
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, os.Interrupt)
 	<-signalChan
+	if cmdFlags.OptimizerStateDir != "" {
+		// save the latest scores so the next run doesn't start from scratch
+		optimizers.Range(func(key, value any) bool {
+			chainID, okChain := key.(string)
+			optimizer, ok := value.(*provideroptimizer.ProviderOptimizer)
+			if !ok || !okChain {
+				return true
+			}
+			if err := optimizer.SaveState(optimizerStateFilePath(cmdFlags.OptimizerStateDir, chainID)); err != nil {
+				utils.LavaFormatWarning("failed saving provider optimizer state", err, utils.LogAttr("chainID", chainID))
+			}
+			return true
+		})
+	}
 	return nil
 }
 
+func optimizerStateFilePath(stateDir string, chainID string) string {
+	return filepath.Join(stateDir, chainID+"_optimizer.json")
+}
+
 func ParseEndpoints(viper_endpoints *viper.Viper, geolocation uint64) (endpoints []*lavasession.RPCEndpoint, err error) {
 	err = viper_endpoints.UnmarshalKey(common.EndpointsConfigName, &endpoints)
 	if err != nil {
@@ -485,12 +516,25 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			}
 
 			analyticsServerAddressess := AnalyticsServerAddressess{
-				MetricsListenAddress: viper.GetString(metrics.MetricsListenFlagName),
-				RelayServerAddress:   viper.GetString(metrics.RelayServerFlagName),
+				MetricsListenAddress:     viper.GetString(metrics.MetricsListenFlagName),
+				RelayServerAddress:       viper.GetString(metrics.RelayServerFlagName),
+				DebugServerListenAddress: viper.GetString(DebugServerListenFlagName),
 			}
 
 			maxConcurrentProviders := viper.GetUint(common.MaximumConcurrentProvidersFlagName)
 
+			accessCredentials, err := common.ParseAccessControl(viper.GetViper())
+			if err != nil {
+				return utils.LavaFormatError("invalid access control definition", err)
+			}
+			accessControl, err := common.NewAccessControl(accessCredentials)
+			if err != nil {
+				return utils.LavaFormatError("invalid access control definition", err)
+			}
+			if accessControl.Enabled() {
+				utils.LavaFormatInfo("access control enabled, listeners require credentials", utils.LogAttr("credentials", len(accessCredentials)))
+			}
+
 			consumerPropagatedFlags := common.ConsumerCmdFlags{
 				HeadersFlag:              viper.GetString(common.CorsHeadersFlag),
 				CredentialsFlag:          viper.GetString(common.CorsCredentialsFlag),
@@ -499,6 +543,12 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 				CDNCacheDuration:         viper.GetString(common.CDNCacheDurationFlag),
 				RelaysHealthEnableFlag:   viper.GetBool(common.RelaysHealthEnableFlag),
 				RelaysHealthIntervalFlag: viper.GetDuration(common.RelayHealthIntervalFlag),
+				HedgeMaxCUPercent:        viper.GetUint64(common.HedgeMaxCUPercentFlag),
+				HedgeLatencyPercentile:   viper.GetFloat64(common.HedgeLatencyPercentileFlag),
+				OptimizerStateDir:        viper.GetString(common.OptimizerStateDirFlag),
+				OptimizerStateInterval:   viper.GetDuration(common.OptimizerStateSaveIntervalFlag),
+				AccessControl:            accessControl,
+				MaxQuorum:                viper.GetInt(common.MaxQuorumFlag),
 			}
 
 			err = rpcConsumer.Start(ctx, txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, maxConcurrentProviders, analyticsServerAddressess, consumerPropagatedFlags)
@@ -517,10 +567,11 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().Bool(lavasession.AllowInsecureConnectionToProvidersFlag, false, "allow insecure provider-dialing. used for development and testing")
 	cmdRPCConsumer.Flags().Bool(common.TestModeFlagName, false, "test mode causes rpcconsumer to send dummy data and print all of the metadata in it's listeners")
 	cmdRPCConsumer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
-	cmdRPCConsumer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdRPCConsumer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance, a comma separated list shards the entries between the cache servers")
 	cmdRPCConsumer.Flags().Var(&strategyFlag, "strategy", fmt.Sprintf("the strategy to use to pick providers (%s)", strings.Join(strategyNames, "|")))
 	cmdRPCConsumer.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
 	cmdRPCConsumer.Flags().String(metrics.RelayServerFlagName, metrics.DisabledFlagOption, "the http address of the relay usage server api endpoint (example http://127.0.0.1:8080)")
+	cmdRPCConsumer.Flags().String(DebugServerListenFlagName, metrics.DisabledFlagOption, "the address to expose the pairing and provider scores debug endpoint on (such as localhost:7780)")
 	cmdRPCConsumer.Flags().BoolVar(&DebugRelaysFlag, DebugRelaysFlagName, false, "adding debug information to relays")
 	// CORS related flags
 	cmdRPCConsumer.Flags().String(common.CorsCredentialsFlag, "true", "Set up CORS allowed credentials,default \"true\"")
@@ -531,6 +582,11 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	// Relays health check related flags
 	cmdRPCConsumer.Flags().Bool(common.RelaysHealthEnableFlag, RelaysHealthEnableFlagDefault, "enables relays health check")
 	cmdRPCConsumer.Flags().Duration(common.RelayHealthIntervalFlag, RelayHealthIntervalFlagDefault, "interval between relay health checks")
+	cmdRPCConsumer.Flags().Uint64(common.HedgeMaxCUPercentFlag, HedgeMaxCUPercentDefault, "max percentage of the relayed compute units that can be spent on hedged relays, 0 disables hedging")
+	cmdRPCConsumer.Flags().Float64(common.HedgeLatencyPercentileFlag, HedgeLatencyPercentileDefault, "send a hedged relay to another provider when a relay is not answered within this latency percentile of recent relays")
+	cmdRPCConsumer.Flags().String(common.OptimizerStateDirFlag, "", "directory to store the provider optimizer scores in so they are reloaded on restarts, empty disables it")
+	cmdRPCConsumer.Flags().Duration(common.OptimizerStateSaveIntervalFlag, OptimizerStateIntervalDefault, "interval between provider optimizer state saves")
+	cmdRPCConsumer.Flags().Int(common.MaxQuorumFlag, MaxQuorumDefault, "max number of providers a single relay can be sent to with the quorum directive header")
 
 	cmdRPCConsumer.Flags().BoolVar(&lavasession.DebugProbes, DebugProbesFlagName, false, "adding information to probes")
 	common.AddRollingLogConfig(cmdRPCConsumer)
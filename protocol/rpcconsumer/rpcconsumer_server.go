@@ -3,6 +3,8 @@ package rpcconsumer
 import (
 	"context"
 	"errors"
+	"math"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +19,7 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/protocopy"
 	"github.com/lavanet/lava/utils/rand"
@@ -44,11 +47,18 @@ type RPCConsumerServer struct {
 	privKey                *btcec.PrivateKey
 	consumerTxSender       ConsumerTxSender
 	requiredResponses      int
+	maxQuorum              int // upper limit of the quorum requested by the directive header
 	finalizationConsensus  *lavaprotocol.FinalizationConsensus
 	lavaChainID            string
 	consumerAddress        sdk.AccAddress
 	consumerConsistency    *ConsumerConsistency
 	relaysMonitor          *metrics.RelaysMonitor
+	hedgeBudget            *hedgeBudget
+	hedgeLatencyPercentile float64
+	strategy               provideroptimizer.Strategy
+	privacySalt            string // mixed into the consumer token sent to providers when the strategy is privacy
+	accessControl          *common.AccessControl
+	methodFilter           *methodFilter // nil when the endpoint doesn't filter methods
 }
 
 type ConsumerTxSender interface {
@@ -84,6 +94,16 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	rpccs.finalizationConsensus = finalizationConsensus
 	rpccs.consumerAddress = consumerAddress
 	rpccs.consumerConsistency = consumerConsistency
+	rpccs.hedgeBudget = newHedgeBudget(cmdFlags.HedgeMaxCUPercent)
+	rpccs.hedgeLatencyPercentile = cmdFlags.HedgeLatencyPercentile
+	rpccs.strategy = consumerSessionManager.Strategy()
+	rpccs.privacySalt = strconv.FormatUint(rand.Uint64(), 16)
+	rpccs.accessControl = cmdFlags.AccessControl
+	rpccs.maxQuorum = cmdFlags.MaxQuorum
+	rpccs.methodFilter, err = newMethodFilter(listenEndpoint)
+	if err != nil {
+		return err
+	}
 
 	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, rpccs, rpcConsumerLogs, chainParser)
 	if err != nil {
@@ -252,6 +272,11 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	// compares the response with other consumer wallets if defined so
 	// asynchronously sends data reliability if necessary
 
+	if batches := rpccs.splitBatchByRouting(url, req, connectionType, metadata); batches != nil {
+		// every sub batch is relayed on its own so one archive or addon element doesn't change the routing of the rest
+		return rpccs.sendSplitBatch(ctx, batches, url, connectionType, dappID, consumerIp, analytics, metadata)
+	}
+
 	// remove lava directive headers
 	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
 	relaySentTime := time.Now()
@@ -259,13 +284,100 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	if err != nil {
 		return nil, err
 	}
-	// temporarily disable subscriptions
-	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Subscriptions are not supported at the moment", nil)
+
+	if rejection, err := rpccs.filterMethods(req, chainMessage); err != nil {
+		// rejected before any provider session is used, the reply carries the error in the format of the api interface
+		return rejection, err
+	}
+	api := chainMessage.GetApi()
+	if err := rpccs.checkAccess(dappID, strings.Split(api.Name, chainlib.SEP), api.ComputeUnits); err != nil {
+		return accessDeniedRelayResult(err), err
 	}
+	chargeTime := time.Now()
+	defer func() {
+		if errRet != nil {
+			// the dapp is charged only for relays that succeeded
+			rpccs.refundAccess(dappID, api.ComputeUnits, chargeTime)
+		}
+	}()
+	return rpccs.sendAllowedRelay(ctx, url, req, connectionType, dappID, consumerIp, analytics, metadata, directiveHeaders, relaySentTime, chainMessage)
+}
 
+// relays a message that passed the method filter and access control
+func (rpccs *RPCConsumerServer) sendAllowedRelay(
+	ctx context.Context,
+	url string,
+	req string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	metadata []pairingtypes.Metadata,
+	directiveHeaders map[string]string,
+	relaySentTime time.Time,
+	chainMessage chainlib.ChainMessage,
+) (*common.RelayResult, error) {
 	rpccs.HandleDirectiveHeadersForMessage(chainMessage, directiveHeaders)
+	if rpccs.strategy == provideroptimizer.STRATEGY_PRIVACY {
+		// pin the dapp to a single provider, the key is never sent to the provider
+		ctx = lavasession.WithProviderPinKey(ctx, common.GetUniqueToken(dappID, consumerIp))
+	}
+	if cheaperChainMessage := rpccs.getCheaperChainMessage(url, req, connectionType, metadata, directiveHeaders, chainMessage); cheaperChainMessage != nil {
+		// the cost strategy tries the relay without the extensions that multiply its compute units first,
+		// it reports on its own analytics so a failed attempt isn't counted on top of the retry
+		var cheaperAnalytics *metrics.RelayMetrics
+		if analytics != nil {
+			analyticsCopy := *analytics
+			cheaperAnalytics = &analyticsCopy
+		}
+		relayResult, err := rpccs.sendParsedRelay(ctx, url, req, connectionType, dappID, consumerIp, cheaperAnalytics, directiveHeaders, relaySentTime, cheaperChainMessage)
+		if err == nil && !replyMissingExtensionData(relayResult) {
+			if analytics != nil {
+				*analytics = *cheaperAnalytics
+			}
+			return relayResult, nil
+		}
+		utils.LavaFormatDebug("relay without extensions failed, retrying with extensions", utils.LogAttr("GUID", ctx), utils.LogAttr("extensions", common.GetExtensionNames(chainMessage.GetExtensions())))
+		relaySentTime = time.Now()
+	}
+	return rpccs.sendParsedRelay(ctx, url, req, connectionType, dappID, consumerIp, analytics, directiveHeaders, relaySentTime, chainMessage)
+}
+
+// enforces the allowed methods and compute units budget of authenticated dapps, a batch is checked as a whole
+func (rpccs *RPCConsumerServer) checkAccess(dappID string, methods []string, cu uint64) error {
+	if !rpccs.accessControl.Enabled() {
+		return nil
+	}
+	return rpccs.accessControl.AllowRelay(dappID, methods, cu)
+}
+
+func (rpccs *RPCConsumerServer) refundAccess(dappID string, cu uint64, chargeTime time.Time) {
+	if !rpccs.accessControl.Enabled() {
+		return
+	}
+	rpccs.accessControl.RefundRelay(dappID, cu, chargeTime)
+}
+
+func accessDeniedRelayResult(err error) *common.RelayResult {
+	statusCode := http.StatusForbidden
+	if common.CuBudgetExceededError.Is(err) {
+		statusCode = http.StatusTooManyRequests
+	}
+	return &common.RelayResult{StatusCode: statusCode}
+}
+
+func (rpccs *RPCConsumerServer) sendParsedRelay(
+	ctx context.Context,
+	url string,
+	req string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	directiveHeaders map[string]string,
+	relaySentTime time.Time,
+	chainMessage chainlib.ChainMessage,
+) (relayResult *common.RelayResult, errRet error) {
 	// do this in a loop with retry attempts, configurable via a flag, limited by the number of providers in CSM
 	reqBlock, _ := chainMessage.RequestedBlock()
 	seenBlock, _ := rpccs.consumerConsistency.GetSeenBlock(dappID, consumerIp)
@@ -273,6 +385,34 @@ func (rpccs *RPCConsumerServer) SendRelay(
 		seenBlock = 0
 	}
 	relayRequestData := lavaprotocol.NewRelayData(ctx, connectionType, url, []byte(req), seenBlock, reqBlock, rpccs.listenEndpoint.ApiInterface, chainMessage.GetRPCMessage().GetHeaders(), chainlib.GetAddon(chainMessage), common.GetExtensionNames(chainMessage.GetExtensions()))
+	if chainlib.IsSubscription(chainMessage) {
+		// subscriptions are held open on a single provider, the stream lives as long as ctx
+		subscriptionResult, err := rpccs.sendSubscriptionRelay(ctx, chainMessage, relayRequestData, rpccs.GetInitialUnwantedProviders(directiveHeaders))
+		if err != nil {
+			return subscriptionResult, err
+		}
+		if analytics != nil {
+			analytics.Latency = time.Since(relaySentTime).Milliseconds()
+			analytics.ComputeUnits = chainMessage.GetApi().ComputeUnits
+		}
+		rpccs.appendHeadersToRelayResult(ctx, subscriptionResult, 0)
+		rpccs.relaysMonitor.LogRelay()
+		return subscriptionResult, nil
+	}
+	if quorumSize := rpccs.getQuorumSize(chainMessage, directiveHeaders); quorumSize > 1 {
+		// quorum compares the providers' replies against each other, so data reliability is not needed
+		quorumResult, retries, err := rpccs.sendQuorumRelay(ctx, chainMessage, relayRequestData, dappID, consumerIp, rpccs.GetInitialUnwantedProviders(directiveHeaders), quorumSize)
+		rpccs.appendHeadersToRelayResult(ctx, quorumResult, retries)
+		if err != nil {
+			return quorumResult, err
+		}
+		if analytics != nil {
+			analytics.Latency = time.Since(relaySentTime).Milliseconds()
+			analytics.ComputeUnits = chainMessage.GetApi().ComputeUnits
+		}
+		rpccs.relaysMonitor.LogRelay()
+		return quorumResult, nil
+	}
 	relayResults := []*common.RelayResult{}
 	relayErrors := &RelayErrors{}
 	blockOnSyncLoss := map[string]struct{}{}
@@ -281,47 +421,107 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	retries := uint64(0)
 	timeouts := 0
 	unwantedProviders := rpccs.GetInitialUnwantedProviders(directiveHeaders)
-	for ; retries < MaxRelayRetries; retries++ {
-		// TODO: make this async between different providers
-		relayResult, err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, &unwantedProviders, timeouts)
-		if relayResult.ProviderInfo.ProviderAddress != "" {
+	// attempts are sent one at a time, when an attempt is not answered within the hedge threshold another attempt is sent in parallel
+	// and the first successful reply is returned. attempts still in flight are cancelled so they release their sessions
+	attempts := make(chan *relayAttempt, MaxRelayRetries)
+	cancelAttempts := []context.CancelFunc{}
+	defer func() {
+		for _, cancel := range cancelAttempts {
+			cancel()
+		}
+	}()
+	attemptsSent := uint64(0)
+	attemptsInFlight := 0
+	hedged := false
+	var hedgeTimer <-chan time.Time
+	for len(relayResults) == 0 {
+		if attemptsInFlight == 0 {
+			if attemptsSent >= MaxRelayRetries {
+				break
+			}
+			attemptsSent++
+			cancel, err := rpccs.sendRelayAttempt(ctx, chainMessage, relayRequestData, dappID, consumerIp, unwantedProviders, timeouts, false, attempts)
 			if err != nil {
-				// add this provider to the erroring providers
-				if errorRelayResult.ProviderInfo.ProviderAddress != "" {
-					errorRelayResult.ProviderInfo.ProviderAddress += ","
-				}
-				errorRelayResult.ProviderInfo.ProviderAddress += relayResult.ProviderInfo.ProviderAddress
-				_, ok := blockOnSyncLoss[relayResult.ProviderInfo.ProviderAddress]
-				if !ok && lavasession.IsSessionSyncLoss(err) {
-					// allow this provider to be wantedProvider on a retry, if it didn't fail once on syncLoss
-					blockOnSyncLoss[relayResult.ProviderInfo.ProviderAddress] = struct{}{}
-					utils.LavaFormatWarning("Identified SyncLoss in provider, not removing it from list for another attempt", err, utils.Attribute{Key: "address", Value: relayResult.ProviderInfo.ProviderAddress})
-				} else {
-					unwantedProviders[relayResult.ProviderInfo.ProviderAddress] = struct{}{}
-				}
-				if common.IsTimeout(err) {
-					timeouts++
+				relayErrors.relayErrors = append(relayErrors.relayErrors, RelayError{err: err})
+				if lavasession.PairingListEmptyError.Is(err) {
+					// if we ran out of pairings because unwantedProviders is too long or validProviders is too short, continue to reply handling code
+					break
 				}
+				utils.LavaFormatDebug("could not send relay to provider", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()}, utils.Attribute{Key: "endpoint", Value: rpccs.listenEndpoint})
+				continue
+			}
+			cancelAttempts = append(cancelAttempts, cancel)
+			attemptsInFlight++
+			if hedgeThreshold := rpccs.getHedgeThreshold(chainMessage, chainlib.GetRelayTimeout(chainMessage, rpccs.chainParser, timeouts)); hedgeThreshold > 0 && !hedged {
+				hedgeTimer = time.After(hedgeThreshold)
 			}
 		}
-		if err != nil {
-			if relayResult.GetStatusCode() != 0 {
-				// keep the error status code
-				errorRelayResult.StatusCode = relayResult.GetStatusCode()
+		select {
+		case <-hedgeTimer:
+			hedgeTimer = nil
+			if attemptsSent >= MaxRelayRetries {
+				continue
 			}
-			relayErrors.relayErrors = append(relayErrors.relayErrors, RelayError{err: err, ProviderInfo: relayResult.ProviderInfo})
-			if lavasession.PairingListEmptyError.Is(err) {
-				// if we ran out of pairings because unwantedProviders is too long or validProviders is too short, continue to reply handling code
-				break
+			// a single hedged attempt per relay
+			hedged = true
+			cancel, err := rpccs.sendRelayAttempt(ctx, chainMessage, relayRequestData, dappID, consumerIp, unwantedProviders, timeouts, true, attempts)
+			if err != nil {
+				utils.LavaFormatDebug("could not send hedged relay", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()})
+				continue
+			}
+			cancelAttempts = append(cancelAttempts, cancel)
+			attemptsSent++
+			attemptsInFlight++
+		case attempt := <-attempts:
+			attemptsInFlight--
+			relayResult, err := attempt.relayResult, attempt.err
+			if relayResult.ProviderInfo.ProviderAddress != "" {
+				if err != nil {
+					// add this provider to the erroring providers
+					if errorRelayResult.ProviderInfo.ProviderAddress != "" {
+						errorRelayResult.ProviderInfo.ProviderAddress += ","
+					}
+					errorRelayResult.ProviderInfo.ProviderAddress += relayResult.ProviderInfo.ProviderAddress
+					_, ok := blockOnSyncLoss[relayResult.ProviderInfo.ProviderAddress]
+					if !ok && lavasession.IsSessionSyncLoss(err) {
+						// allow this provider to be wantedProvider on a retry, if it didn't fail once on syncLoss
+						blockOnSyncLoss[relayResult.ProviderInfo.ProviderAddress] = struct{}{}
+						utils.LavaFormatWarning("Identified SyncLoss in provider, not removing it from list for another attempt", err, utils.Attribute{Key: "address", Value: relayResult.ProviderInfo.ProviderAddress})
+					} else {
+						unwantedProviders[relayResult.ProviderInfo.ProviderAddress] = struct{}{}
+					}
+					if common.IsTimeout(err) {
+						timeouts++
+					}
+				}
 			}
-			// decide if we should break here if its something retry won't solve
-			utils.LavaFormatDebug("could not send relay to provider", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()}, utils.Attribute{Key: "endpoint", Value: rpccs.listenEndpoint})
-			continue
+			if err != nil {
+				if relayResult.GetStatusCode() != 0 {
+					// keep the error status code
+					errorRelayResult.StatusCode = relayResult.GetStatusCode()
+				}
+				relayErrors.relayErrors = append(relayErrors.relayErrors, RelayError{err: err, ProviderInfo: relayResult.ProviderInfo})
+				utils.LavaFormatDebug("could not send relay to provider", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "error", Value: err.Error()}, utils.Attribute{Key: "endpoint", Value: rpccs.listenEndpoint}, utils.Attribute{Key: "hedged", Value: attempt.hedged})
+				continue
+			}
+			if attempt.hedged {
+				utils.LavaFormatDebug("hedged relay answered first", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "provider", Value: relayResult.ProviderInfo.ProviderAddress})
+			}
+			relayResults = append(relayResults, relayResult)
+			unwantedProviders[relayResult.ProviderInfo.ProviderAddress] = struct{}{}
+		}
+	}
+	if len(relayResults) > 0 {
+		// the losing attempts read the chain message, they are stopped and waited for before it's modified
+		for _, cancel := range cancelAttempts {
+			cancel()
+		}
+		for ; attemptsInFlight > 0; attemptsInFlight-- {
+			<-attempts
 		}
-		relayResults = append(relayResults, relayResult)
-		unwantedProviders[relayResult.ProviderInfo.ProviderAddress] = struct{}{}
 		// future relay requests and data reliability requests need to ask for the same specific block height to get consensus on the reply
 		// we do not modify the chain message data on the consumer, only it's requested block, so we let the provider know it can't put any block height it wants by setting a specific block height
+		relayResult := relayResults[0]
 		reqBlock, _ := chainMessage.RequestedBlock()
 		if reqBlock == spectypes.LATEST_BLOCK {
 			modifiedOnLatestReq = chainMessage.UpdateLatestBlockInMessage(relayResult.Request.RelayData.RequestBlock, false)
@@ -329,12 +529,18 @@ func (rpccs *RPCConsumerServer) SendRelay(
 				relayResult.Finalized = false // shut down data reliability
 			}
 		}
-		if len(relayResults) >= rpccs.requiredResponses {
-			break
-		}
+	}
+	retries = attemptsSent
+	if len(relayResults) > 0 && retries > 0 {
+		// the successful attempt is not a retry
+		retries--
 	}
 
 	enabled, dataReliabilityThreshold := rpccs.chainParser.DataReliabilityParams()
+	if rpccs.strategy == provideroptimizer.STRATEGY_ACCURACY {
+		// accuracy verifies every relay that can be verified
+		dataReliabilityThreshold = math.MaxUint32
+	}
 	if enabled {
 		for _, relayResult := range relayResults {
 			// new context is needed for data reliability as some clients cancel the context they provide when the relay returns
@@ -359,11 +565,7 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	} else if len(relayErrors.relayErrors) > 0 {
 		utils.LavaFormatDebug("relay succeeded but had some errors", utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "errors", Value: relayErrors})
 	}
-	var returnedResult *common.RelayResult
-	for _, iteratedResult := range relayResults {
-		// TODO: go over rpccs.requiredResponses and get majority
-		returnedResult = iteratedResult
-	}
+	returnedResult := relayResults[0]
 
 	if analytics != nil {
 		currentLatency := time.Since(relaySentTime)
@@ -401,17 +603,31 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	// handle QoS updates
 	// in case connection totally fails, update unresponsive providers in ConsumerSessionManager
 
-	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		// temporarily disable subscriptions
-		// TODO: fix subscription and disable this case.
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Subscriptions are disabled currently", nil)
+	if chainlib.IsSubscription(chainMessage) {
+		// subscriptions hold a stream open, they are relayed through sendSubscriptionRelay
+		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("subscriptions can't be sent as regular relays", nil)
 	}
 
-	privKey := rpccs.privKey
-	chainID := rpccs.listenEndpoint.ChainID
-	lavaChainID := rpccs.lavaChainID
+	sessions, err := rpccs.getSessionsForRelay(ctx, chainMessage, relayRequestData, *unwantedProviders)
+	if err != nil {
+		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, err
+	}
+	return rpccs.sendRelayToSessions(detachedRelayContext(ctx), chainMessage, relayRequestData, dappID, consumerIp, sessions, timeouts)
+}
 
+// detachedRelayContext returns a context carrying the GUID of ctx that isn't cancelled with it,
+// a client disconnecting must not abort relays the provider may already be serving and charging for
+func detachedRelayContext(ctx context.Context) context.Context {
+	detachedCtx := context.Background()
+	guid, found := utils.GetUniqueIdentifier(ctx)
+	if found {
+		detachedCtx = utils.WithUniqueIdentifier(detachedCtx, guid)
+	}
+	return detachedCtx
+}
+
+// gets locked sessions for the relay from the ConsumerSessionManager, the chosen providers are added to unwantedProviders
+func (rpccs *RPCConsumerServer) getSessionsForRelay(ctx context.Context, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, unwantedProviders map[string]struct{}) (lavasession.ConsumerSessionsMap, error) {
 	// Get Session. we get session here so we can use the epoch in the callbacks
 	reqBlock, _ := chainMessage.RequestedBlock()
 	if reqBlock == spectypes.LATEST_BLOCK && relayRequestData.SeenBlock != 0 {
@@ -422,14 +638,27 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
 	addon := chainlib.GetAddon(chainMessage)
 	extensions := chainMessage.GetExtensions()
-	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), *unwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
+	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), unwantedProviders, reqBlock, addon, extensions, chainlib.GetStateful(chainMessage), virtualEpoch)
 	if err != nil {
 		if lavasession.PairingListEmptyError.Is(err) && (addon != "" || len(extensions) > 0) {
 			// if we have no providers for a specific addon or extension, return an indicative error
 			err = utils.LavaFormatError("No Providers For Addon Or Extension", err, utils.LogAttr("addon", addon), utils.LogAttr("extensions", extensions))
 		}
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, err
+		return nil, err
 	}
+	return sessions, nil
+}
+
+// sends the relay to all the sessions in parallel and returns the first successful response
+func (rpccs *RPCConsumerServer) sendRelayToSessions(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	consumerIp string,
+	sessions lavasession.ConsumerSessionsMap,
+	timeouts int,
+) (relayResult *common.RelayResult, errRet error) {
 
 	type relayResponse struct {
 		relayResult *common.RelayResult
@@ -444,151 +673,10 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	for providerPublicAddress, sessionInfo := range sessions {
 		// Launch a separate goroutine for each session
 		go func(providerPublicAddress string, sessionInfo *lavasession.SessionInfo) {
-			var localRelayResult *common.RelayResult
-			var errResponse error
-			goroutineCtx, goroutineCtxCancel := context.WithCancel(context.Background())
-			guid, found := utils.GetUniqueIdentifier(ctx)
-			if found {
-				goroutineCtx = utils.WithUniqueIdentifier(goroutineCtx, guid)
-			}
-			defer func() {
-				// Return response
-				responses <- &relayResponse{
-					relayResult: localRelayResult,
-					err:         errResponse,
-				}
-				// Close context
-				goroutineCtxCancel()
-			}()
-			localRelayResult = &common.RelayResult{
-				ProviderInfo: common.ProviderInfo{ProviderAddress: providerPublicAddress, ProviderStake: sessionInfo.StakeSize, ProviderQoSExcellenceSummery: sessionInfo.QoSSummeryResult},
-				Finalized:    false,
-				// setting the single consumer session as the conflict handler.
-				//  to be able to validate if we need to report this provider or not.
-				// holding the pointer is ok because the session is locked atm,
-				// and later after its unlocked we only atomic read / write to it.
-				ConflictHandler: sessionInfo.Session.Parent,
-			}
-			localRelayRequestData := *relayRequestData
-
-			// Extract fields from the sessionInfo
-			singleConsumerSession := sessionInfo.Session
-			epoch := sessionInfo.Epoch
-			reportedProviders := sessionInfo.ReportedProviders
-
-			relayRequest, errResponse := lavaprotocol.ConstructRelayRequest(goroutineCtx, privKey, lavaChainID, chainID, &localRelayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
-			if errResponse != nil {
-				return
-			}
-			localRelayResult.Request = relayRequest
-			endpointClient := *singleConsumerSession.Endpoint.Client
-
-			if isSubscription {
-				localRelayResult, errResponse = rpccs.relaySubscriptionInner(goroutineCtx, endpointClient, singleConsumerSession, localRelayResult)
-				if errResponse != nil {
-					return
-				}
-			}
-			requestedBlock, _ := chainMessage.RequestedBlock()
-			if requestedBlock != spectypes.NOT_APPLICABLE {
-				// try using cache before sending relay
-				var cacheReply *pairingtypes.CacheRelayReply
-				cacheReply, errResponse = rpccs.cache.GetEntry(goroutineCtx, localRelayResult.Request.RelayData, nil, chainID, false, localRelayResult.Request.RelaySession.Provider) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
-				reply := cacheReply.GetReply()
-				if errResponse == nil && reply != nil {
-					// Info was fetched from cache, so we don't need to change the state
-					// so we can return here, no need to update anything and calculate as this info was fetched from the cache
-					localRelayResult.Reply = reply
-					lavaprotocol.UpdateRequestedBlock(localRelayResult.Request.RelayData, reply) // update relay request requestedBlock to the provided one in case it was arbitrary
-					errResponse = rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession)
-
-					return
-				}
-			} else {
-				utils.LavaFormatDebug("skipping cache due to requested block being NOT_APPLICABLE", utils.Attribute{Key: "api name", Value: chainMessage.GetApi().Name})
-			}
-
-			// cache failed, move on to regular relay
-			if performance.NotConnectedError.Is(errResponse) {
-				utils.LavaFormatDebug("cache not connected", utils.LogAttr("error", errResponse))
-			}
-
-			// unique per dappId and ip
-			consumerToken := common.GetUniqueToken(dappID, consumerIp)
-
-			localRelayResult, relayLatency, errResponse, backoff := rpccs.relayInner(goroutineCtx, singleConsumerSession, localRelayResult, relayTimeout, chainMessage, consumerToken)
-			if errResponse != nil {
-				failRelaySession := func(origErr error, backoff_ bool) {
-					backOffDuration := 0 * time.Second
-					if backoff_ {
-						backOffDuration = lavasession.BACKOFF_TIME_ON_FAILURE
-					}
-					time.Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
-					// relay failed need to fail the session advancement
-					errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, origErr)
-					if errReport != nil {
-						utils.LavaFormatError("failed relay onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "original error", Value: origErr.Error()})
-					}
-				}
-				go failRelaySession(errResponse, backoff)
-
-				return
-			}
-
-			// get here only if performed a regular relay successfully
-			expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
-			pairingAddressesLen := rpccs.consumerSessionManager.GetAtomicPairingAddressesLength()
-			latestBlock := localRelayResult.Reply.LatestBlock
-			if expectedBH-latestBlock > 1000 {
-				utils.LavaFormatWarning("identified block gap", nil,
-					utils.Attribute{Key: "expectedBH", Value: expectedBH},
-					utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
-					utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
-					utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
-					utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
-					utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
-				)
-			}
-			if DebugRelaysFlag && singleConsumerSession.QoSInfo.LastQoSReport != nil &&
-				singleConsumerSession.QoSInfo.LastQoSReport.Sync.BigInt() != nil &&
-				singleConsumerSession.QoSInfo.LastQoSReport.Sync.LT(sdk.MustNewDecFromStr("0.9")) {
-				utils.LavaFormatDebug("identified QoS mismatch",
-					utils.Attribute{Key: "expectedBH", Value: expectedBH},
-					utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
-					utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
-					utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
-					utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
-					utils.Attribute{Key: "singleConsumerSession.QoSInfo", Value: singleConsumerSession.QoSInfo},
-					utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
-				)
-			}
-			errResponse = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, latestBlock, chainlib.GetComputeUnits(chainMessage), relayLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, pairingAddressesLen, chainMessage.GetApi().Category.HangingApi) // session done successfully
-
-			if rpccs.cache.CacheActive() {
-				// copy private data so if it changes it doesn't panic mid async send
-				copyPrivateData := &pairingtypes.RelayPrivateData{}
-				copyRequestErr := protocopy.DeepCopyProtoObject(localRelayResult.Request.RelayData, copyPrivateData)
-				copyReply := &pairingtypes.RelayReply{}
-				copyReplyErr := protocopy.DeepCopyProtoObject(localRelayResult.Reply, copyReply)
-				// set cache in a non blocking call
-				go func() {
-					// deal with copying error.
-					if copyRequestErr != nil || copyReplyErr != nil {
-						utils.LavaFormatError("Failed copying relay private data sendRelayToProvider", nil, utils.LogAttr("copyReplyErr", copyReplyErr), utils.LogAttr("copyRequestErr", copyRequestErr))
-						return
-					}
-					requestedBlock, _ := chainMessage.RequestedBlock()
-					if requestedBlock == spectypes.NOT_APPLICABLE {
-						return
-					}
-					new_ctx := context.Background()
-					new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
-					defer cancel()
-					err2 := rpccs.cache.SetEntry(new_ctx, copyPrivateData, nil, chainID, copyReply, localRelayResult.Finalized, localRelayResult.Request.RelaySession.Provider, nil) // caching in the portal doesn't care about hashes
-					if err2 != nil {
-						utils.LavaFormatWarning("error updating cache with new entry", err2)
-					}
-				}()
+			localRelayResult, errResponse := rpccs.relayToSession(ctx, chainMessage, relayRequestData, dappID, consumerIp, providerPublicAddress, sessionInfo, relayTimeout, true)
+			responses <- &relayResponse{
+				relayResult: localRelayResult,
+				err:         errResponse,
 			}
 		}(providerPublicAddress, sessionInfo)
 	}
@@ -637,6 +725,173 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	return response.relayResult, response.err
 }
 
+// sends the relay on a locked session acquired from the ConsumerSessionManager, and releases the session according to the result
+// useCache is false when the reply must come from the provider itself (e.g. quorum relays comparing providers)
+func (rpccs *RPCConsumerServer) relayToSession(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	consumerIp string,
+	providerPublicAddress string,
+	sessionInfo *lavasession.SessionInfo,
+	relayTimeout time.Duration,
+	useCache bool,
+) (localRelayResult *common.RelayResult, errResponse error) {
+	privKey := rpccs.privKey
+	chainID := rpccs.listenEndpoint.ChainID
+	lavaChainID := rpccs.lavaChainID
+	// cancelled when another attempt's reply is used
+	goroutineCtx, goroutineCtxCancel := context.WithCancel(ctx)
+	defer goroutineCtxCancel()
+	localRelayResult = &common.RelayResult{
+		ProviderInfo: common.ProviderInfo{ProviderAddress: providerPublicAddress, ProviderStake: sessionInfo.StakeSize, ProviderQoSExcellenceSummery: sessionInfo.QoSSummeryResult},
+		Finalized:    false,
+		// setting the single consumer session as the conflict handler.
+		//  to be able to validate if we need to report this provider or not.
+		// holding the pointer is ok because the session is locked atm,
+		// and later after its unlocked we only atomic read / write to it.
+		ConflictHandler: sessionInfo.Session.Parent,
+	}
+	localRelayRequestData := *relayRequestData
+
+	// Extract fields from the sessionInfo
+	singleConsumerSession := sessionInfo.Session
+	epoch := sessionInfo.Epoch
+	reportedProviders := sessionInfo.ReportedProviders
+
+	relayRequest, errResponse := lavaprotocol.ConstructRelayRequest(goroutineCtx, privKey, lavaChainID, chainID, &localRelayRequestData, providerPublicAddress, singleConsumerSession, int64(epoch), reportedProviders)
+	if errResponse != nil {
+		return
+	}
+	localRelayResult.Request = relayRequest
+
+	requestedBlock, _ := chainMessage.RequestedBlock()
+	if !useCache {
+		utils.LavaFormatDebug("skipping cache, reply must come from the provider", utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "provider", Value: providerPublicAddress})
+	} else if requestedBlock != spectypes.NOT_APPLICABLE {
+		// try using cache before sending relay
+		var cacheReply *pairingtypes.CacheRelayReply
+		cacheReply, errResponse = rpccs.cache.GetEntry(goroutineCtx, localRelayResult.Request.RelayData, nil, chainID, false, localRelayResult.Request.RelaySession.Provider) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
+		reply := cacheReply.GetReply()
+		if errResponse == nil && reply != nil {
+			// Info was fetched from cache, so we don't need to change the state
+			// so we can return here, no need to update anything and calculate as this info was fetched from the cache
+			localRelayResult.Reply = reply
+			lavaprotocol.UpdateRequestedBlock(localRelayResult.Request.RelayData, reply) // update relay request requestedBlock to the provided one in case it was arbitrary
+			errResponse = rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession)
+
+			return
+		}
+	} else {
+		utils.LavaFormatDebug("skipping cache due to requested block being NOT_APPLICABLE", utils.Attribute{Key: "api name", Value: chainMessage.GetApi().Name})
+	}
+
+	// cache failed, move on to regular relay
+	if performance.NotConnectedError.Is(errResponse) {
+		utils.LavaFormatDebug("cache not connected", utils.LogAttr("error", errResponse))
+	}
+
+	// unique per dappId and ip
+	consumerToken := rpccs.getConsumerToken(dappID, consumerIp, sessionInfo.Epoch)
+
+	localRelayResult, relayLatency, errResponse, backoff := rpccs.relayInner(goroutineCtx, singleConsumerSession, localRelayResult, relayTimeout, chainMessage, consumerToken)
+	if errResponse != nil && lavasession.IsRateLimited(errResponse) {
+		// the provider rejected the relay before using the session, it's not a provider fault. the provider is left out of
+		// the retries of this relay so another provider is picked
+		utils.LavaFormatDebug("provider rate limited the relay", utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "provider", Value: providerPublicAddress})
+		errUnused := rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession)
+		if errUnused != nil {
+			utils.LavaFormatError("rate limited relay OnSessionUnUsed errored", errUnused, utils.Attribute{Key: "GUID", Value: goroutineCtx})
+		}
+		return
+	}
+	if errResponse != nil && ctx.Err() != nil {
+		// the relay was cancelled on the consumer side while in flight, the provider may have already
+		// counted it so the session can't be returned unused, but there's no need to back off either
+		utils.LavaFormatDebug("relay cancelled", utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "provider", Value: providerPublicAddress})
+		errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, errResponse)
+		if errReport != nil {
+			utils.LavaFormatError("cancelled relay onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: goroutineCtx})
+		}
+		return
+	}
+	if errResponse != nil {
+		failRelaySession := func(origErr error, backoff_ bool) {
+			backOffDuration := 0 * time.Second
+			if backoff_ {
+				backOffDuration = lavasession.BACKOFF_TIME_ON_FAILURE
+			}
+			time.Sleep(backOffDuration) // sleep before releasing this singleConsumerSession
+			// relay failed need to fail the session advancement
+			errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, origErr)
+			if errReport != nil {
+				utils.LavaFormatError("failed relay onSessionFailure errored", errReport, utils.Attribute{Key: "GUID", Value: goroutineCtx}, utils.Attribute{Key: "original error", Value: origErr.Error()})
+			}
+		}
+		go failRelaySession(errResponse, backoff)
+
+		return
+	}
+
+	// get here only if performed a regular relay successfully
+	expectedBH, numOfProviders := rpccs.finalizationConsensus.ExpectedBlockHeight(rpccs.chainParser)
+	pairingAddressesLen := rpccs.consumerSessionManager.GetAtomicPairingAddressesLength()
+	latestBlock := localRelayResult.Reply.LatestBlock
+	if expectedBH-latestBlock > 1000 {
+		utils.LavaFormatWarning("identified block gap", nil,
+			utils.Attribute{Key: "expectedBH", Value: expectedBH},
+			utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
+			utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
+			utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
+			utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
+			utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
+		)
+	}
+	if DebugRelaysFlag && singleConsumerSession.QoSInfo.LastQoSReport != nil &&
+		singleConsumerSession.QoSInfo.LastQoSReport.Sync.BigInt() != nil &&
+		singleConsumerSession.QoSInfo.LastQoSReport.Sync.LT(sdk.MustNewDecFromStr("0.9")) {
+		utils.LavaFormatDebug("identified QoS mismatch",
+			utils.Attribute{Key: "expectedBH", Value: expectedBH},
+			utils.Attribute{Key: "latestServicedBlock", Value: latestBlock},
+			utils.Attribute{Key: "session_id", Value: singleConsumerSession.SessionId},
+			utils.Attribute{Key: "provider_address", Value: singleConsumerSession.Parent.PublicLavaAddress},
+			utils.Attribute{Key: "providersCount", Value: pairingAddressesLen},
+			utils.Attribute{Key: "singleConsumerSession.QoSInfo", Value: singleConsumerSession.QoSInfo},
+			utils.Attribute{Key: "finalizationConsensus", Value: rpccs.finalizationConsensus.String()},
+		)
+	}
+	errResponse = rpccs.consumerSessionManager.OnSessionDone(singleConsumerSession, latestBlock, chainlib.GetComputeUnits(chainMessage), relayLatency, singleConsumerSession.CalculateExpectedLatency(relayTimeout), expectedBH, numOfProviders, pairingAddressesLen, chainMessage.GetApi().Category.HangingApi) // session done successfully
+
+	if rpccs.cache.CacheActive() {
+		// copy private data so if it changes it doesn't panic mid async send
+		copyPrivateData := &pairingtypes.RelayPrivateData{}
+		copyRequestErr := protocopy.DeepCopyProtoObject(localRelayResult.Request.RelayData, copyPrivateData)
+		copyReply := &pairingtypes.RelayReply{}
+		copyReplyErr := protocopy.DeepCopyProtoObject(localRelayResult.Reply, copyReply)
+		// set cache in a non blocking call
+		go func() {
+			// deal with copying error.
+			if copyRequestErr != nil || copyReplyErr != nil {
+				utils.LavaFormatError("Failed copying relay private data sendRelayToProvider", nil, utils.LogAttr("copyReplyErr", copyReplyErr), utils.LogAttr("copyRequestErr", copyRequestErr))
+				return
+			}
+			requestedBlock, _ := chainMessage.RequestedBlock()
+			if requestedBlock == spectypes.NOT_APPLICABLE {
+				return
+			}
+			new_ctx := context.Background()
+			new_ctx, cancel := context.WithTimeout(new_ctx, common.DataReliabilityTimeoutIncrease)
+			defer cancel()
+			err2 := rpccs.cache.SetEntry(new_ctx, copyPrivateData, nil, chainID, copyReply, localRelayResult.Finalized, localRelayResult.Request.RelaySession.Provider, nil) // caching in the portal doesn't care about hashes
+			if err2 != nil {
+				utils.LavaFormatWarning("error updating cache with new entry", err2)
+			}
+		}()
+	}
+	return
+}
+
 func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult, relayTimeout time.Duration, chainMessage chainlib.ChainMessage, consumerToken string) (relayResultRet *common.RelayResult, relayLatency time.Duration, err error, needsBackoff bool) {
 	existingSessionLatestBlock := singleConsumerSession.LatestBlock // we read it now because singleConsumerSession is locked, and later it's not
 	endpointClient := *singleConsumerSession.Endpoint.Client
@@ -705,17 +960,21 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	enabled, _ := rpccs.chainParser.DataReliabilityParams()
 	if enabled {
 		// TODO: DETECTION instead of existingSessionLatestBlock, we need proof of last reply to send the previous reply and the current reply
-		finalizedBlocks, finalizationConflict, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, rpccs.consumerAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
+		finalizedBlocks, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, rpccs.consumerAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
 		if err != nil {
-			if lavaprotocol.ProviderFinzalizationDataAccountabilityError.Is(err) && finalizationConflict != nil {
-				go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
-			}
 			return relayResult, 0, err, false
 		}
 
-		finalizationConflict, err = rpccs.finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), providerPublicAddress, finalizedBlocks, relayRequest.RelaySession, reply)
+		finalizationConflict, err := rpccs.finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), providerPublicAddress, finalizedBlocks, relayRequest.RelaySession, reply)
 		if err != nil {
-			go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
+			if finalizationConflict != nil {
+				if finalizationConflict.RelaySession0.Provider == finalizationConflict.RelaySession1.Provider {
+					// the provider contradicted its own finalization data
+					go rpccs.consumerTxSender.TxConflictDetection(ctx, nil, nil, finalizationConflict, singleConsumerSession.Parent)
+				} else {
+					go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
+				}
+			}
 			return relayResult, 0, err, false
 		}
 	}
@@ -807,6 +1066,8 @@ func (rpccs *RPCConsumerServer) LavaDirectiveHeaders(metadata []pairingtypes.Met
 			headerDirectives[name] = metaElement.Value
 		case common.EXTENSION_OVERRIDE_HEADER_NAME:
 			headerDirectives[name] = metaElement.Value
+		case common.QUORUM_HEADER_NAME:
+			headerDirectives[name] = metaElement.Value
 		default:
 			metadataRet = append(metadataRet, metaElement)
 		}
@@ -0,0 +1,340 @@
+package rpcconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/rand"
+	"github.com/lavanet/lava/utils/sigs"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	plantypes "github.com/lavanet/lava/x/plans/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const mockProviderLatestBlock = int64(1000)
+
+type mockConsumerTxSender struct{}
+
+func (mockConsumerTxSender) TxConflictDetection(ctx context.Context, finalizationConflict *conflicttypes.FinalizationConflict, responseConflict *conflicttypes.ResponseConflict, sameProviderConflict *conflicttypes.FinalizationConflict, conflictHandler common.ConflictHandlerInterface) error {
+	return nil
+}
+
+func (mockConsumerTxSender) GetConsumerPolicy(ctx context.Context, consumerAddress, chainID string) (*plantypes.Policy, error) {
+	return nil, nil
+}
+
+func (mockConsumerTxSender) GetLatestVirtualEpoch() uint64 {
+	return 0
+}
+
+// answers relays with the data returned by handler, signed like a real provider
+type mockProvider struct {
+	pairingtypes.UnimplementedRelayerServer
+	privKey *btcec.PrivateKey
+	handler func(ctx context.Context, request *pairingtypes.RelayRequest) ([]byte, error)
+}
+
+func (mp *mockProvider) Relay(ctx context.Context, request *pairingtypes.RelayRequest) (*pairingtypes.RelayReply, error) {
+	data, err := mp.handler(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pairingtypes.RelayReply{Data: data, LatestBlock: mockProviderLatestBlock}
+	return lavaprotocol.SignRelayResponse(nil, *request, mp.privKey, reply, false)
+}
+
+func (mp *mockProvider) Probe(ctx context.Context, probeReq *pairingtypes.ProbeRequest) (*pairingtypes.ProbeReply, error) {
+	return &pairingtypes.ProbeReply{Guid: probeReq.Guid, LatestBlock: mockProviderLatestBlock}, nil
+}
+
+// starts a relayer server answering with handler and returns its pairing entry
+func startMockProvider(t *testing.T, addons []string, extensions []string, handler func(ctx context.Context, request *pairingtypes.RelayRequest) ([]byte, error)) *lavasession.ConsumerSessionsWithProvider {
+	privKey, address := sigs.GenerateFloatingKey()
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(lavasession.GetTlsConfig(lavasession.NetworkAddressData{}))))
+	pairingtypes.RegisterRelayerServer(server, &mockProvider{privKey: privKey, handler: handler})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	endpoint := &lavasession.Endpoint{NetworkAddress: listener.Addr().String(), Enabled: true, Addons: map[string]struct{}{}, Extensions: map[string]struct{}{}}
+	for _, addon := range addons {
+		endpoint.Addons[addon] = struct{}{}
+	}
+	for _, extension := range extensions {
+		endpoint.Extensions[extension] = struct{}{}
+	}
+	return lavasession.NewConsumerSessionWithProvider(address.String(), []*lavasession.Endpoint{endpoint}, 10000, 1, sdk.NewCoin("ulava", sdk.NewInt(1)))
+}
+
+// latencyOptimizer reports a fixed latency percentile so relays are hedged after a known threshold
+type latencyOptimizer struct {
+	*provideroptimizer.ProviderOptimizer
+	latencyPercentile time.Duration
+}
+
+func (lo *latencyOptimizer) LatencyPercentile(cu uint64, percentile float64) time.Duration {
+	return lo.latencyPercentile
+}
+
+// creates a jsonrpc ETH1 consumer server relaying to the given providers, data reliability is disabled
+func createTestConsumerServer(t *testing.T, optimizer lavasession.ProviderOptimizer, providers ...*lavasession.ConsumerSessionsWithProvider) *RPCConsumerServer {
+	lavasession.AllowInsecureConnectionToProviders = true
+	rand.InitRandomSeed()
+	spec, err := keepertest.GetASpec("ETH1", "../../", nil, nil)
+	require.NoError(t, err)
+	spec.DataReliabilityEnabled = false
+	chainParser, err := chainlib.NewChainParser(spectypes.APIInterfaceJsonRPC)
+	require.NoError(t, err)
+	chainParser.SetSpec(spec)
+	chainParser.(interface {
+		SetPolicyFromAddonAndExtensionMap(map[string]struct{})
+	}).SetPolicyFromAddonAndExtensionMap(map[string]struct{}{"debug": {}, "archive": {}})
+
+	listenEndpoint := &lavasession.RPCEndpoint{ChainID: spec.Index, ApiInterface: spectypes.APIInterfaceJsonRPC}
+	if optimizer == nil {
+		optimizer = provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, time.Second, time.Second, 1)
+	}
+	consumerSessionManager := lavasession.NewConsumerSessionManager(listenEndpoint, optimizer, nil)
+	pairingList := map[uint64]*lavasession.ConsumerSessionsWithProvider{}
+	for idx, provider := range providers {
+		pairingList[uint64(idx)] = provider
+	}
+	require.NoError(t, consumerSessionManager.UpdateAllProviders(1, pairingList))
+
+	privKey, consumerAddress := sigs.GenerateFloatingKey()
+	return &RPCConsumerServer{
+		chainParser:            chainParser,
+		consumerSessionManager: consumerSessionManager,
+		listenEndpoint:         listenEndpoint,
+		privKey:                privKey,
+		consumerTxSender:       mockConsumerTxSender{},
+		requiredResponses:      1,
+		finalizationConsensus:  lavaprotocol.NewFinalizationConsensus(spec.Index),
+		lavaChainID:            "lava",
+		consumerAddress:        consumerAddress,
+		consumerConsistency:    NewConsumerConsistency(spec.Index),
+		strategy:               provideroptimizer.STRATEGY_BALANCED,
+	}
+}
+
+func TestSendRelayHedgedAttemptWinsAndCancelsTheSlowOne(t *testing.T) {
+	received := make(chan struct{}, 2)
+	cancelled := make(chan struct{}, 2)
+	first := make(chan struct{}, 1)
+	first <- struct{}{}
+	handler := func(ctx context.Context, request *pairingtypes.RelayRequest) ([]byte, error) {
+		received <- struct{}{}
+		select {
+		case <-first:
+			// the first attempt hangs until the consumer gives up on it
+			<-ctx.Done()
+			cancelled <- struct{}{}
+			return nil, ctx.Err()
+		default:
+			return []byte(`{"jsonrpc":"2.0","id":1,"result":"0x3e8"}`), nil
+		}
+	}
+	optimizer := &latencyOptimizer{
+		ProviderOptimizer: provideroptimizer.NewProviderOptimizer(provideroptimizer.STRATEGY_BALANCED, time.Second, time.Second, 1),
+		latencyPercentile: 100 * time.Millisecond,
+	}
+	rpccs := createTestConsumerServer(t, optimizer, startMockProvider(t, nil, nil, handler), startMockProvider(t, nil, nil, handler))
+	rpccs.hedgeBudget = newHedgeBudget(100)
+	rpccs.hedgeLatencyPercentile = 0.9
+
+	start := time.Now()
+	relayResult, err := rpccs.SendRelay(context.Background(), "", `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`, "POST", "dapp", "127.0.0.1", nil, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"0x3e8"}`, string(relayResult.Reply.Data))
+	require.Len(t, received, 2)
+	// the hedge answered long before the relay timeout and the slow attempt was stopped
+	require.Less(t, time.Since(start), 5*time.Second)
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the losing attempt was not cancelled")
+	}
+	require.NotZero(t, rpccs.hedgeBudget.hedgeCU)
+}
+
+func TestSendRelayClientDisconnectDoesNotAbortRelay(t *testing.T) {
+	received := make(chan struct{}, 1)
+	providerCancelled := atomic.Bool{}
+	handler := func(ctx context.Context, request *pairingtypes.RelayRequest) ([]byte, error) {
+		received <- struct{}{}
+		select {
+		case <-ctx.Done():
+			providerCancelled.Store(true)
+			return nil, ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+			return []byte(`{"jsonrpc":"2.0","id":1,"result":"0x3e8"}`), nil
+		}
+	}
+	rpccs := createTestConsumerServer(t, nil, startMockProvider(t, nil, nil, handler))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		// the client goes away while the provider is serving the relay
+		cancel()
+	}()
+	_, err := rpccs.SendRelay(ctx, "", `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`, "POST", "dapp", "127.0.0.1", nil, nil)
+	require.NoError(t, err)
+	require.False(t, providerCancelled.Load())
+}
+
+func TestSendRelaySplitsMixedBatch(t *testing.T) {
+	lock := sync.Mutex{}
+	routes := map[string]string{} // method -> addon;extensions
+	failDebug := atomic.Bool{}
+	handler := func(ctx context.Context, request *pairingtypes.RelayRequest) ([]byte, error) {
+		if failDebug.Load() && request.RelayData.Addon == "debug" {
+			return nil, fmt.Errorf("debug node is down")
+		}
+		elements := splitBatchRequest(string(request.RelayData.Data))
+		single := elements == nil
+		if single {
+			elements = []json.RawMessage{request.RelayData.Data}
+		}
+		replies := []rpcInterfaceMessages.JsonrpcMessage{}
+		for _, element := range elements {
+			message := rpcInterfaceMessages.JsonrpcMessage{}
+			if err := json.Unmarshal(element, &message); err != nil {
+				return nil, err
+			}
+			lock.Lock()
+			routes[message.Method] = request.RelayData.Addon + ";" + strings.Join(request.RelayData.Extensions, ",")
+			lock.Unlock()
+			if len(message.ID) == 0 {
+				// notifications are not answered
+				continue
+			}
+			replies = append(replies, rpcInterfaceMessages.JsonrpcMessage{Version: "2.0", ID: message.ID, Result: json.RawMessage(`"` + message.Method + `"`)})
+		}
+		if single {
+			return json.Marshal(replies[0])
+		}
+		return json.Marshal(replies)
+	}
+	rpccs := createTestConsumerServer(t, nil, startMockProvider(t, []string{"debug"}, []string{"archive"}, handler))
+	batch := `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x1","0x10"]},
+		{"jsonrpc":"2.0","id":2,"method":"debug_getRawTransaction","params":["0x1"]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber","params":[]},
+		{"jsonrpc":"2.0","method":"eth_chainId","params":[]}
+	]`
+
+	t.Run("sub batches are routed by their addons and extensions", func(t *testing.T) {
+		relayResult, err := rpccs.SendRelay(context.Background(), "", batch, "POST", "dapp", "127.0.0.1", nil, nil)
+		require.NoError(t, err)
+		require.JSONEq(t, `[
+			{"jsonrpc":"2.0","id":1,"result":"eth_getBalance"},
+			{"jsonrpc":"2.0","id":2,"result":"debug_getRawTransaction"},
+			{"jsonrpc":"2.0","id":3,"result":"eth_blockNumber"}
+		]`, string(relayResult.Reply.Data))
+		require.Equal(t, map[string]string{
+			"eth_getBalance":          ";archive",
+			"debug_getRawTransaction": "debug;",
+			"eth_blockNumber":         ";",
+			"eth_chainId":             ";",
+		}, routes)
+	})
+
+	t.Run("the batch is filtered as a whole before it is split", func(t *testing.T) {
+		routes = map[string]string{}
+		var err error
+		rpccs.methodFilter, err = newMethodFilter(&lavasession.RPCEndpoint{DeniedMethods: []string{"debug_*"}})
+		require.NoError(t, err)
+		defer func() { rpccs.methodFilter = nil }()
+		relayResult, err := rpccs.SendRelay(context.Background(), "", batch, "POST", "dapp", "127.0.0.1", nil, nil)
+		require.True(t, common.MethodDeniedError.Is(err))
+		require.NotNil(t, relayResult)
+		require.Empty(t, routes)
+	})
+
+	t.Run("the batch is charged once and failed sub batches are refunded", func(t *testing.T) {
+		var err error
+		rpccs.accessControl, err = common.NewAccessControl([]common.AccessCredential{{Type: common.CredentialTypeApiKey, Key: "key", DappID: "dapp", CuBudget: 1000000}})
+		require.NoError(t, err)
+		defer func() { rpccs.accessControl = nil }()
+		_, err = rpccs.SendRelay(context.Background(), "", batch, "POST", "dapp", "127.0.0.1", nil, nil)
+		require.NoError(t, err)
+		chargedBudget := 1000000 - remainingBudget(t, rpccs.accessControl, "dapp")
+		require.NotZero(t, chargedBudget)
+		rpccs.accessControl.RefundRelay("dapp", chargedBudget, time.Now())
+
+		failDebug.Store(true)
+		defer failDebug.Store(false)
+		relayResult, err := rpccs.SendRelay(context.Background(), "", batch, "POST", "dapp", "127.0.0.1", nil, nil)
+		require.NoError(t, err)
+		require.False(t, relayResult.Finalized)
+		var replies []map[string]interface{}
+		require.NoError(t, json.Unmarshal(relayResult.Reply.Data, &replies))
+		require.Len(t, replies, 3)
+		require.NotNil(t, replies[1]["error"])
+		debugMessage, err := rpccs.chainParser.ParseMsg("", []byte(`{"jsonrpc":"2.0","id":2,"method":"debug_getRawTransaction","params":["0x1"]}`), "POST", nil, extensionslib.ExtensionInfo{})
+		require.NoError(t, err)
+		require.Equal(t, chargedBudget-debugMessage.GetApi().ComputeUnits, 1000000-remainingBudget(t, rpccs.accessControl, "dapp"))
+	})
+}
+
+func TestSendRelayCostFallbackAnalytics(t *testing.T) {
+	const cheaperAttemptLatency = 300 * time.Millisecond
+	handler := func(ctx context.Context, request *pairingtypes.RelayRequest) ([]byte, error) {
+		if len(request.RelayData.Extensions) == 0 {
+			// the relay without the archive extension reaches a pruned node
+			time.Sleep(cheaperAttemptLatency)
+			return []byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"missing trie node 0x1 (path )"}}`), nil
+		}
+		return []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`), nil
+	}
+	rpccs := createTestConsumerServer(t, nil, startMockProvider(t, nil, []string{"archive"}, handler))
+	rpccs.strategy = provideroptimizer.STRATEGY_COST
+
+	req := `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x1","0x10"]}`
+	analytics := &metrics.RelayMetrics{}
+	relayResult, err := rpccs.SendRelay(context.Background(), "", req, "POST", "dapp", "127.0.0.1", analytics, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, string(relayResult.Reply.Data))
+
+	// the analytics describe the relay with the extension only
+	archiveMessage, err := rpccs.chainParser.ParseMsg("", []byte(req), "POST", nil, extensionslib.ExtensionInfo{LatestBlock: uint64(mockProviderLatestBlock)})
+	require.NoError(t, err)
+	require.Equal(t, archiveMessage.GetApi().ComputeUnits, analytics.ComputeUnits)
+	require.Less(t, analytics.Latency, cheaperAttemptLatency.Milliseconds())
+}
+
+// the cu left in the budget, found by charging it all and refunding it
+func remainingBudget(t *testing.T, accessControl *common.AccessControl, dappID string) uint64 {
+	remaining := uint64(0)
+	for step := uint64(1 << 20); step > 0; step /= 2 {
+		if accessControl.AllowRelay(dappID, nil, step) == nil {
+			remaining += step
+		}
+	}
+	accessControl.RefundRelay(dappID, remaining, time.Now())
+	return remaining
+}
@@ -12,6 +12,7 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -20,12 +21,15 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// the test certificate is written to a temporary directory so it is never left in the package directory
+var testCertPath, testKeyPath string
+
 func StartTestServer() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, "Hello, this server doesn't set CORS headers!")
 	})
-	err := http.ListenAndServeTLS(":8080", "cert.pem", "key.pem", mux)
+	err := http.ListenAndServeTLS(":8080", testCertPath, testKeyPath, mux)
 	if err != nil {
 		log.Fatalf("Failed to start server 8080: %s", err.Error())
 	}
@@ -37,7 +41,7 @@ func StartTestServerWithOriginHeader() {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		fmt.Fprint(w, "Hello, this server sets Access-Control-Allow-Origin but not x-grpc-web!")
 	})
-	err := http.ListenAndServeTLS(":8081", "cert.pem", "key.pem", mux)
+	err := http.ListenAndServeTLS(":8081", testCertPath, testKeyPath, mux)
 	if err != nil {
 		log.Fatalf("Failed to start server 8081: %s", err.Error())
 	}
@@ -50,7 +54,7 @@ func StartTestServerWithXGrpcWeb() {
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, x-grpc-web")
 		fmt.Fprint(w, "Hello, this server sets Access-Control-Allow-Origin and x-grpc-web but not lava-sdk-relay-timeout!")
 	})
-	err := http.ListenAndServeTLS(":8082", "cert.pem", "key.pem", mux)
+	err := http.ListenAndServeTLS(":8082", testCertPath, testKeyPath, mux)
 	if err != nil {
 		log.Fatalf("Failed to start server 8082: %s", err.Error())
 	}
@@ -63,14 +67,20 @@ func StartTestServerWithAllHeaders() {
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, x-grpc-web, lava-sdk-relay-timeout")
 		fmt.Fprint(w, "Hello, this server sets all required headers!")
 	})
-	err := http.ListenAndServeTLS(":8083", "cert.pem", "key.pem", mux)
+	err := http.ListenAndServeTLS(":8083", testCertPath, testKeyPath, mux)
 	if err != nil {
 		log.Fatalf("Failed to start server 8083: %s", err.Error())
 	}
 }
 
 func TestMain(m *testing.M) {
-	err := CreateSelfSignedCertificate("cert.pem", "key.pem", 365*24*time.Hour)
+	certDir, err := os.MkdirTemp("", "cors_test")
+	if err != nil {
+		panic(err)
+	}
+	testCertPath = filepath.Join(certDir, "cert.pem")
+	testKeyPath = filepath.Join(certDir, "key.pem")
+	err = CreateSelfSignedCertificate(testCertPath, testKeyPath, 365*24*time.Hour)
 	if err != nil {
 		panic(err)
 	}
@@ -82,6 +92,7 @@ func TestMain(m *testing.M) {
 	time.Sleep(10 * time.Millisecond) // allow the servers to finish starting
 	code := m.Run()
 
+	os.RemoveAll(certDir)
 	os.Exit(code)
 }
 
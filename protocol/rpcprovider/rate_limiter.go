@@ -0,0 +1,231 @@
+package rpcprovider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	"github.com/spf13/viper"
+)
+
+const (
+	RateLimitsConfigName = "rate-limits"
+	RateLimitByConsumer  = "consumer"
+	RateLimitByProject   = "project"
+	RateLimitByBadgeUser = "badge-user"
+	RateLimitAnyKey      = "*" // a rule for every key of the type that has no rule of its own
+	// full buckets are dropped at this interval, they are recreated full on the next relay of their key
+	rateLimitCleanupInterval = 10 * time.Minute
+)
+
+var rateLimitKeyTypes = []string{RateLimitByConsumer, RateLimitByProject, RateLimitByBadgeUser}
+
+// RateLimitConfig limits the relays of a consumer address, project id or badge user.
+// each limit allows bursts of one second worth of its rate, a zero limit is not enforced
+type RateLimitConfig struct {
+	KeyType     string  `yaml:"key-type,omitempty" json:"key-type,omitempty" mapstructure:"key-type"`
+	Key         string  `yaml:"key,omitempty" json:"key,omitempty" mapstructure:"key"`
+	Rps         float64 `yaml:"rps,omitempty" json:"rps,omitempty" mapstructure:"rps"`
+	CuPerSecond float64 `yaml:"cu-per-second,omitempty" json:"cu-per-second,omitempty" mapstructure:"cu-per-second"`
+}
+
+func (rlc RateLimitConfig) Validate() error {
+	validKeyType := false
+	for _, keyType := range rateLimitKeyTypes {
+		if rlc.KeyType == keyType {
+			validKeyType = true
+		}
+	}
+	if !validKeyType {
+		return fmt.Errorf("invalid rate limit key-type %q, expected one of %v", rlc.KeyType, rateLimitKeyTypes)
+	}
+	if rlc.Key == "" {
+		return fmt.Errorf("empty rate limit key for key-type %s, use %q for all keys", rlc.KeyType, RateLimitAnyKey)
+	}
+	if rlc.Rps < 0 || rlc.CuPerSecond < 0 {
+		return fmt.Errorf("negative rate limit for %s %s", rlc.KeyType, rlc.Key)
+	}
+	if rlc.Rps == 0 && rlc.CuPerSecond == 0 {
+		return fmt.Errorf("rate limit for %s %s has no rps or cu-per-second", rlc.KeyType, rlc.Key)
+	}
+	return nil
+}
+
+func ParseRateLimits(viperRateLimits *viper.Viper) (rateLimits []RateLimitConfig, err error) {
+	err = viperRateLimits.UnmarshalKey(RateLimitsConfigName, &rateLimits)
+	if err != nil {
+		return nil, err
+	}
+	for _, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return rateLimits, nil
+}
+
+type tokenBucket struct {
+	rate   float64 // tokens per second, also the bucket size
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, tokens: rate, last: now}
+}
+
+func (tb *tokenBucket) refill(now time.Time) {
+	tb.tokens += now.Sub(tb.last).Seconds() * tb.rate
+	if tb.tokens > tb.rate {
+		tb.tokens = tb.rate
+	}
+	tb.last = now
+}
+
+// a full bucket allows any amount so relays costing more than a second worth of tokens are not rejected forever,
+// the bucket goes into debt and refills before the next one
+func (tb *tokenBucket) canTake(amount float64) bool {
+	return tb.tokens >= amount || tb.tokens >= tb.rate
+}
+
+type rateLimitBuckets struct {
+	requests *tokenBucket // nil when not limited
+	cu       *tokenBucket // nil when not limited
+}
+
+func (rlb *rateLimitBuckets) refill(now time.Time) {
+	if rlb.requests != nil {
+		rlb.requests.refill(now)
+	}
+	if rlb.cu != nil {
+		rlb.cu.refill(now)
+	}
+}
+
+func (rlb *rateLimitBuckets) canTake(cu float64) bool {
+	return (rlb.requests == nil || rlb.requests.canTake(1)) && (rlb.cu == nil || rlb.cu.canTake(cu))
+}
+
+func (rlb *rateLimitBuckets) take(cu float64) {
+	if rlb.requests != nil {
+		rlb.requests.tokens--
+	}
+	if rlb.cu != nil {
+		rlb.cu.tokens -= cu
+	}
+}
+
+func (rlb *rateLimitBuckets) isFull() bool {
+	return (rlb.requests == nil || rlb.requests.tokens >= rlb.requests.rate) && (rlb.cu == nil || rlb.cu.tokens >= rlb.cu.rate)
+}
+
+// RelayRateLimiter enforces token bucket limits on relays per consumer address, project id and badge user.
+// a relay is served only if it is within the limits of all the keys it belongs to. a nil limiter allows everything
+type RelayRateLimiter struct {
+	lock        sync.Mutex
+	rules       map[string]map[string]RateLimitConfig // key type -> key -> rule
+	buckets     map[string]*rateLimitBuckets          // key type;key -> buckets
+	lastCleanup time.Time
+}
+
+func NewRelayRateLimiter(rateLimits []RateLimitConfig) (*RelayRateLimiter, error) {
+	rl := &RelayRateLimiter{}
+	return rl, rl.UpdateLimits(rateLimits)
+}
+
+// replaces the limits, the usage tracked so far is reset
+func (rl *RelayRateLimiter) UpdateLimits(rateLimits []RateLimitConfig) error {
+	rules := map[string]map[string]RateLimitConfig{}
+	for _, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		if _, ok := rules[rateLimit.KeyType]; !ok {
+			rules[rateLimit.KeyType] = map[string]RateLimitConfig{}
+		}
+		rules[rateLimit.KeyType][rateLimit.Key] = rateLimit
+	}
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	rl.rules = rules
+	rl.buckets = map[string]*rateLimitBuckets{}
+	return nil
+}
+
+// returns RateLimitedError when the relay exceeds one of the limits, in that case nothing is counted
+func (rl *RelayRateLimiter) Allow(consumer string, projectId string, badgeUser string, cu uint64) error {
+	if rl == nil {
+		return nil
+	}
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+	if len(rl.rules) == 0 {
+		return nil
+	}
+	now := time.Now()
+	rl.cleanup(now)
+	keys := map[string]string{RateLimitByConsumer: consumer, RateLimitByProject: projectId, RateLimitByBadgeUser: badgeUser}
+	matched := []*rateLimitBuckets{}
+	for _, keyType := range rateLimitKeyTypes {
+		key := keys[keyType]
+		if key == "" {
+			continue
+		}
+		buckets := rl.getBuckets(keyType, key, now)
+		if buckets == nil {
+			continue
+		}
+		buckets.refill(now)
+		if !buckets.canTake(float64(cu)) {
+			// rejections are expected under load, they are not logged above debug
+			utils.LavaFormatDebug("relay rate limited", utils.Attribute{Key: "keyType", Value: keyType}, utils.Attribute{Key: "key", Value: key}, utils.Attribute{Key: "cu", Value: cu})
+			return sdkerrors.Wrapf(lavasession.RateLimitedError, "%s %s exceeded its rate limit", keyType, key)
+		}
+		matched = append(matched, buckets)
+	}
+	for _, buckets := range matched {
+		buckets.take(float64(cu))
+	}
+	return nil
+}
+
+// assumes the lock is held, returns nil when no rule applies to the key
+func (rl *RelayRateLimiter) getBuckets(keyType string, key string, now time.Time) *rateLimitBuckets {
+	bucketsKey := keyType + ";" + key
+	if buckets, ok := rl.buckets[bucketsKey]; ok {
+		return buckets
+	}
+	rule, ok := rl.rules[keyType][key]
+	if !ok {
+		rule, ok = rl.rules[keyType][RateLimitAnyKey]
+		if !ok {
+			return nil
+		}
+	}
+	buckets := &rateLimitBuckets{}
+	if rule.Rps > 0 {
+		buckets.requests = newTokenBucket(rule.Rps, now)
+	}
+	if rule.CuPerSecond > 0 {
+		buckets.cu = newTokenBucket(rule.CuPerSecond, now)
+	}
+	rl.buckets[bucketsKey] = buckets
+	return buckets
+}
+
+// assumes the lock is held
+func (rl *RelayRateLimiter) cleanup(now time.Time) {
+	if now.Sub(rl.lastCleanup) < rateLimitCleanupInterval {
+		return
+	}
+	rl.lastCleanup = now
+	for bucketsKey, buckets := range rl.buckets {
+		buckets.refill(now)
+		if buckets.isFull() {
+			delete(rl.buckets, bucketsKey)
+		}
+	}
+}
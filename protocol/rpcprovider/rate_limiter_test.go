@@ -0,0 +1,118 @@
+package rpcprovider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	config := `
+rate-limits:
+  - key-type: consumer
+    key: "*"
+    rps: 10
+  - key-type: project
+    key: lava@project1
+    rps: 5
+    cu-per-second: 100
+`
+	viperConfig := viper.New()
+	viperConfig.SetConfigType("yml")
+	require.NoError(t, viperConfig.ReadConfig(strings.NewReader(config)))
+	rateLimits, err := ParseRateLimits(viperConfig)
+	require.NoError(t, err)
+	require.Equal(t, []RateLimitConfig{
+		{KeyType: RateLimitByConsumer, Key: RateLimitAnyKey, Rps: 10},
+		{KeyType: RateLimitByProject, Key: "lava@project1", Rps: 5, CuPerSecond: 100},
+	}, rateLimits)
+
+	invalid := []RateLimitConfig{
+		{KeyType: "ip", Key: "*", Rps: 1},
+		{KeyType: RateLimitByConsumer, Key: "", Rps: 1},
+		{KeyType: RateLimitByConsumer, Key: "*", Rps: -1},
+		{KeyType: RateLimitByConsumer, Key: "*"},
+	}
+	for _, rateLimit := range invalid {
+		_, err := NewRelayRateLimiter([]RateLimitConfig{rateLimit})
+		require.Error(t, err, rateLimit)
+	}
+}
+
+func TestRelayRateLimiter(t *testing.T) {
+	rateLimiter, err := NewRelayRateLimiter([]RateLimitConfig{
+		{KeyType: RateLimitByConsumer, Key: RateLimitAnyKey, Rps: 3},
+		{KeyType: RateLimitByConsumer, Key: "vip", Rps: 100},
+		{KeyType: RateLimitByProject, Key: "project", CuPerSecond: 100},
+		{KeyType: RateLimitByBadgeUser, Key: RateLimitAnyKey, Rps: 1},
+	})
+	require.NoError(t, err)
+
+	t.Run("rps per consumer", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			require.NoError(t, rateLimiter.Allow("consumer1", "", "", 10))
+		}
+		err := rateLimiter.Allow("consumer1", "", "", 10)
+		require.True(t, lavasession.RateLimitedError.Is(err))
+		// every consumer has its own bucket, and specific rules override the default
+		require.NoError(t, rateLimiter.Allow("consumer2", "", "", 10))
+		for i := 0; i < 10; i++ {
+			require.NoError(t, rateLimiter.Allow("vip", "", "", 10))
+		}
+	})
+
+	t.Run("cu per project", func(t *testing.T) {
+		require.NoError(t, rateLimiter.Allow("consumer3", "project", "", 60))
+		err := rateLimiter.Allow("consumer3", "project", "", 60)
+		require.True(t, lavasession.RateLimitedError.Is(err))
+		require.NoError(t, rateLimiter.Allow("consumer3", "project", "", 40))
+		// the rejected relay wasn't counted in the consumer rps
+		require.NoError(t, rateLimiter.Allow("consumer3", "", "", 1))
+		require.True(t, lavasession.RateLimitedError.Is(rateLimiter.Allow("consumer3", "", "", 1)))
+		// projects without a rule are not limited
+		require.NoError(t, rateLimiter.Allow("consumer4", "other", "", 1000))
+	})
+
+	t.Run("badge users", func(t *testing.T) {
+		require.NoError(t, rateLimiter.Allow("consumer5", "", "user1", 1))
+		require.True(t, lavasession.RateLimitedError.Is(rateLimiter.Allow("consumer5", "", "user1", 1)))
+		require.NoError(t, rateLimiter.Allow("consumer5", "", "user2", 1))
+	})
+
+	t.Run("buckets refill", func(t *testing.T) {
+		rateLimiter.lock.Lock()
+		for _, buckets := range rateLimiter.buckets {
+			if buckets.requests != nil {
+				buckets.requests.last = buckets.requests.last.Add(-time.Second)
+			}
+		}
+		rateLimiter.lock.Unlock()
+		require.NoError(t, rateLimiter.Allow("consumer1", "", "", 10))
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		require.NoError(t, rateLimiter.UpdateLimits([]RateLimitConfig{{KeyType: RateLimitByConsumer, Key: "consumer1", Rps: 1}}))
+		require.NoError(t, rateLimiter.Allow("consumer1", "", "", 10))
+		require.Error(t, rateLimiter.Allow("consumer1", "", "", 10))
+		require.NoError(t, rateLimiter.Allow("consumer2", "", "", 10))
+		require.NoError(t, rateLimiter.Allow("consumer2", "", "", 10))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var disabled *RelayRateLimiter
+		require.NoError(t, disabled.Allow("consumer1", "project", "user", 1000))
+	})
+}
+
+func TestRateLimitedErrorStatus(t *testing.T) {
+	rateLimiter, err := NewRelayRateLimiter([]RateLimitConfig{{KeyType: RateLimitByConsumer, Key: RateLimitAnyKey, Rps: 1}})
+	require.NoError(t, err)
+	require.NoError(t, rateLimiter.Allow("consumer", "", "", 1))
+	err = (&RPCProviderServer{}).handleRelayErrorStatus(rateLimiter.Allow("consumer", "", "", 1))
+	require.True(t, lavasession.IsRateLimited(err))
+	require.False(t, lavasession.IsSessionSyncLoss(err))
+}
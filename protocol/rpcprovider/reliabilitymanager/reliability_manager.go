@@ -12,6 +12,7 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
 	"github.com/lavanet/lava/protocol/chaintracker"
+	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	"github.com/lavanet/lava/utils/sigs"
@@ -105,22 +106,33 @@ func (rm *ReliabilityManager) VoteHandler(voteParams *VoteParams, nodeHeight uin
 		// we need to send a commit, first we need to use the chainProxy and get the response
 		// TODO: implement code that verified the requested block is finalized and if its not waits and tries again
 		ctx := context.Background()
-		chainMessage, err := rm.chainParser.ParseMsg(voteParams.ApiURL, voteParams.RequestData, voteParams.ConnectionType, nil, extensionslib.ExtensionInfo{LatestBlock: 0}) // TODO: do we have the latest block?, also we need to add extensions to vote params to report the right extension
-		if err != nil {
-			return utils.LavaFormatError("vote Request did not pass the api check on chain proxy", err,
-				utils.Attribute{Key: "voteID", Value: voteID}, utils.Attribute{Key: "chainID", Value: voteParams.ChainID})
-		}
-		// TODO: get extensions and addons from the request
-		reply, _, _, _, _, err := rm.chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
-		if err != nil {
-			return utils.LavaFormatError("vote relay send has failed", err,
-				utils.Attribute{Key: "ApiURL", Value: voteParams.ApiURL}, utils.Attribute{Key: "RequestData", Value: voteParams.RequestData})
+		var replyDataHash []byte
+		if voteParams.FinalizationVote {
+			// the providers signed different hashes for the requested block, vote with the hash our node has for it
+			blockHash, err := rm.fetchBlockHash(ctx, voteParams)
+			if err != nil {
+				return utils.LavaFormatError("vote failed fetching the block hash", err,
+					utils.Attribute{Key: "voteID", Value: voteID}, utils.Attribute{Key: "chainID", Value: voteParams.ChainID}, utils.Attribute{Key: "requestBlock", Value: voteParams.RequestBlock})
+			}
+			replyDataHash = conflicttypes.FinalizedBlockHashVoteData(blockHash)
+		} else {
+			chainMessage, err := rm.chainParser.ParseMsg(voteParams.ApiURL, voteParams.RequestData, voteParams.ConnectionType, nil, extensionslib.ExtensionInfo{LatestBlock: 0}) // TODO: do we have the latest block?, also we need to add extensions to vote params to report the right extension
+			if err != nil {
+				return utils.LavaFormatError("vote Request did not pass the api check on chain proxy", err,
+					utils.Attribute{Key: "voteID", Value: voteID}, utils.Attribute{Key: "chainID", Value: voteParams.ChainID})
+			}
+			// TODO: get extensions and addons from the request
+			reply, _, _, _, _, err := rm.chainRouter.SendNodeMsg(ctx, nil, chainMessage, nil)
+			if err != nil {
+				return utils.LavaFormatError("vote relay send has failed", err,
+					utils.Attribute{Key: "ApiURL", Value: voteParams.ApiURL}, utils.Attribute{Key: "RequestData", Value: voteParams.RequestData})
+			}
+			reply.Metadata, _, _ = rm.chainParser.HandleHeaders(reply.Metadata, chainMessage.GetApiCollection(), spectypes.Header_pass_reply)
+			relayData := BuildRelayDataFromVoteParams(voteParams)
+			relayExchange := pairingtypes.NewRelayExchange(pairingtypes.RelayRequest{RelayData: relayData}, *reply)
+			replyDataHash = sigs.HashMsg(relayExchange.DataToSign())
 		}
-		reply.Metadata, _, _ = rm.chainParser.HandleHeaders(reply.Metadata, chainMessage.GetApiCollection(), spectypes.Header_pass_reply)
 		nonce := rand.Int63()
-		relayData := BuildRelayDataFromVoteParams(voteParams)
-		relayExchange := pairingtypes.NewRelayExchange(pairingtypes.RelayRequest{RelayData: relayData}, *reply)
-		replyDataHash := sigs.HashMsg(relayExchange.DataToSign())
 		commitHash := conflicttypes.CommitVoteData(nonce, replyDataHash, rm.publicAddress)
 
 		vote = &VoteData{RelayDataHash: replyDataHash, Nonce: nonce, CommitHash: commitHash}
@@ -131,6 +143,22 @@ func (rm *ReliabilityManager) VoteHandler(voteParams *VoteParams, nodeHeight uin
 	}
 }
 
+// fetchBlockHash returns the hash of the vote's block from the chain tracker, or from the node when it's no longer tracked
+func (rm *ReliabilityManager) fetchBlockHash(ctx context.Context, voteParams *VoteParams) (string, error) {
+	if rm.chainTracker != nil {
+		_, requestedHashes, _, err := rm.chainTracker.GetLatestBlockData(spectypes.NOT_APPLICABLE, spectypes.NOT_APPLICABLE, int64(voteParams.RequestBlock))
+		if err == nil && len(requestedHashes) == 1 {
+			return requestedHashes[0].Hash, nil
+		}
+	}
+	chainFetcher := chainlib.NewChainFetcher(ctx, &chainlib.ChainFetcherOptions{
+		ChainRouter: rm.chainRouter,
+		ChainParser: rm.chainParser,
+		Endpoint:    &lavasession.RPCProviderEndpoint{ChainID: voteParams.ChainID, ApiInterface: voteParams.ApiInterface},
+	})
+	return chainFetcher.FetchBlockHashByNum(ctx, int64(voteParams.RequestBlock))
+}
+
 func (rm *ReliabilityManager) GetLatestBlockData(fromBlock, toBlock, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, changeTime time.Time, err error) {
 	return rm.chainTracker.GetLatestBlockData(fromBlock, toBlock, specificBlock)
 }
@@ -171,6 +199,8 @@ type VoteParams struct {
 	VoteID         string
 	ParamsType     uint
 	Metadata       []pairingtypes.Metadata
+	// a finalization conflict vote is on the hash of RequestBlock instead of a relay response
+	FinalizationVote bool
 }
 
 func (vp *VoteParams) GetCloseVote() bool {
@@ -267,18 +297,26 @@ func BuildVoteParamsFromDetectionEvent(event terderminttypes.Event) (*VoteParams
 		return nil, utils.LavaFormatError("failed building BuildVoteParamsFromRevealEvent", nil, utils.Attribute{Key: "attributes", Value: attributes})
 	}
 	voters := strings.Split(voters_st, ",")
+	finalizationVote := false
+	if finalizationVote_str, ok := attributes["finalizationVote"]; ok {
+		finalizationVote, err = strconv.ParseBool(finalizationVote_str)
+		if err != nil {
+			return nil, utils.LavaFormatError("vote finalization flag could not be parsed", err, utils.Attribute{Key: "finalizationVote", Value: finalizationVote_str}, utils.Attribute{Key: "voteID", Value: voteID})
+		}
+	}
 	voteParams := &VoteParams{
-		ChainID:        chainID,
-		ApiURL:         apiURL,
-		RequestData:    requestData,
-		RequestBlock:   requestBlock,
-		Voters:         voters,
-		CloseVote:      false,
-		ConnectionType: connectionType,
-		ApiInterface:   apiInterface,
-		VoteDeadline:   voteDeadline,
-		VoteID:         voteID,
-		ParamsType:     DetectionVoteType,
+		ChainID:          chainID,
+		ApiURL:           apiURL,
+		RequestData:      requestData,
+		RequestBlock:     requestBlock,
+		Voters:           voters,
+		CloseVote:        false,
+		ConnectionType:   connectionType,
+		ApiInterface:     apiInterface,
+		VoteDeadline:     voteDeadline,
+		VoteID:           voteID,
+		ParamsType:       DetectionVoteType,
+		FinalizationVote: finalizationVote,
 	}
 	return voteParams, nil
 }
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	terderminttypes "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/chaintracker"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavaprotocol"
 	"github.com/lavanet/lava/protocol/lavasession"
@@ -19,6 +21,7 @@ import (
 	"github.com/lavanet/lava/protocol/statetracker"
 	testkeeper "github.com/lavanet/lava/testutil/keeper"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
 	"github.com/lavanet/lava/utils/sigs"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
@@ -95,7 +98,7 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ctx, reply, relay, provider_address.String())
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+		_, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
 		require.NoError(t, err)
 
 		relayResult := &common.RelayResult{
@@ -128,7 +131,7 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ctx, replyDR, relayDR, providerDR_address.String())
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), consumer_address, int64(0), 0)
+		_, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), consumer_address, int64(0), 0)
 		require.NoError(t, err)
 		relayResultDR := &common.RelayResult{
 			Request:      relayDR,
@@ -252,7 +255,7 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ts.Ctx, reply, relay, provider_address.String())
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+		_, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
 		require.NoError(t, err)
 
 		relayResult := &common.RelayResult{
@@ -290,7 +293,7 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		require.NoError(t, err)
 		err = lavaprotocol.VerifyRelayReply(ts.Ctx, replyDR, relayDR, providerDR_address.String())
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), consumer_address, int64(0), 0)
+		_, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), consumer_address, int64(0), 0)
 		require.NoError(t, err)
 		relayResultDR := &common.RelayResult{
 			Request:      relayDR,
@@ -384,3 +387,44 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		}())
 	})
 }
+
+type blockHashChainTracker struct {
+	hashes map[int64]string
+}
+
+func (bct blockHashChainTracker) GetLatestBlockData(fromBlock int64, toBlock int64, specificBlock int64) (latestBlock int64, requestedHashes []*chaintracker.BlockStore, changeTime time.Time, err error) {
+	hash, ok := bct.hashes[specificBlock]
+	if !ok {
+		return 0, nil, time.Time{}, fmt.Errorf("block %d is not tracked", specificBlock)
+	}
+	return specificBlock, []*chaintracker.BlockStore{{Block: specificBlock, Hash: hash}}, time.Now(), nil
+}
+
+func (bct blockHashChainTracker) GetLatestBlockNum() (int64, time.Time) {
+	return 0, time.Now()
+}
+
+func TestFinalizationVoteCommitsBlockHash(t *testing.T) {
+	rand.InitRandomSeed()
+	event := terderminttypes.Event{Type: utils.EventPrefix + conflicttypes.ConflictVoteDetectionEventName}
+	for key, value := range map[string]string{
+		"voteID": "vote", "chainID": "LAV1", "apiURL": "", "requestData": "", "connectionType": "", "apiInterface": "", "metadata": "[]",
+		"requestBlock": "100", "voteDeadline": "200", "voters": "voter", "finalizationVote": "true",
+	} {
+		event.Attributes = append(event.Attributes, terderminttypes.EventAttribute{Key: key, Value: value})
+	}
+	voteParams, err := reliabilitymanager.BuildVoteParamsFromDetectionEvent(event)
+	require.NoError(t, err)
+	require.True(t, voteParams.FinalizationVote)
+
+	var committed *reliabilitymanager.VoteData
+	txSender := mockTx{callbackCommit: func(voteID string, vote *reliabilitymanager.VoteData) { committed = vote }}
+	chainTracker := blockHashChainTracker{hashes: map[int64]string{100: "0xhash"}}
+	reliabilityManager := reliabilitymanager.NewReliabilityManager(chainTracker, txSender, "voter", nil, nil)
+	require.NoError(t, reliabilityManager.VoteHandler(voteParams, 1))
+
+	// the vote is on the hash of the block, not on a relay response
+	require.NotNil(t, committed)
+	require.Equal(t, conflicttypes.FinalizedBlockHashVoteData("0xhash"), committed.RelayDataHash)
+	require.Equal(t, conflicttypes.CommitVoteData(committed.Nonce, committed.RelayDataHash, "voter"), committed.CommitHash)
+}
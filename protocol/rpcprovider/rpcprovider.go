@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fsnotify/fsnotify"
 	"github.com/lavanet/lava/app"
 	"github.com/lavanet/lava/protocol/chainlib"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
@@ -96,6 +97,8 @@ type rpcProviderStartOptions struct {
 	clientCtx                 client.Context
 	rpcProviderEndpoints      []*lavasession.RPCProviderEndpoint
 	cache                     *performance.Cache
+	embeddedCache             *performance.EmbeddedCache
+	rateLimiter               *RelayRateLimiter
 	parallelConnections       uint
 	metricsListenAddress      string
 	rewardStoragePath         string
@@ -119,6 +122,8 @@ type RPCProvider struct {
 	chainMutexes           map[string]*sync.Mutex
 	parallelConnections    uint
 	cache                  *performance.Cache
+	embeddedCache          *performance.EmbeddedCache
+	rateLimiter            *RelayRateLimiter
 	shardID                uint // shardID is a flag that allows setting up multiple provider databases of the same chain
 	chainTrackers          *ChainTrackers
 }
@@ -134,6 +139,8 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	rpcp.chainTrackers = &ChainTrackers{}
 	rpcp.parallelConnections = options.parallelConnections
 	rpcp.cache = options.cache
+	rpcp.embeddedCache = options.embeddedCache
+	rpcp.rateLimiter = options.rateLimiter
 	rpcp.providerMetricsManager = metrics.NewProviderMetricsManager(options.metricsListenAddress) // start up prometheus metrics
 	rpcp.providerMetricsManager.SetVersion(upgrade.GetCurrentVersion().ProviderVersion)
 	rpcp.rpcProviderListeners = make(map[string]*ProviderListener)
@@ -385,6 +392,13 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 				NewLatestCallback:   recordMetricsOnNewBlock,
 				ConsistencyCallback: consistencyErrorCallback,
 				Pmetrics:            rpcp.providerMetricsManager,
+				SubscribeToHeads:    chaintracker.SubscribeHeads,
+			}
+			if rpcp.embeddedCache.CacheActive() {
+				// blocks within the saved hashes can change on a fork, entries from the oldest of them are dropped
+				chainTrackerConfig.ForkCallback = func(latestBlock int64) {
+					rpcp.embeddedCache.EvictFromBlock(chainID, latestBlock-int64(blocksToSaveChainTracker))
+				}
 			}
 
 			chainTracker, err = chaintracker.NewChainTracker(ctx, chainFetcher, chainTrackerConfig)
@@ -420,7 +434,7 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	rpcp.rewardServer.AddDataBase(rpcProviderEndpoint.ChainID, rpcp.addr.String(), rpcp.shardID)
 
 	rpcProviderServer := &RPCProviderServer{}
-	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics)
+	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, rpcp.embeddedCache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, rpcp.rateLimiter)
 	// set up grpc listener
 	var listener *ProviderListener
 	func() {
@@ -554,6 +568,29 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 					}
 				}
 			}
+			rateLimits, err := ParseRateLimits(viper.GetViper())
+			if err != nil {
+				return utils.LavaFormatError("invalid rate limits definition", err)
+			}
+			rateLimiter, err := NewRelayRateLimiter(rateLimits)
+			if err != nil {
+				return utils.LavaFormatError("failed creating rate limiter", err)
+			}
+			if len(args) <= 1 {
+				// rate limits are reloaded when the config file changes, the rest of the config requires a restart
+				viper.OnConfigChange(func(event fsnotify.Event) {
+					rateLimits, err := ParseRateLimits(viper.GetViper())
+					if err == nil {
+						err = rateLimiter.UpdateLimits(rateLimits)
+					}
+					if err != nil {
+						utils.LavaFormatError("failed reloading rate limits, keeping the previous limits", err, utils.Attribute{Key: "config", Value: event.Name})
+						return
+					}
+					utils.LavaFormatInfo("reloaded rate limits", utils.Attribute{Key: "config", Value: event.Name}, utils.Attribute{Key: "rateLimits", Value: rateLimits})
+				})
+				viper.WatchConfig()
+			}
 			// handle flags, pass necessary fields
 			ctx := context.Background()
 
@@ -612,6 +649,10 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 					utils.LavaFormatInfo("cache service connected", utils.Attribute{Key: "address", Value: cacheAddr})
 				}
 			}
+			embeddedCache, err := performance.NewEmbeddedCache(viper.GetUint64(performance.EmbeddedCacheSizeFlagName))
+			if err != nil {
+				utils.LavaFormatFatal("failed creating embedded cache", err, utils.Attribute{Key: "sizeMB", Value: viper.GetUint64(performance.EmbeddedCacheSizeFlagName)})
+			}
 			numberOfNodeParallelConnections, err := cmd.Flags().GetUint(chainproxy.ParallelConnectionsFlag)
 			if err != nil {
 				utils.LavaFormatFatal("error fetching chainproxy.ParallelConnectionsFlag", err)
@@ -637,6 +678,8 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 					clientCtx,
 					rpcProviderEndpoints,
 					cache,
+					embeddedCache,
+					rateLimiter,
 					numberOfNodeParallelConnections,
 					prometheusListenAddr,
 					rewardStoragePath,
@@ -656,7 +699,8 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Uint64(common.GeolocationFlag, 0, "geolocation to run from")
 	cmdRPCProvider.MarkFlagRequired(common.GeolocationFlag)
 	cmdRPCProvider.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
-	cmdRPCProvider.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdRPCProvider.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance, a comma separated list shards the entries between the cache servers")
+	cmdRPCProvider.Flags().Uint64(performance.EmbeddedCacheSizeFlagName, 0, "size in MB of an in process cache of finalized responses, checked before the cache server. 0 disables it")
 	cmdRPCProvider.Flags().Uint(chainproxy.ParallelConnectionsFlag, chainproxy.NumberOfParallelConnections, "parallel connections")
 	cmdRPCProvider.Flags().String(flags.FlagLogLevel, "debug", "log level")
 	cmdRPCProvider.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
@@ -667,6 +711,7 @@ rpcprovider 127.0.0.1:3333 COS3 tendermintrpc "wss://www.node-path.com:80,https:
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotTimeoutSecFlagName, rewardserver.DefaultRewardsSnapshotTimeoutSec, "the seconds to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().String(StickinessHeaderName, RPCProviderStickinessHeaderName, "the name of the header to be attacked to requests for stickiness by consumer, used for consistency")
 	cmdRPCProvider.Flags().Uint64Var(&chaintracker.PollingMultiplier, chaintracker.PollingMultiplierFlagName, 1, "when set, forces the chain tracker to poll more often, improving the sync at the cost of more queries")
+	cmdRPCProvider.Flags().BoolVar(&chaintracker.SubscribeHeads, chaintracker.SubscribeHeadsFlagName, false, "when set, the chain tracker subscribes to new heads over the node websocket and falls back to polling if the subscription drops")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationInterval, SpecValidationIntervalFlagName, SpecValidationInterval, "determines the interval of which to run validation on the spec for all connected chains")
 	cmdRPCProvider.Flags().DurationVar(&SpecValidationIntervalDisabledChains, SpecValidationIntervalDisabledChainsFlagName, SpecValidationIntervalDisabledChains, "determines the interval of which to run validation on the spec for all disabled chains, determines recovery time")
 
@@ -56,6 +56,8 @@ type RPCProviderServer struct {
 	lavaChainID               string
 	allowedMissingCUThreshold float64
 	metrics                   *metrics.ProviderMetrics
+	embeddedCache             *performance.EmbeddedCache
+	rateLimiter               *RelayRateLimiter
 }
 
 type ReliabilityManagerInf interface {
@@ -84,14 +86,17 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	reliabilityManager ReliabilityManagerInf,
 	privKey *btcec.PrivateKey,
 	cache *performance.Cache,
+	embeddedCache *performance.EmbeddedCache,
 	chainRouter chainlib.ChainRouter,
 	stateTracker StateTrackerInf,
 	providerAddress sdk.AccAddress,
 	lavaChainID string,
 	allowedMissingCUThreshold float64,
 	providerMetrics *metrics.ProviderMetrics,
+	rateLimiter *RelayRateLimiter,
 ) {
 	rpcps.cache = cache
+	rpcps.embeddedCache = embeddedCache
 	rpcps.chainRouter = chainRouter
 	rpcps.privKey = privKey
 	rpcps.providerSessionManager = providerSessionManager
@@ -104,6 +109,7 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	rpcps.lavaChainID = lavaChainID
 	rpcps.allowedMissingCUThreshold = allowedMissingCUThreshold
 	rpcps.metrics = providerMetrics
+	rpcps.rateLimiter = rateLimiter
 }
 
 // function used to handle relay requests from a consumer, it is called by a provider_listener by calling RegisterReceiver
@@ -220,6 +226,15 @@ func (rpcps *RPCProviderServer) initRelay(ctx context.Context, request *pairingt
 		return nil, nil, nil, err
 	}
 	relayCU := chainMessage.GetApi().ComputeUnits
+	badgeUser := ""
+	if request.RelaySession.Badge != nil {
+		badgeUser = request.RelaySession.Badge.Address
+	}
+	// checked before the session is used so a rejected relay doesn't change the session state
+	err = rpcps.rateLimiter.Allow(consumerAddress.String(), relaySession.GetProjectId(), badgeUser, relayCU)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	virtualEpoch := rpcps.stateTracker.GetVirtualEpoch(uint64(request.RelaySession.Epoch))
 	err = relaySession.PrepareSessionForUsage(ctx, relayCU, request.RelaySession.CuSum, rpcps.allowedMissingCUThreshold, virtualEpoch)
 	if err != nil {
@@ -583,6 +598,8 @@ func (rpcps *RPCProviderServer) handleRelayErrorStatus(err error) error {
 		err = status.Error(codes.Code(lavasession.SessionOutOfSyncError.ABCICode()), err.Error())
 	} else if lavasession.EpochMismatchError.Is(err) {
 		err = status.Error(codes.Code(lavasession.EpochMismatchError.ABCICode()), err.Error())
+	} else if lavasession.RateLimitedError.Is(err) {
+		err = status.Error(codes.Code(lavasession.RateLimitedError.ABCICode()), err.Error())
 	}
 	return err
 }
@@ -660,7 +677,15 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 	var reply *pairingtypes.RelayReply = nil
 	var err error = nil
 	ignoredMetadata := []pairingtypes.Metadata{}
-	if requestedBlockHash != nil || finalized {
+	// the embedded cache only holds finalized data, so it can't serve a block that was reorged
+	embeddedCache := rpcps.embeddedCache
+	if finalized {
+		if cacheReply, found := embeddedCache.GetEntry(request.RelayData, requestedBlockHash, rpcps.rpcProviderEndpoint.ChainID); found {
+			reply = cacheReply.GetReply()
+			ignoredMetadata = cacheReply.GetOptionalMetadata()
+		}
+	}
+	if reply == nil && (requestedBlockHash != nil || finalized) {
 		var cacheReply *pairingtypes.CacheRelayReply
 		cacheReply, err = cache.GetEntry(ctx, request.RelayData, requestedBlockHash, rpcps.rpcProviderEndpoint.ChainID, finalized, rpcps.providerAddress.String())
 		reply = cacheReply.GetReply()
@@ -668,6 +693,9 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		if err != nil && performance.NotConnectedError.Is(err) {
 			utils.LavaFormatDebug("cache not connected", utils.LogAttr("err", err), utils.Attribute{Key: "GUID", Value: ctx})
 		}
+		if err == nil && reply != nil && finalized {
+			embeddedCache.SetEntry(request.RelayData, requestedBlockHash, rpcps.rpcProviderEndpoint.ChainID, reply, ignoredMetadata)
+		}
 	}
 	if err != nil || reply == nil {
 		// we need to send relay, cache miss or invalid
@@ -690,6 +718,10 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		}
 		reply.Metadata, _, ignoredMetadata = rpcps.chainParser.HandleHeaders(reply.Metadata, chainMsg.GetApiCollection(), spectypes.Header_pass_reply)
 		// TODO: use overwriteReqBlock on the reply metadata to set the correct latest block
+		if finalized {
+			// the entry is marshaled on set so the request and reply can change later on
+			embeddedCache.SetEntry(request.RelayData, requestedBlockHash, rpcps.rpcProviderEndpoint.ChainID, reply, ignoredMetadata)
+		}
 		if cache.CacheActive() && (requestedBlockHash != nil || finalized) {
 			// copy request and reply as they change later on and we call SetEntry in a routine.
 			copyPrivateData := &pairingtypes.RelayPrivateData{}
@@ -500,6 +500,39 @@ func (ts *Tester) TxSubscriptionAutoRenewal(creator, consumer, planIndex string,
 	return err
 }
 
+// TxSubscriptionDepositOveruse: implement 'tx subscription deposit-overuse'
+func (ts *Tester) TxSubscriptionDepositOveruse(creator, consumer string, deposit int64, cuCap uint64) error {
+	msg := &subscriptiontypes.MsgDepositOveruse{
+		Creator:  creator,
+		Consumer: consumer,
+		Deposit:  sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(deposit)),
+		CuCap:    cuCap,
+	}
+	_, err := ts.Servers.SubscriptionServer.DepositOveruse(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionTransfer: implement 'tx subscription transfer'
+func (ts *Tester) TxSubscriptionTransfer(creator, consumer, newConsumer string) error {
+	msg := subscriptiontypes.NewMsgTransfer(creator, consumer, newConsumer)
+	_, err := ts.Servers.SubscriptionServer.Transfer(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionCancel: implement 'tx subscription cancel'
+func (ts *Tester) TxSubscriptionCancel(creator, consumer string) error {
+	msg := subscriptiontypes.NewMsgCancel(creator, consumer)
+	_, err := ts.Servers.SubscriptionServer.Cancel(ts.GoCtx, msg)
+	return err
+}
+
+// TxSubscriptionUpgrade: implement 'tx subscription upgrade'
+func (ts *Tester) TxSubscriptionUpgrade(creator, consumer, plan string) error {
+	msg := subscriptiontypes.NewMsgUpgrade(creator, consumer, plan)
+	_, err := ts.Servers.SubscriptionServer.Upgrade(ts.GoCtx, msg)
+	return err
+}
+
 // TxProjectAddKeys: implement 'tx project add-keys'
 func (ts *Tester) TxProjectAddKeys(projectID, creator string, projectKeys ...projectstypes.ProjectKey) error {
 	msg := projectstypes.MsgAddKeys{
@@ -542,6 +575,13 @@ func (ts *Tester) TxProjectSetPolicy(projectID, subkey string, policy *planstype
 	return ts.Servers.ProjectServer.SetPolicy(ts.GoCtx, msg)
 }
 
+// TxProjectSetEnabled: implement 'tx project set-enabled'
+func (ts *Tester) TxProjectSetEnabled(projectID, creator string, enabled bool) error {
+	msg := projectstypes.NewMsgSetProjectEnabled(creator, projectID, enabled)
+	_, err := ts.Servers.ProjectServer.SetProjectEnabled(ts.GoCtx, msg)
+	return err
+}
+
 // TxPairingStakeProvider: implement 'tx pairing stake-provider'
 func (ts *Tester) TxPairingStakeProvider(
 	addr string,
@@ -609,6 +649,12 @@ func (ts *Tester) TxPairingUnfreezeProvider(addr, chainID string) (*pairingtypes
 	return ts.Servers.PairingServer.UnfreezeProvider(ts.GoCtx, msg)
 }
 
+// TxPairingBailProvider: implement 'tx pairing bail'
+func (ts *Tester) TxPairingBailProvider(addr, chainID string, bail sdk.Coin, validator string) (*pairingtypes.MsgBailProviderResponse, error) {
+	msg := pairingtypes.NewMsgBail(addr, chainID, bail, validator)
+	return ts.Servers.PairingServer.BailProvider(ts.GoCtx, msg)
+}
+
 // TxCreateValidator: implement 'tx staking createvalidator' and bond its tokens
 func (ts *Tester) TxCreateValidator(validator sigs.Account, amount math.Int) {
 	consensusPowerTokens := ts.Keepers.StakingKeeper.TokensFromConsensusPower(ts.Ctx, 1)
@@ -736,6 +782,23 @@ func (ts *Tester) QueryPairingProviders(chainID string, frozen bool) (*pairingty
 	return ts.Keepers.Pairing.Providers(ts.GoCtx, msg)
 }
 
+// QueryPairingJailedProviders: implement 'q pairing jailed-providers'
+func (ts *Tester) QueryPairingJailedProviders(chainID string) (*pairingtypes.QueryJailedProvidersResponse, error) {
+	msg := &pairingtypes.QueryJailedProvidersRequest{
+		ChainID: chainID,
+	}
+	return ts.Keepers.Pairing.JailedProviders(ts.GoCtx, msg)
+}
+
+// QueryPairingProvidersQos: implement 'q pairing providers-qos'
+func (ts *Tester) QueryPairingProvidersQos(chainID, provider string) (*pairingtypes.QueryProvidersQosResponse, error) {
+	msg := &pairingtypes.QueryProvidersQosRequest{
+		ChainID:  chainID,
+		Provider: provider,
+	}
+	return ts.Keepers.Pairing.ProvidersQos(ts.GoCtx, msg)
+}
+
 // QueryPairingVerifyPairing implements 'q pairing verfy-pairing'
 func (ts *Tester) QueryPairingVerifyPairing(chainID, client, provider string, block uint64) (*pairingtypes.QueryVerifyPairingResponse, error) {
 	msg := &pairingtypes.QueryVerifyPairingRequest{
@@ -2,16 +2,34 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
-func (k Keeper) ValidateFinalizationConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
-	return nil
+// FinalizationConflictBlock is the lowest block that both providers consider finalized and signed different hashes for
+type FinalizationConflictBlock struct {
+	EpochStart uint64
+	Block      int64
+	Hash0      string
+	Hash1      string
+}
+
+func (k Keeper) ValidateFinalizationConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (FinalizationConflictBlock, error) {
+	providerAddress0, providerAddress1, conflictBlock, err := k.validateFinalizationData(ctx, conflictData, clientAddr)
+	if err != nil {
+		return conflictBlock, err
+	}
+	if providerAddress0.Equals(providerAddress1) {
+		return conflictBlock, fmt.Errorf("finalization conflict with the same provider %s, use same provider conflict instead", providerAddress0)
+	}
+	return conflictBlock, nil
 }
 
 func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.ResponseConflict, clientAddr sdk.AccAddress) error {
@@ -165,6 +183,145 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 	return nil
 }
 
-func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
-	return nil
+func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (FinalizationConflictBlock, error) {
+	providerAddress0, providerAddress1, conflictBlock, err := k.validateFinalizationData(ctx, conflictData, clientAddr)
+	if err != nil {
+		return conflictBlock, err
+	}
+	if !providerAddress0.Equals(providerAddress1) {
+		return conflictBlock, fmt.Errorf("mismatching providers in same provider conflict %s, %s", providerAddress0, providerAddress1)
+	}
+	return conflictBlock, nil
+}
+
+// validateFinalizationData verifies that both replies were signed by providers that were paired
+// with the client in the conflict's epoch, and that they report different hashes for a block
+// that both of them consider finalized. It returns the providers that signed the replies and the conflicting block
+func (k Keeper) validateFinalizationData(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (providerAddress0, providerAddress1 sdk.AccAddress, conflictBlock FinalizationConflictBlock, err error) {
+	if conflictData.RelayReply0 == nil || conflictData.RelayReply1 == nil || conflictData.RelaySession0 == nil || conflictData.RelaySession1 == nil {
+		return nil, nil, conflictBlock, fmt.Errorf("finalization conflict must contain both relay replies and their relay sessions")
+	}
+
+	// 1. validate mismatching data
+	chainID := conflictData.RelaySession0.SpecId
+	if chainID != conflictData.RelaySession1.SpecId {
+		return nil, nil, conflictBlock, fmt.Errorf("mismatching request parameters between providers %s, %s", chainID, conflictData.RelaySession1.SpecId)
+	}
+
+	// 2. validate both replies are in the same epoch and within the allowed span
+	epochStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(conflictData.RelaySession0.Epoch))
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("could not find epoch for block %d", conflictData.RelaySession0.Epoch)
+	}
+	epochStart1, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(conflictData.RelaySession1.Epoch))
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("could not find epoch for block %d", conflictData.RelaySession1.Epoch)
+	}
+	if epochStart != epochStart1 {
+		return nil, nil, conflictBlock, fmt.Errorf("mismatching epochs between relay sessions %d, %d", epochStart, epochStart1)
+	}
+
+	epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, epochStart)
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("could not get EpochBlocks param")
+	}
+	span := k.VoteStartSpan(ctx) * epochBlocks
+	if uint64(ctx.BlockHeight())-epochStart >= span {
+		return nil, nil, conflictBlock, fmt.Errorf("conflict was received outside of the allowed span, current: %d, span %d - %d", ctx.BlockHeight(), epochStart, epochStart+span)
+	}
+
+	_, _, err = k.pairingKeeper.VerifyPairingData(ctx, chainID, epochStart)
+	if err != nil {
+		return nil, nil, conflictBlock, err
+	}
+
+	_, err = k.pairingKeeper.GetProjectData(ctx, clientAddr, chainID, epochStart)
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("did not find a project for %s on epoch %d, chainID %s error: %s", clientAddr, epochStart, chainID, err.Error())
+	}
+
+	// 3. validate the client signed both relay sessions
+	verifyClientAddrFromSignatureOnSession := func(relaySession *pairingtypes.RelaySession) error {
+		pubKey, err := sigs.RecoverPubKey(*relaySession)
+		if err != nil {
+			return fmt.Errorf("invalid consumer signature in relay session %+v , error: %s", relaySession, err.Error())
+		}
+		derived_clientAddr, err := sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+		if err != nil {
+			return fmt.Errorf("invalid consumer address from signature in relay session %+v , error: %s", relaySession, err.Error())
+		}
+		if !derived_clientAddr.Equals(clientAddr) {
+			return fmt.Errorf("mismatching consumer address signature and msg.Creator in relay session %s , %s", derived_clientAddr, clientAddr)
+		}
+		return nil
+	}
+	err = verifyClientAddrFromSignatureOnSession(conflictData.RelaySession0)
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("relay session 0: %s", err)
+	}
+	err = verifyClientAddrFromSignatureOnSession(conflictData.RelaySession1)
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("relay session 1: %s", err)
+	}
+
+	// 4. validate providers finalization signatures and stakeEntry for that epoch
+	providerAddressFromFinalizationAndVerifyStakeEntry := func(relaySession *pairingtypes.RelaySession, reply *pairingtypes.RelayReply, first bool) (providerAddress sdk.AccAddress, err error) {
+		print_st := "first"
+		if !first {
+			print_st = "second"
+		}
+		relayFinalization := pairingtypes.NewRelayFinalization(pairingtypes.NewRelayExchange(pairingtypes.RelayRequest{RelaySession: relaySession}, *reply), clientAddr)
+		pubKey, err := sigs.RecoverPubKey(relayFinalization)
+		if err != nil {
+			return nil, fmt.Errorf("RecoverPubKey %s provider RelayFinalization: %w", print_st, err)
+		}
+		providerAddress, err = sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+		if err != nil {
+			return nil, fmt.Errorf("AccAddressFromHex %s provider: %w", print_st, err)
+		}
+		if providerAddress.String() != relaySession.Provider {
+			return nil, fmt.Errorf("mismatching %s provider address signature and relay session provider %s , %s", print_st, providerAddress, relaySession.Provider)
+		}
+		_, err = k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddress, epochStart)
+		if err != nil {
+			return nil, fmt.Errorf("did not find a stake entry for %s provider %s on epoch %d, chainID %s error: %s", print_st, providerAddress, epochStart, chainID, err.Error())
+		}
+		return providerAddress, nil
+	}
+	providerAddress0, err = providerAddressFromFinalizationAndVerifyStakeEntry(conflictData.RelaySession0, conflictData.RelayReply0, true)
+	if err != nil {
+		return nil, nil, conflictBlock, err
+	}
+	providerAddress1, err = providerAddressFromFinalizationAndVerifyStakeEntry(conflictData.RelaySession1, conflictData.RelayReply1, false)
+	if err != nil {
+		return nil, nil, conflictBlock, err
+	}
+
+	// 5. validate mismatching hashes on a block that both replies consider finalized
+	finalizedBlocks0 := map[int64]string{}
+	err = json.Unmarshal(conflictData.RelayReply0.FinalizedBlocksHashes, &finalizedBlocks0)
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("failed unmarshalling finalized blocks hashes of first provider: %w", err)
+	}
+	finalizedBlocks1 := map[int64]string{}
+	err = json.Unmarshal(conflictData.RelayReply1.FinalizedBlocksHashes, &finalizedBlocks1)
+	if err != nil {
+		return nil, nil, conflictBlock, fmt.Errorf("failed unmarshalling finalized blocks hashes of second provider: %w", err)
+	}
+	// blocks are checked in order so every validator picks the same conflicting block
+	blocks := maps.Keys(finalizedBlocks0)
+	slices.Sort(blocks)
+	for _, block := range blocks {
+		hash0 := finalizedBlocks0[block]
+		hash1, ok := finalizedBlocks1[block]
+		if !ok || hash0 == hash1 {
+			continue
+		}
+		if k.specKeeper.IsFinalizedBlock(ctx, chainID, block, conflictData.RelayReply0.LatestBlock) &&
+			k.specKeeper.IsFinalizedBlock(ctx, chainID, block, conflictData.RelayReply1.LatestBlock) {
+			conflictBlock = FinalizationConflictBlock{EpochStart: epochStart, Block: block, Hash0: hash0, Hash1: hash1}
+			return providerAddress0, providerAddress1, conflictBlock, nil
+		}
+	}
+	return nil, nil, conflictBlock, fmt.Errorf("no conflict between providers finalized blocks hashes")
 }
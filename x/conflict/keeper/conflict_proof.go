@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/conflict/types"
+)
+
+// SetConflictProof marks a finalization proof as reported, it's kept while proofs from its epoch are accepted
+func (k Keeper) SetConflictProof(ctx sdk.Context, key []byte, epochStart uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictProofKeyPrefix))
+	store.Set(key, sdk.Uint64ToBigEndian(epochStart))
+}
+
+// IsConflictProofReported returns whether a finalization proof with this key was already reported
+func (k Keeper) IsConflictProofReported(ctx sdk.Context, key []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictProofKeyPrefix))
+	return store.Has(key)
+}
+
+// RemoveExpiredConflictProofs removes the proofs of epochs that are out of the vote start span, they can't be reported anymore
+func (k Keeper) RemoveExpiredConflictProofs(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConflictProofKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		epochStart := sdk.BigEndianToUint64(iterator.Value())
+		epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, epochStart)
+		if err != nil {
+			continue
+		}
+		if uint64(ctx.BlockHeight())-epochStart >= k.VoteStartSpan(ctx)*epochBlocks {
+			expired = append(expired, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
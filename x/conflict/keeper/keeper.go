@@ -76,4 +76,7 @@ func (k Keeper) PushFixations(ctx sdk.Context) {
 
 func (k Keeper) BeginBlock(ctx sdk.Context) {
 	k.CheckAndHandleAllVotes(ctx)
+	if k.IsEpochStart(ctx) {
+		k.RemoveExpiredConflictProofs(ctx)
+	}
 }
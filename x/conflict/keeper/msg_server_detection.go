@@ -3,11 +3,13 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/x/conflict/types"
 	pairingfilters "github.com/lavanet/lava/x/pairing/keeper/filters"
 	"golang.org/x/exp/slices"
@@ -17,6 +19,11 @@ func DetectionIndex(msg *types.MsgDetection, epochStart uint64) string {
 	return msg.Creator + msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider + msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider + strconv.FormatUint(epochStart, 10)
 }
 
+// FinalizationDetectionIndex is the vote index of a finalization conflict, there is one vote per conflicting block
+func FinalizationDetectionIndex(creator, provider0, provider1 string, conflictBlock FinalizationConflictBlock) string {
+	return creator + provider0 + provider1 + strconv.FormatUint(conflictBlock.EpochStart, 10) + "/" + strconv.FormatInt(conflictBlock.Block, 10)
+}
+
 func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*types.MsgDetectionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := k.Keeper.Logger(ctx)
@@ -27,23 +34,116 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		)
 	}
 	if msg.FinalizationConflict != nil && msg.ResponseConflict == nil && msg.SameProviderConflict == nil {
-		err := k.Keeper.ValidateFinalizationConflict(ctx, msg.FinalizationConflict, clientAddr)
+		conflictBlock, err := k.Keeper.ValidateFinalizationConflict(ctx, msg.FinalizationConflict, clientAddr)
+		if err != nil {
+			return nil, utils.LavaFormatWarning("invalid finalization conflict detection", err,
+				utils.Attribute{Key: "client", Value: msg.Creator},
+			)
+		}
+
+		chainID := msg.FinalizationConflict.RelaySession0.SpecId
+		provider0 := msg.FinalizationConflict.RelaySession0.Provider
+		provider1 := msg.FinalizationConflict.RelaySession1.Provider
+		proofKey := types.ConflictProofKey(chainID, conflictBlock.Block, []string{provider0, provider1})
+		if k.Keeper.IsConflictProofReported(ctx, proofKey) {
+			return nil, utils.LavaFormatWarning("finalization conflict was already reported for these providers and block", fmt.Errorf("duplicate finalization conflict"),
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider0", Value: provider0},
+				utils.Attribute{Key: "provider1", Value: provider1},
+				utils.Attribute{Key: "block", Value: conflictBlock.Block},
+			)
+		}
+
+		// one of the providers signed a forked block hash as finalized, the jury votes on the hash of the block
+		conflictVote := types.ConflictVote{}
+		conflictVote.Index = FinalizationDetectionIndex(msg.Creator, provider0, provider1, conflictBlock)
+		if k.Keeper.AllocateNewConflictVote(ctx, conflictVote.Index) {
+			return nil, utils.LavaFormatWarning("conflict is already open for this client and providers in this epoch", fmt.Errorf("duplicate finalization conflict"),
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider0", Value: provider0},
+				utils.Attribute{Key: "provider1", Value: provider1},
+			)
+		}
+		conflictVote.VoteState = types.StateCommit
+		conflictVote.VoteStartBlock = uint64(msg.FinalizationConflict.RelaySession0.Epoch)
+		conflictVote.VoteDeadline, err = k.Keeper.voteDeadline(ctx)
 		if err != nil {
-			return nil, utils.LavaFormatWarning("Simulation: invalid finalization conflict detection", err,
+			return nil, utils.LavaFormatError("could not get the vote deadline", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider0", Value: provider0},
+				utils.Attribute{Key: "provider1", Value: provider1},
 			)
 		}
+		conflictVote.ClientAddress = msg.Creator
+		conflictVote.ChainID = chainID
+		conflictVote.RequestBlock = uint64(conflictBlock.Block)
+
+		// the reveal hashes the revealed data again before comparing it to the responses
+		conflictVote.FirstProvider.Account = provider0
+		conflictVote.FirstProvider.Response = sigs.HashMsg(types.FinalizedBlockHashVoteData(conflictBlock.Hash0))
+		conflictVote.SecondProvider.Account = provider1
+		conflictVote.SecondProvider.Response = sigs.HashMsg(types.FinalizedBlockHashVoteData(conflictBlock.Hash1))
+		conflictVote.Votes = []types.Vote{}
+		voters := k.Keeper.LotteryVoters(goCtx, conflictBlock.EpochStart, chainID, []string{provider0, provider1})
+		for _, voter := range voters {
+			conflictVote.Votes = append(conflictVote.Votes, types.Vote{Address: voter, Hash: []byte{}, Result: types.NoVote})
+		}
+
+		k.SetConflictVote(ctx, conflictVote)
+		k.Keeper.SetConflictProof(ctx, proofKey, conflictBlock.EpochStart)
+
+		eventData := map[string]string{"client": msg.Creator}
+		eventData["voteID"] = conflictVote.Index
+		eventData["chainID"] = conflictVote.ChainID
+		eventData["connectionType"] = ""
+		eventData["apiURL"] = ""
+		eventData["requestData"] = ""
+		eventData["requestBlock"] = strconv.FormatUint(conflictVote.RequestBlock, 10)
+		eventData["voteDeadline"] = strconv.FormatUint(conflictVote.VoteDeadline, 10)
+		eventData["voters"] = strings.Join(voters, ",")
+		eventData["apiInterface"] = ""
+		eventData["metadata"] = "[]"
+		eventData["finalizationVote"] = strconv.FormatBool(true)
+		eventData["provider0"] = provider0
+		eventData["provider1"] = provider1
+
+		utils.LogLavaEvent(ctx, logger, types.ConflictVoteDetectionEventName, eventData, "Got a new valid finalization conflict detection from consumer, starting new vote")
+		return &types.MsgDetectionResponse{}, nil
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict == nil && msg.SameProviderConflict != nil {
-		err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
+		conflictBlock, err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
 		if err != nil {
-			return nil, utils.LavaFormatWarning("Simulation: invalid same provider conflict detection", err,
+			return nil, utils.LavaFormatWarning("invalid same provider conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
+
+		chainID := msg.SameProviderConflict.RelaySession0.SpecId
+		provider := msg.SameProviderConflict.RelaySession0.Provider
+		proofKey := types.ConflictProofKey(chainID, conflictBlock.Block, []string{provider})
+		if k.Keeper.IsConflictProofReported(ctx, proofKey) {
+			return nil, utils.LavaFormatWarning("same provider conflict was already reported for this provider and block", fmt.Errorf("duplicate same provider conflict"),
+				utils.Attribute{Key: "client", Value: msg.Creator},
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "block", Value: conflictBlock.Block},
+			)
+		}
+		k.Keeper.SetConflictProof(ctx, proofKey, conflictBlock.EpochStart)
+
+		// the provider contradicted itself, jail and slash it
+		punished, slashed := k.Keeper.PunishFinalizationConflict(ctx, chainID, []sdk.AccAddress{sdk.MustAccAddressFromBech32(provider)})
+
+		eventData := map[string]string{"client": msg.Creator}
+		eventData["chainID"] = chainID
+		eventData["provider"] = provider
+		eventData["block"] = strconv.FormatInt(conflictBlock.Block, 10)
+		eventData["jailed"] = strings.Join(punished, ",")
+		eventData["slashed"] = slashed.String()
+		utils.LogLavaEvent(ctx, logger, types.SameProviderConflictEventName, eventData, "Got a new valid same provider conflict detection from consumer")
+		return &types.MsgDetectionResponse{}, nil
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict != nil && msg.SameProviderConflict == nil {
 		err := k.Keeper.ValidateResponseConflict(ctx, msg.ResponseConflict, clientAddr)
 		if err != nil {
-			return nil, utils.LavaFormatWarning("Simulation: invalid response conflict detection", err,
+			return nil, utils.LavaFormatWarning("invalid response conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
@@ -75,18 +175,9 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		conflictVote.Index = index
 		conflictVote.VoteState = types.StateCommit
 		conflictVote.VoteStartBlock = uint64(msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Epoch)
-		epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+		voteDeadline, err := k.Keeper.voteDeadline(ctx)
 		if err != nil {
-			return nil, utils.LavaFormatError("Simulation: could not get epochblocks", err,
-				utils.Attribute{Key: "client", Value: msg.Creator},
-				utils.Attribute{Key: "provider0", Value: msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider},
-				utils.Attribute{Key: "provider1", Value: msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider},
-			)
-		}
-
-		voteDeadline, err := k.Keeper.epochstorageKeeper.GetNextEpoch(ctx, uint64(ctx.BlockHeight())+k.VotePeriod(ctx)*epochBlocks)
-		if err != nil {
-			return nil, utils.LavaFormatError("Simulation: could not get NextEpoch", err,
+			return nil, utils.LavaFormatError("Simulation: could not get the vote deadline", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 				utils.Attribute{Key: "provider0", Value: msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider},
 				utils.Attribute{Key: "provider1", Value: msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider},
@@ -140,6 +231,15 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 	return &types.MsgDetectionResponse{}, nil
 }
 
+// voteDeadline returns the end of the commit period of a vote that starts now
+func (k Keeper) voteDeadline(ctx sdk.Context) (uint64, error) {
+	epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return 0, err
+	}
+	return k.epochstorageKeeper.GetNextEpoch(ctx, uint64(ctx.BlockHeight())+k.VotePeriod(ctx)*epochBlocks)
+}
+
 func (k Keeper) LotteryVoters(goCtx context.Context, epoch uint64, chainID string, exemptions []string) []string {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	entries, err := k.epochstorageKeeper.GetStakeEntryForAllProvidersEpoch(ctx, chainID, epoch)
@@ -1,12 +1,14 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/rand"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/utils/slices"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
@@ -184,3 +186,189 @@ func TestFrozenProviderDetection(t *testing.T) {
 	// the frozen provider should not be part of the voters list
 	require.False(t, slices.Contains(votersList, frozenProvider))
 }
+
+// finalizationRelay creates a consumer signed relay session and a provider signed reply with the given finalized hashes
+func (ts *tester) finalizationRelay(provider sigs.Account, sessionID uint64, latestBlock int64, hashes map[int64]string) (*types.RelaySession, *types.RelayReply) {
+	relaySession := &types.RelaySession{
+		Provider:  provider.Addr.String(),
+		SessionId: sessionID,
+		SpecId:    ts.spec.Index,
+		Epoch:     ts.Ctx.BlockHeight(),
+		RelayNum:  1,
+	}
+	sig, err := sigs.Sign(ts.consumer.SK, *relaySession)
+	require.NoError(ts.T, err)
+	relaySession.Sig = sig
+
+	hashesBytes, err := json.Marshal(hashes)
+	require.NoError(ts.T, err)
+	reply := &types.RelayReply{
+		Data:                  []byte("DUMMYREPLY"),
+		LatestBlock:           latestBlock,
+		FinalizedBlocksHashes: hashesBytes,
+	}
+	relayFinalization := types.NewRelayFinalization(types.NewRelayExchange(types.RelayRequest{RelaySession: relaySession}, *reply), ts.consumer.Addr)
+	sigBlocks, err := sigs.Sign(provider.SK, relayFinalization)
+	require.NoError(ts.T, err)
+	reply.SigBlocks = sigBlocks
+	return relaySession, reply
+}
+
+func TestFinalizationConflictDetection(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForConflict(ProvidersCount)
+
+	hashes := map[int64]string{98: "a", 99: "b", 100: "c"}
+	forkedHashes := map[int64]string{98: "a", 99: "b", 100: "forked"}
+
+	tests := []struct {
+		name         string
+		provider1    sigs.Account
+		latestBlock1 int64
+		hashes1      map[int64]string
+		corruptSig   bool
+		valid        bool
+	}{
+		{"HappyFlow", ts.providers[1], 100, forkedHashes, false, true},
+		{"SameHashes", ts.providers[2], 100, hashes, false, false},
+		{"NotFinalized", ts.providers[2], 99, forkedHashes, false, false},
+		{"BadSignature", ts.providers[2], 100, forkedHashes, true, false},
+		{"SameProvider", ts.providers[0], 100, forkedHashes, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session0, reply0 := ts.finalizationRelay(ts.providers[0], 1, 100, hashes)
+			session1, reply1 := ts.finalizationRelay(tt.provider1, 2, tt.latestBlock1, tt.hashes1)
+			if tt.corruptSig {
+				reply1.LatestBlock++
+			}
+			msg := conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), &conflicttypes.FinalizationConflict{
+				RelayReply0:   reply0,
+				RelayReply1:   reply1,
+				RelaySession0: session0,
+				RelaySession1: session1,
+			}, nil, nil)
+
+			_, err := ts.txConflictDetection(msg)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			events := ts.Ctx.EventManager().Events()
+			require.Equal(t, utils.EventPrefix+conflicttypes.ConflictVoteDetectionEventName, events[len(events)-1].Type)
+
+			// a vote is opened on the conflicting block and the providers are not punished yet
+			votes := ts.Keepers.Conflict.GetAllConflictVote(ts.Ctx)
+			require.Len(t, votes, 1)
+			require.Equal(t, uint64(100), votes[0].RequestBlock)
+			require.NotEmpty(t, votes[0].Votes)
+			for _, provider := range []sigs.Account{ts.providers[0], tt.provider1} {
+				entry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+				require.True(t, found)
+				require.False(t, entry.IsJailed(uint64(ts.Ctx.BlockHeight())))
+			}
+
+			// the same proof can't be reported again, also after its vote was closed
+			_, err = ts.txConflictDetection(msg)
+			require.Error(t, err)
+			ts.Keepers.Conflict.RemoveConflictVote(ts.Ctx, votes[0].Index)
+			_, err = ts.txConflictDetection(msg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFinalizationConflictVote(t *testing.T) {
+	rand.InitRandomSeed()
+	ts := newTester(t)
+	ts.setupForConflict(ProvidersCount)
+
+	session0, reply0 := ts.finalizationRelay(ts.providers[0], 1, 100, map[int64]string{99: "b", 100: "c"})
+	session1, reply1 := ts.finalizationRelay(ts.providers[1], 2, 100, map[int64]string{99: "b", 100: "forked"})
+	_, err := ts.txConflictDetection(conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), &conflicttypes.FinalizationConflict{
+		RelayReply0:   reply0,
+		RelayReply1:   reply1,
+		RelaySession0: session0,
+		RelaySession1: session1,
+	}, nil, nil))
+	require.NoError(t, err)
+	votes := ts.Keepers.Conflict.GetAllConflictVote(ts.Ctx)
+	require.Len(t, votes, 1)
+	voteID := votes[0].Index
+
+	// the jury commits to the hash their node has for the conflicting block
+	nonce := rand.Int63()
+	voteData := conflicttypes.FinalizedBlockHashVoteData("c")
+	for i := 2; i < ProvidersCount; i++ {
+		creator := ts.providers[i].Addr.String()
+		_, err := ts.txConflictVoteCommit(&conflicttypes.MsgConflictVoteCommit{Creator: creator, VoteID: voteID, Hash: conflicttypes.CommitVoteData(nonce, voteData, creator)})
+		require.NoError(t, err)
+	}
+
+	ts.AdvanceEpochs(ts.VotePeriod() + 1)
+
+	for i := 2; i < ProvidersCount; i++ {
+		_, err := ts.txConflictVoteReveal(&conflicttypes.MsgConflictVoteReveal{Creator: ts.providers[i].Addr.String(), VoteID: voteID, Nonce: nonce, Hash: voteData})
+		require.NoError(t, err)
+	}
+	vote, found := ts.Keepers.Conflict.GetConflictVote(ts.Ctx, voteID)
+	require.True(t, found)
+	for _, voter := range vote.Votes {
+		require.Equal(t, int64(conflicttypes.Provider0), voter.Result)
+	}
+
+	ts.AdvanceEpochs(ts.VotePeriod())
+
+	_, found = ts.Keepers.Conflict.GetConflictVote(ts.Ctx, voteID)
+	require.False(t, found)
+	events := ts.Ctx.EventManager().Events()
+	require.Equal(t, utils.EventPrefix+conflicttypes.ConflictVoteResolvedEventName, events[len(events)-1].Type)
+}
+
+func TestSameProviderConflictDetection(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForConflict(ProvidersCount)
+
+	provider := ts.providers[0]
+	entry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+	require.True(t, found)
+	stake := entry.EffectiveStake()
+
+	session0, reply0 := ts.finalizationRelay(provider, 1, 100, map[int64]string{99: "a", 100: "b"})
+	session1, reply1 := ts.finalizationRelay(provider, 2, 101, map[int64]string{100: "forked", 101: "c"})
+	conflict := &conflicttypes.FinalizationConflict{
+		RelayReply0:   reply0,
+		RelayReply1:   reply1,
+		RelaySession0: session0,
+		RelaySession1: session1,
+	}
+
+	// a same provider conflict can't be reported as a finalization conflict
+	_, err := ts.txConflictDetection(conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), conflict, nil, nil))
+	require.Error(t, err)
+
+	_, err = ts.txConflictDetection(conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, conflict))
+	require.NoError(t, err)
+	events := ts.Ctx.EventManager().Events()
+	require.Equal(t, utils.EventPrefix+conflicttypes.SameProviderConflictEventName, events[len(events)-1].Type)
+
+	// the provider is jailed and slashed
+	entry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+	require.True(t, found)
+	require.True(t, entry.IsJailed(uint64(ts.Ctx.BlockHeight())))
+	require.True(t, entry.EffectiveStake().LT(stake))
+
+	// the same proof can't be used twice
+	_, err = ts.txConflictDetection(conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, conflict))
+	require.Error(t, err)
+	slashedEntry, _, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+	require.True(t, entry.EffectiveStake().Equal(slashedEntry.EffectiveStake()))
+
+	// a conflict between different providers can't be reported as a same provider conflict
+	session1, reply1 = ts.finalizationRelay(ts.providers[1], 2, 101, map[int64]string{100: "forked", 101: "c"})
+	conflict.RelaySession1, conflict.RelayReply1 = session1, reply1
+	_, err = ts.txConflictDetection(conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, conflict))
+	require.Error(t, err)
+}
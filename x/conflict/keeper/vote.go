@@ -49,7 +49,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	// valid only if one of the votes is bigger than 50% from total
 	// punish providers that didnt vote - discipline/jail + bail = 20%stake + slash 5%stake
 	// (dont add jailed providers to voters)
-	// if strong majority punish wrong providers - jail from start of memory to end + slash 100%stake
+	// if strong majority punish wrong providers - freeze + slash 5%stake
 	// reward pool is the slashed amount from all punished providers
 	// reward to stake - client 50%, the original provider 10%, 20% the voters
 	totalVotes := sdk.ZeroInt()
@@ -59,6 +59,14 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	var providersWithoutVote []string
 	rewardPool := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
 	rewardCount := math.ZeroInt()
+	rewardPaid := math.ZeroInt()
+	// the slashed stake is held until it's paid as rewards, what is left of it is burned
+	defer func() {
+		err := k.pairingKeeper.BurnSlashed(ctx, rewardPool.SubAmount(rewardPaid))
+		if err != nil {
+			utils.LavaFormatError("failed burning the conflict reward pool leftover", err, utils.Attribute{Key: "voteID", Value: conflictVote.Index})
+		}
+	}()
 	votersStake := map[string]math.Int{} // this is needed in order to give rewards for each voter according to their stake(so we dont take this data twice from the keeper)
 	ConsensusVote := true
 	var majorityMet bool
@@ -120,8 +128,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 		default:
 			// punish providers that didnt vote
 			providersWithoutVote = append(providersWithoutVote, vote.Address)
-			bail := stake
-			bail.Quo(sdk.NewIntFromUint64(BailStakeDiv))
+			bail := stake.Quo(sdk.NewIntFromUint64(BailStakeDiv))
 			err = k.pairingKeeper.JailEntry(ctx, accAddress, conflictVote.ChainID, conflictVote.VoteStartBlock, blocksToSave, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bail))
 			if err != nil {
 				utils.LavaFormatWarning("jailing failed at vote conflict", err)
@@ -184,7 +191,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 						)
 						continue
 					}
-					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, sdk.NewDecWithPrec(1, 0))
+					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, SlashStakePercent)
 					rewardPool = rewardPool.Add(slashed)
 					if err != nil {
 						utils.LavaFormatWarning("slashing failed at vote conflict", err)
@@ -235,6 +242,8 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 				ok, err := k.pairingKeeper.CreditStakeEntry(ctx, conflictVote.ChainID, accWinnerAddress, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), winnerReward.TruncateInt()))
 				if !ok {
 					utils.LavaFormatWarning("failed to credit client", err)
+				} else {
+					rewardPaid = rewardPaid.Add(winnerReward.TruncateInt())
 				}
 			}
 		}
@@ -277,6 +286,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 					utils.LavaFormatWarning("failed to credit client", err)
 					continue
 				}
+				rewardPaid = rewardPaid.Add(rewardVoter.TruncateInt())
 			}
 		}
 	}
@@ -292,6 +302,49 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	utils.LogLavaEvent(ctx, logger, eventName, eventDataMap, "conflict detection resolved")
 }
 
+// PunishFinalizationConflict jails and slashes the providers that contradicted their own finalization data,
+// providers that are already jailed are not jailed again
+func (k Keeper) PunishFinalizationConflict(ctx sdk.Context, chainID string, providers []sdk.AccAddress) (punished []string, slashed sdk.Coin) {
+	slashed = sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	currentBlock := uint64(ctx.BlockHeight())
+	blocksToSave, err := k.epochstorageKeeper.BlocksToSave(ctx, currentBlock)
+	if err != nil {
+		utils.LavaFormatWarning("failed to get blocks to save", err,
+			utils.Attribute{Key: "block", Value: currentBlock},
+		)
+		return nil, slashed
+	}
+
+	for _, provider := range providers {
+		entry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, provider)
+		if !found || entry.IsJailed(currentBlock) {
+			continue
+		}
+
+		bail := entry.EffectiveStake().Quo(sdk.NewIntFromUint64(BailStakeDiv))
+		err = k.pairingKeeper.JailEntry(ctx, provider, chainID, currentBlock, blocksToSave, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bail))
+		if err != nil {
+			utils.LavaFormatWarning("jailing failed at finalization conflict", err)
+			// not skipping to continue to slash
+		}
+		punished = append(punished, provider.String())
+
+		amount, err := k.pairingKeeper.SlashEntry(ctx, provider, chainID, SlashStakePercent)
+		if err != nil {
+			utils.LavaFormatWarning("slashing failed at finalization conflict", err)
+			continue
+		}
+		slashed = slashed.Add(amount)
+	}
+
+	// there is no vote to reward, the slashed stake is burned
+	if err := k.pairingKeeper.BurnSlashed(ctx, slashed); err != nil {
+		utils.LavaFormatError("failed burning slashed stake at finalization conflict", err)
+	}
+
+	return punished, slashed
+}
+
 func (k Keeper) TransitionVoteToReveal(ctx sdk.Context, conflictVote types.ConflictVote) {
 	logger := k.Logger(ctx)
 	conflictVote.VoteState = types.StateReveal
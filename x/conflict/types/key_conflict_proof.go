@@ -0,0 +1,31 @@
+package types
+
+import (
+	"strconv"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// ConflictProofKeyPrefix is the prefix to retrieve all the finalization proofs that were already reported
+	ConflictProofKeyPrefix = "ConflictProof/value/"
+)
+
+// ConflictProofKey returns the store key of a finalization proof, providers are reported once for the hashes they signed for a block
+func ConflictProofKey(
+	chainID string,
+	block int64,
+	providers []string,
+) []byte {
+	var key []byte
+
+	// the order of the providers in the proof doesn't matter
+	sorted := slices.Clone(providers)
+	slices.Sort(sorted)
+	key = append(key, []byte(chainID+"/"+strconv.FormatInt(block, 10)+"/")...)
+	for _, provider := range sorted {
+		key = append(key, []byte(provider+"/")...)
+	}
+
+	return key
+}
@@ -28,6 +28,7 @@ const (
 	ConflictVoteGotCommitEventName     = "conflict_vote_got_commit"
 	ConflictVoteGotRevealEventName     = "conflict_vote_got_reveal"
 	ConflictUnstakeFraudVoterEventName = "conflict_unstake_fraud_voter"
+	SameProviderConflictEventName      = "same_provider_conflict_detection"
 )
 
 // unstake description
@@ -42,3 +43,8 @@ func CommitVoteData(nonce int64, dataHash []byte, providerAddress string) []byte
 	commitDataHash := tendermintcrypto.Sha256(commitData)
 	return commitDataHash
 }
+
+// FinalizedBlockHashVoteData is the data a voter commits to in a finalization conflict vote, the block hash its node has for the conflicting block
+func FinalizedBlockHashVoteData(blockHash string) []byte {
+	return sigs.HashMsg([]byte(blockHash))
+}
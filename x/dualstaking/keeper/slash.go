@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// SlashDelegations takes a percentage of all the delegations to the provider on the chain, including the provider's own stake.
+// the slashed tokens are moved to the dualstaking module account, from there they are paid as rewards or burned with BurnSlashed.
+// returns the slashed amount
+func (k Keeper) SlashDelegations(ctx sdk.Context, provider string, chainID string, percentage sdk.Dec) (math.Int, error) {
+	epoch := k.epochstorageKeeper.GetCurrentNextEpoch(ctx)
+	delegations, err := k.GetProviderDelegators(ctx, provider, epoch)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	slashed := math.ZeroInt()
+	for _, delegation := range delegations {
+		if delegation.ChainID != chainID {
+			continue
+		}
+		amount := percentage.MulInt(delegation.Amount.Amount).TruncateInt()
+		if amount.IsZero() {
+			continue
+		}
+		taken, err := k.slashDelegation(ctx, delegation.Delegator, provider, chainID, sdk.NewCoin(delegation.Amount.Denom, amount))
+		if err != nil {
+			return slashed, err
+		}
+		slashed = slashed.Add(taken)
+	}
+
+	return slashed, nil
+}
+
+// slashDelegation moves the amount from the provider to the empty provider and takes it from the delegator's validators delegations,
+// the dualstaking hooks of the validators unbonding take it from the empty provider so the balance is kept
+func (k Keeper) slashDelegation(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin) (math.Int, error) {
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	err = k.Redelegate(ctx, delegator, provider, types.EMPTY_PROVIDER, chainID, types.EMPTY_PROVIDER_CHAINID, amount)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	taken := math.ZeroInt()
+	remaining := amount.Amount
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr) {
+		if !remaining.IsPositive() {
+			break
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		toUnbond := math.MinInt(validator.TokensFromShares(delegation.Shares).TruncateInt(), remaining)
+		if toUnbond.IsZero() {
+			continue
+		}
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delAddr, delegation.GetValidatorAddr(), toUnbond)
+		if err != nil {
+			return taken, err
+		}
+		unbonded, err := k.stakingKeeper.Unbond(ctx, delAddr, delegation.GetValidatorAddr(), shares)
+		if err != nil {
+			return taken, err
+		}
+		// the tokens of a bonded validator stay in the bonded pool when unbonded directly
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.ModuleName, sdk.NewCoins(sdk.NewCoin(amount.Denom, unbonded)))
+		if err != nil {
+			return taken, err
+		}
+		taken = taken.Add(unbonded)
+		remaining = remaining.Sub(toUnbond)
+	}
+
+	details := map[string]string{
+		"delegator": delegator,
+		"provider":  provider,
+		"chainID":   chainID,
+		"amount":    taken.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.DelegationSlashEventName, details, "Delegation slashed")
+
+	return taken, nil
+}
+
+// BurnSlashed burns slashed tokens that were not paid as rewards
+func (k Keeper) BurnSlashed(ctx sdk.Context, amount sdk.Coin) error {
+	if amount.IsZero() {
+		return nil
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+}
@@ -7,6 +7,7 @@ const (
 	ClaimRewardsEventName      = "delegator_claim_rewards"
 	ContributorRewardEventName = "contributor_rewards"
 	ValidatorSlashEventName    = "validator_slash"
+	DelegationSlashEventName   = "delegation_slash"
 )
 
 const (
@@ -4,8 +4,10 @@
 package types
 
 import (
-	"cosmossdk.io/math"
 	regmath "math"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (se StakeEntry) EffectiveStake() math.Int {
@@ -32,3 +34,38 @@ func (stakeEntry *StakeEntry) UnFreeze(currentBlock uint64) {
 func (stakeEntry *StakeEntry) IsFrozen() bool {
 	return stakeEntry.StakeAppliedBlock == FROZEN_BLOCK
 }
+
+// jails the provider from jailStartBlock until jailEndBlock, a jail that overlaps an existing jail extends it
+func (stakeEntry *StakeEntry) Jail(jailStartBlock uint64, jailEndBlock uint64, bail math.Int, currentBlock uint64) {
+	if stakeEntry.IsJailed(currentBlock) {
+		if jailStartBlock > stakeEntry.JailStartBlock {
+			jailStartBlock = stakeEntry.JailStartBlock
+		}
+		if jailEndBlock < stakeEntry.JailEndBlock {
+			jailEndBlock = stakeEntry.JailEndBlock
+		}
+		if stakeEntry.Bail != nil {
+			bail = bail.Add(stakeEntry.Bail.Amount)
+		}
+	}
+	stakeEntry.JailStartBlock = jailStartBlock
+	stakeEntry.JailEndBlock = jailEndBlock
+	bailCoin := sdk.NewCoin(stakeEntry.Stake.Denom, bail)
+	stakeEntry.Bail = &bailCoin
+	stakeEntry.Jails++
+}
+
+// releases the provider from jail, payments for relays before the release block are still rejected
+func (stakeEntry *StakeEntry) Release(currentBlock uint64) {
+	stakeEntry.JailEndBlock = currentBlock
+	stakeEntry.Bail = nil
+}
+
+func (stakeEntry *StakeEntry) IsJailed(block uint64) bool {
+	return stakeEntry.JailEndBlock > block
+}
+
+// returns true if any block of the range [start, end] is in the jail period, relays on these blocks are not paid
+func (stakeEntry *StakeEntry) IsJailedDuring(start uint64, end uint64) bool {
+	return end >= stakeEntry.JailStartBlock && start < stakeEntry.JailEndBlock
+}
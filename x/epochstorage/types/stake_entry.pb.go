@@ -25,16 +25,20 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StakeEntry struct {
-	Stake              types.Coin `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake"`
-	Address            string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	StakeAppliedBlock  uint64     `protobuf:"varint,3,opt,name=stake_applied_block,json=stakeAppliedBlock,proto3" json:"stake_applied_block,omitempty"`
-	Endpoints          []Endpoint `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
-	Geolocation        int32      `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
-	Chain              string     `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
-	Moniker            string     `protobuf:"bytes,8,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	DelegateTotal      types.Coin `protobuf:"bytes,9,opt,name=delegate_total,json=delegateTotal,proto3" json:"delegate_total"`
-	DelegateLimit      types.Coin `protobuf:"bytes,10,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
-	DelegateCommission uint64     `protobuf:"varint,11,opt,name=delegate_commission,json=delegateCommission,proto3" json:"delegate_commission,omitempty"`
+	Stake              types.Coin  `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake"`
+	Address            string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	StakeAppliedBlock  uint64      `protobuf:"varint,3,opt,name=stake_applied_block,json=stakeAppliedBlock,proto3" json:"stake_applied_block,omitempty"`
+	Endpoints          []Endpoint  `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
+	Geolocation        int32       `protobuf:"varint,5,opt,name=geolocation,proto3" json:"geolocation,omitempty"`
+	Chain              string      `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
+	Moniker            string      `protobuf:"bytes,8,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	DelegateTotal      types.Coin  `protobuf:"bytes,9,opt,name=delegate_total,json=delegateTotal,proto3" json:"delegate_total"`
+	DelegateLimit      types.Coin  `protobuf:"bytes,10,opt,name=delegate_limit,json=delegateLimit,proto3" json:"delegate_limit"`
+	DelegateCommission uint64      `protobuf:"varint,11,opt,name=delegate_commission,json=delegateCommission,proto3" json:"delegate_commission,omitempty"`
+	JailStartBlock     uint64      `protobuf:"varint,12,opt,name=jail_start_block,json=jailStartBlock,proto3" json:"jail_start_block,omitempty"`
+	JailEndBlock       uint64      `protobuf:"varint,13,opt,name=jail_end_block,json=jailEndBlock,proto3" json:"jail_end_block,omitempty"`
+	Bail               *types.Coin `protobuf:"bytes,14,opt,name=bail,proto3" json:"bail,omitempty"`
+	Jails              uint64      `protobuf:"varint,15,opt,name=jails,proto3" json:"jails,omitempty"`
 }
 
 func (m *StakeEntry) Reset()         { *m = StakeEntry{} }
@@ -140,6 +144,34 @@ func (m *StakeEntry) GetDelegateCommission() uint64 {
 	return 0
 }
 
+func (m *StakeEntry) GetJailStartBlock() uint64 {
+	if m != nil {
+		return m.JailStartBlock
+	}
+	return 0
+}
+
+func (m *StakeEntry) GetJailEndBlock() uint64 {
+	if m != nil {
+		return m.JailEndBlock
+	}
+	return 0
+}
+
+func (m *StakeEntry) GetBail() *types.Coin {
+	if m != nil {
+		return m.Bail
+	}
+	return nil
+}
+
+func (m *StakeEntry) GetJails() uint64 {
+	if m != nil {
+		return m.Jails
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StakeEntry)(nil), "lavanet.lava.epochstorage.StakeEntry")
 }
@@ -149,34 +181,37 @@ func init() {
 }
 
 var fileDescriptor_df6302d6b53c056e = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0xcd, 0x92, 0xa4, 0x4d, 0x1c, 0x81, 0xc0, 0xed, 0xc1, 0xed, 0x61, 0x59, 0xc1, 0x65, 0x25,
-	0x90, 0xad, 0x16, 0xf1, 0x01, 0xa4, 0x6a, 0x91, 0x10, 0xa7, 0xc0, 0x89, 0x4b, 0xe4, 0xf5, 0x8e,
-	0x36, 0x56, 0x76, 0x3d, 0xab, 0xb5, 0xa9, 0xe8, 0x5f, 0xf0, 0x59, 0x3d, 0xf6, 0xc8, 0x09, 0xa1,
-	0xe4, 0x03, 0xf8, 0x05, 0xe4, 0xf5, 0x6e, 0x69, 0x0e, 0x91, 0xe0, 0x64, 0xcf, 0xbc, 0x79, 0x4f,
-	0xef, 0x8d, 0x86, 0xbc, 0x2a, 0xe5, 0xb5, 0x34, 0xe0, 0x84, 0x7f, 0x05, 0xd4, 0xa8, 0x56, 0xd6,
-	0x61, 0x23, 0x0b, 0x10, 0xd6, 0xc9, 0x35, 0x2c, 0xc1, 0xb8, 0xe6, 0x86, 0xd7, 0x0d, 0x3a, 0xa4,
-	0x27, 0xdd, 0x30, 0xf7, 0x2f, 0x7f, 0x38, 0x7c, 0x9a, 0xee, 0xd7, 0x01, 0x93, 0xd7, 0xa8, 0x8d,
-	0x0b, 0x22, 0xa7, 0xc7, 0x05, 0x16, 0xd8, 0x7e, 0x85, 0xff, 0x75, 0xdd, 0x58, 0xa1, 0xad, 0xd0,
-	0x8a, 0x4c, 0x5a, 0x10, 0xd7, 0x67, 0x19, 0x38, 0x79, 0x26, 0x14, 0x6a, 0x13, 0xf0, 0x17, 0xbf,
-	0x87, 0x84, 0x7c, 0xf2, 0x86, 0x2e, 0xbd, 0x1f, 0xfa, 0x96, 0x8c, 0x5b, 0x7b, 0x2c, 0x4a, 0xa2,
-	0x74, 0x76, 0x7e, 0xc2, 0x03, 0x9d, 0x7b, 0x3a, 0xef, 0xe8, 0xfc, 0x02, 0xb5, 0x99, 0x8f, 0x6e,
-	0x7f, 0x3e, 0x1f, 0x2c, 0xc2, 0x34, 0x65, 0xe4, 0x50, 0xe6, 0x79, 0x03, 0xd6, 0xb2, 0x47, 0x49,
-	0x94, 0x4e, 0x17, 0x7d, 0x49, 0x39, 0x39, 0x0a, 0x79, 0x65, 0x5d, 0x97, 0x1a, 0xf2, 0x65, 0x56,
-	0xa2, 0x5a, 0xb3, 0x61, 0x12, 0xa5, 0xa3, 0xc5, 0xb3, 0x16, 0x7a, 0x17, 0x90, 0xb9, 0x07, 0xe8,
-	0x7b, 0x32, 0xed, 0x73, 0x59, 0x36, 0x4a, 0x86, 0xe9, 0xec, 0xfc, 0x25, 0xdf, 0xbb, 0x1e, 0x7e,
-	0xd9, 0xcd, 0x76, 0x76, 0xfe, 0x72, 0x69, 0x42, 0x66, 0x05, 0x60, 0x89, 0x4a, 0x3a, 0x8d, 0x86,
-	0x8d, 0x93, 0x28, 0x1d, 0x2f, 0x1e, 0xb6, 0xe8, 0x31, 0x19, 0xab, 0x95, 0xd4, 0x86, 0x1d, 0xb4,
-	0x96, 0x43, 0xe1, 0xa3, 0x54, 0x68, 0xf4, 0x1a, 0x1a, 0x36, 0x09, 0x51, 0xba, 0x92, 0x5e, 0x91,
-	0x27, 0x39, 0x94, 0x50, 0x48, 0x07, 0x4b, 0x87, 0x4e, 0x96, 0x6c, 0xfa, 0x6f, 0x4b, 0x7a, 0xdc,
-	0xd3, 0x3e, 0x7b, 0xd6, 0x8e, 0x4e, 0xa9, 0x2b, 0xed, 0x18, 0xf9, 0x4f, 0x9d, 0x8f, 0x9e, 0x45,
-	0x05, 0x39, 0xba, 0xd7, 0x51, 0x58, 0x55, 0xda, 0x5a, 0x9f, 0x74, 0xd6, 0xae, 0x96, 0xf6, 0xd0,
-	0xc5, 0x3d, 0xf2, 0x61, 0x34, 0x39, 0x7c, 0x3a, 0x99, 0x5f, 0xdd, 0x6e, 0xe2, 0xe8, 0x6e, 0x13,
-	0x47, 0xbf, 0x36, 0x71, 0xf4, 0x7d, 0x1b, 0x0f, 0xee, 0xb6, 0xf1, 0xe0, 0xc7, 0x36, 0x1e, 0x7c,
-	0x79, 0x5d, 0x68, 0xb7, 0xfa, 0x9a, 0x71, 0x85, 0x95, 0xd8, 0x39, 0xbb, 0x6f, 0xbb, 0x87, 0xe7,
-	0x6e, 0x6a, 0xb0, 0xd9, 0x41, 0x7b, 0x40, 0x6f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x45,
-	0x2d, 0xb5, 0xea, 0x02, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0xdd, 0xd0, 0x4d, 0xbb, 0xeb, 0x6d, 0x97, 0xe2, 0xf6, 0xe0, 0xf6, 0x10, 0x22, 0xe0, 0x10,
+	0x09, 0x70, 0xd4, 0x22, 0x3e, 0x80, 0xad, 0xb6, 0x48, 0x88, 0x53, 0xca, 0x89, 0x4b, 0xe4, 0x24,
+	0x56, 0xd6, 0x6c, 0xe2, 0x89, 0x62, 0x53, 0xd1, 0x3b, 0x1f, 0xc0, 0x67, 0xf5, 0xd8, 0x23, 0x27,
+	0x84, 0x76, 0x7f, 0x04, 0xd9, 0x4e, 0x4a, 0xf7, 0x50, 0x41, 0x4f, 0xf6, 0xcc, 0xbc, 0xf7, 0xf4,
+	0x66, 0xc6, 0x46, 0x2f, 0x2b, 0x76, 0xc9, 0x24, 0xd7, 0xb1, 0x39, 0x63, 0xde, 0x40, 0xbe, 0x50,
+	0x1a, 0x5a, 0x56, 0xf2, 0x58, 0x69, 0xb6, 0xe4, 0x29, 0x97, 0xba, 0xbd, 0xa2, 0x4d, 0x0b, 0x1a,
+	0xf0, 0x51, 0x07, 0xa6, 0xe6, 0xa4, 0x77, 0xc1, 0xc7, 0xd1, 0xfd, 0x3a, 0x5c, 0x16, 0x0d, 0x08,
+	0xa9, 0x9d, 0xc8, 0xf1, 0x61, 0x09, 0x25, 0xd8, 0x6b, 0x6c, 0x6e, 0x5d, 0x36, 0xc8, 0x41, 0xd5,
+	0xa0, 0xe2, 0x8c, 0x29, 0x1e, 0x5f, 0x9e, 0x64, 0x5c, 0xb3, 0x93, 0x38, 0x07, 0x21, 0x5d, 0xfd,
+	0xd9, 0x77, 0x1f, 0xa1, 0x0b, 0x63, 0x68, 0x6e, 0xfc, 0xe0, 0xb7, 0xc8, 0xb7, 0xf6, 0x88, 0x17,
+	0x7a, 0xd1, 0xe4, 0xf4, 0x88, 0x3a, 0x3a, 0x35, 0x74, 0xda, 0xd1, 0xe9, 0x19, 0x08, 0x39, 0x1b,
+	0x5e, 0xff, 0x7a, 0x3a, 0x48, 0x1c, 0x1a, 0x13, 0xb4, 0xc3, 0x8a, 0xa2, 0xe5, 0x4a, 0x91, 0x47,
+	0xa1, 0x17, 0x8d, 0x93, 0x3e, 0xc4, 0x14, 0x1d, 0xb8, 0x7e, 0x59, 0xd3, 0x54, 0x82, 0x17, 0x69,
+	0x56, 0x41, 0xbe, 0x24, 0x5b, 0xa1, 0x17, 0x0d, 0x93, 0x27, 0xb6, 0xf4, 0xce, 0x55, 0x66, 0xa6,
+	0x80, 0xdf, 0xa3, 0x71, 0xdf, 0x97, 0x22, 0xc3, 0x70, 0x2b, 0x9a, 0x9c, 0x3e, 0xa7, 0xf7, 0x8e,
+	0x87, 0xce, 0x3b, 0x6c, 0x67, 0xe7, 0x2f, 0x17, 0x87, 0x68, 0x52, 0x72, 0xa8, 0x20, 0x67, 0x5a,
+	0x80, 0x24, 0x7e, 0xe8, 0x45, 0x7e, 0x72, 0x37, 0x85, 0x0f, 0x91, 0x9f, 0x2f, 0x98, 0x90, 0x64,
+	0xdb, 0x5a, 0x76, 0x81, 0x69, 0xa5, 0x06, 0x29, 0x96, 0xbc, 0x25, 0x23, 0xd7, 0x4a, 0x17, 0xe2,
+	0x73, 0x34, 0x2d, 0x78, 0xc5, 0x4b, 0xa6, 0x79, 0xaa, 0x41, 0xb3, 0x8a, 0x8c, 0xff, 0x6f, 0x48,
+	0x7b, 0x3d, 0xed, 0x93, 0x61, 0x6d, 0xe8, 0x54, 0xa2, 0x16, 0x9a, 0xa0, 0x07, 0xea, 0x7c, 0x34,
+	0x2c, 0x1c, 0xa3, 0x83, 0x5b, 0x9d, 0x1c, 0xea, 0x5a, 0x28, 0x65, 0x3a, 0x9d, 0xd8, 0xd1, 0xe2,
+	0xbe, 0x74, 0x76, 0x5b, 0xc1, 0x11, 0xda, 0xff, 0xc2, 0x44, 0x95, 0x2a, 0xcd, 0x5a, 0xdd, 0x2d,
+	0x62, 0xd7, 0xa2, 0xa7, 0x26, 0x7f, 0x61, 0xd2, 0x6e, 0x0b, 0x2f, 0x90, 0xcd, 0xa4, 0x5c, 0xf6,
+	0x0b, 0xdb, 0xb3, 0xb8, 0x5d, 0x93, 0x9d, 0xcb, 0x6e, 0x57, 0xaf, 0xd1, 0x30, 0x63, 0xa2, 0x22,
+	0xd3, 0x7f, 0xd8, 0x4f, 0x2c, 0xcc, 0xcc, 0xdb, 0xd0, 0x15, 0x79, 0x6c, 0xb5, 0x5c, 0xf0, 0x61,
+	0x38, 0xda, 0xd9, 0x1f, 0xcd, 0xce, 0xaf, 0x57, 0x81, 0x77, 0xb3, 0x0a, 0xbc, 0xdf, 0xab, 0xc0,
+	0xfb, 0xb1, 0x0e, 0x06, 0x37, 0xeb, 0x60, 0xf0, 0x73, 0x1d, 0x0c, 0x3e, 0xbf, 0x2a, 0x85, 0x5e,
+	0x7c, 0xcd, 0x68, 0x0e, 0x75, 0xbc, 0xf1, 0x17, 0xbe, 0x6d, 0xfe, 0x06, 0x7d, 0xd5, 0x70, 0x95,
+	0x6d, 0xdb, 0x57, 0xfd, 0xe6, 0xcf, 0x00, 0xc7, 0xce, 0x81, 0xba, 0x7f, 0x03, 0x00, 0x00,
 }
 
 func (m *StakeEntry) Marshal() (dAtA []byte, err error) {
@@ -199,6 +234,33 @@ func (m *StakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Jails != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.Jails))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Bail != nil {
+		{
+			size, err := m.Bail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStakeEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.JailEndBlock != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.JailEndBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.JailStartBlock != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.JailStartBlock))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.DelegateCommission != 0 {
 		i = encodeVarintStakeEntry(dAtA, i, uint64(m.DelegateCommission))
 		i--
@@ -332,6 +394,19 @@ func (m *StakeEntry) Size() (n int) {
 	if m.DelegateCommission != 0 {
 		n += 1 + sovStakeEntry(uint64(m.DelegateCommission))
 	}
+	if m.JailStartBlock != 0 {
+		n += 1 + sovStakeEntry(uint64(m.JailStartBlock))
+	}
+	if m.JailEndBlock != 0 {
+		n += 1 + sovStakeEntry(uint64(m.JailEndBlock))
+	}
+	if m.Bail != nil {
+		l = m.Bail.Size()
+		n += 1 + l + sovStakeEntry(uint64(l))
+	}
+	if m.Jails != 0 {
+		n += 1 + sovStakeEntry(uint64(m.Jails))
+	}
 	return n
 }
 
@@ -656,6 +731,99 @@ func (m *StakeEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailStartBlock", wireType)
+			}
+			m.JailStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailStartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEndBlock", wireType)
+			}
+			m.JailEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bail == nil {
+				m.Bail = &types.Coin{}
+			}
+			if err := m.Bail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jails", wireType)
+			}
+			m.Jails = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jails |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakeEntry(dAtA[iNdEx:])
@@ -43,6 +43,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdSdkPairing())
 	cmd.AddCommand(CmdProviderMonthlyPayout())
 	cmd.AddCommand(CmdSubscriptionMonthlyPayout())
+	cmd.AddCommand(CmdJailedProviders())
+	cmd.AddCommand(CmdProvidersQos())
 
 	// this line is used by starport scaffolding # 1
 
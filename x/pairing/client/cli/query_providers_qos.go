@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdProvidersQos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers-qos [chain-id] [optional: provider]",
+		Short: "Query the QoS excellence aggregate of the providers of a chain per cluster, with their QoS pairing score",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqChainID := args[0]
+			reqProvider := ""
+			if len(args) > 1 {
+				reqProvider = args[1]
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProvidersQosRequest{
+				ChainID:  reqChainID,
+				Provider: reqProvider,
+			}
+
+			res, err := queryClient.ProvidersQos(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dualstakingclient "github.com/lavanet/lava/x/dualstaking/client/cli"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdBail() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bail [chain-id] [amount] [optional: validator]",
+		Short: "Posts a bail to release a jailed provider",
+		Long: `The bail command allows a jailed provider to be released before its jail ends. The bail is added to the provider's stake 
+		and must be at least the bail the provider was jailed with. if no validator is specified, the validator from the largest delegation is picked`,
+		Example: `required flags: --from alice
+		lavad tx pairing bail [chain-id] [amount] --from <provider_address>
+		lavad tx pairing bail ETH1 1000ulava --from alice`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBail, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var validator string
+			if len(args) > 2 {
+				validator = args[2]
+			} else {
+				validator = dualstakingclient.GetValidator(clientCtx)
+			}
+
+			msg := types.NewMsgBail(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				argBail,
+				validator,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
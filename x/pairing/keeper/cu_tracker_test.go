@@ -699,3 +699,72 @@ func TestTrackedCuDeletion(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, relayCuSum, cu)
 }
+
+// TestTrackedCuTransferredSubscription checks that the CU of relays of the transfer epoch,
+// which are tracked under the old consumer, is rewarded even if the relays are paid late
+func TestTrackedCuTransferredSubscription(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 2)
+
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+	_, newConsumer := ts.AddAccount(common.CONSUMER, 1, testBalance)
+
+	ts.AdvanceBlock()
+	relayPayment := sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+
+	err := ts.TxSubscriptionTransfer(client, client, newConsumer)
+	require.NoError(t, err)
+
+	// the old consumer buys a new subscription, so its late relays still pass validation
+	ts.AdvanceEpoch()
+	_, err = ts.TxSubscriptionBuy(client, client, ts.plan.Index, 1, false, false)
+	require.NoError(t, err)
+
+	// pay the relay of the transfer epoch more than blocksToSave after the transfer
+	ts.AdvanceBlocks(ts.BlocksToSave() - ts.EpochBlocks() + 1)
+	ts.relayPaymentWithoutPay(relayPayment, true)
+
+	// advance blocksToSave from the epoch after the transfer to trigger the provider payment
+	ts.AdvanceBlocks(ts.EpochBlocks())
+
+	reward, err := ts.QueryDualstakingDelegatorRewards(providerAcc.Addr.String(), providerAcc.Addr.String(), ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, reward.Rewards, 1)
+	require.Equal(t, ts.plan.Price.Amount, reward.Rewards[0].Amount.Amount)
+}
+
+// TestTrackedCuUpgradedSubscription checks that the CU of relays of the upgrade epoch,
+// which are tracked under the pre-upgrade subscription, is rewarded even if the relays are paid late
+func TestTrackedCuUpgradedSubscription(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 2)
+
+	clientAcc, client := ts.GetAccount(common.CONSUMER, 0)
+	providerAcc, provider := ts.GetAccount(common.PROVIDER, 0)
+
+	premiumPlan := ts.plan
+	premiumPlan.Index = "premium"
+	premiumPlan.Price.Amount = ts.plan.Price.Amount.MulRaw(2)
+	err := testkeeper.SimulatePlansAddProposal(ts.Ctx, ts.Keepers.Plans, []planstypes.Plan{premiumPlan}, false)
+	require.NoError(t, err)
+
+	ts.AdvanceBlock()
+	relayPayment := sendRelay(ts, provider, clientAcc, []string{ts.spec.Index})
+
+	err = ts.TxSubscriptionUpgrade(client, client, premiumPlan.Index)
+	require.NoError(t, err)
+
+	// pay the relay of the upgrade epoch more than blocksToSave after the upgrade
+	ts.AdvanceEpoch()
+	ts.AdvanceBlocks(ts.BlocksToSave() - ts.EpochBlocks() + 1)
+	ts.relayPaymentWithoutPay(relayPayment, true)
+
+	// advance blocksToSave from the epoch after the upgrade to trigger the provider payment
+	ts.AdvanceBlocks(ts.EpochBlocks())
+
+	reward, err := ts.QueryDualstakingDelegatorRewards(providerAcc.Addr.String(), providerAcc.Addr.String(), ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, reward.Rewards, 1)
+	require.Equal(t, ts.plan.Price.Amount, reward.Rewards[0].Amount.Amount)
+}
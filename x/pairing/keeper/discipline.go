@@ -1,20 +1,148 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
+// JailEntry jails the provider on the chain from jailStartBlock for jailBlocks blocks. the provider is excluded from pairing
+// and can't get paid for relays in that period unless it posts the bail. jailing an already jailed provider extends the jail
 func (k Keeper) JailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, jailStartBlock, jailBlocks uint64, bail sdk.Coin) error {
-	// todo - provider will not get pairing and payment for this period
+	stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return utils.LavaFormatWarning("jail_cant_get_stake_entry", types.DisciplineStakeEntryNotFoundError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+		)
+	}
+
+	currentBlock := uint64(ctx.BlockHeight())
+	stakeEntry.Jail(jailStartBlock, jailStartBlock+jailBlocks, bail.Amount, currentBlock)
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+
+	details := map[string]string{
+		"providerAddress": account.String(),
+		"chainID":         chainID,
+		"jailStartBlock":  strconv.FormatUint(stakeEntry.JailStartBlock, 10),
+		"jailEndBlock":    strconv.FormatUint(stakeEntry.JailEndBlock, 10),
+		"bail":            stakeEntry.Bail.String(),
+		"jails":           strconv.FormatUint(stakeEntry.Jails, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.JailEntryEventName, details, "Provider Jailed")
 	return nil
 }
 
-func (k Keeper) BailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, bail sdk.Coin) error {
-	// todo - remove provider from jail and remove bail amount from account and add to stake
+// BailEntry releases a jailed provider. the bail is delegated as the provider's stake through the validator
+func (k Keeper) BailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, validator string, bail sdk.Coin) error {
+	stakeEntry, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return utils.LavaFormatWarning("bail_cant_get_stake_entry", types.DisciplineStakeEntryNotFoundError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+		)
+	}
+
+	currentBlock := uint64(ctx.BlockHeight())
+	if !stakeEntry.IsJailed(currentBlock) {
+		return utils.LavaFormatWarning("bail_provider_not_jailed", types.ProviderNotJailedError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+			utils.LogAttr("jailEndBlock", stakeEntry.JailEndBlock),
+		)
+	}
+
+	if stakeEntry.Bail == nil {
+		return utils.LavaFormatWarning("bail_not_set", types.BailNotSetError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+			utils.LogAttr("jailEndBlock", stakeEntry.JailEndBlock),
+		)
+	}
+
+	if bail.Denom != stakeEntry.Bail.Denom || bail.IsLT(*stakeEntry.Bail) {
+		return utils.LavaFormatWarning("bail_insufficient", types.InsufficientBailError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+			utils.LogAttr("bail", bail),
+			utils.LogAttr("requiredBail", stakeEntry.Bail),
+		)
+	}
+
+	err := k.dualstakingKeeper.DelegateFull(ctx, account.String(), validator, account.String(), chainID, bail)
+	if err != nil {
+		return utils.LavaFormatWarning("bail_delegate_failed", err,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+			utils.LogAttr("bail", bail),
+		)
+	}
+
+	// the delegation modified the stake entry, get it again
+	stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return utils.LavaFormatError("critical: stake entry not found after bail delegation", types.DisciplineStakeEntryNotFoundError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+		)
+	}
+	stakeEntry.Release(currentBlock)
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+
+	details := map[string]string{
+		"providerAddress": account.String(),
+		"chainID":         chainID,
+		"bail":            bail.String(),
+		"stake":           stakeEntry.Stake.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.BailEntryEventName, details, "Provider Bailed")
 	return nil
 }
 
+// SlashEntry takes a percentage of the provider's stake and of its delegations on the chain and returns the slashed amount.
+// the caller pays the slashed amount as rewards with CreditStakeEntry and burns the rest with BurnSlashed
 func (k Keeper) SlashEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, percentage sdk.Dec) (sdk.Coin, error) {
-	// TODO: jail user, and count problems
-	return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt()), nil
+	slashed := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	if percentage.IsNil() || percentage.IsNegative() || percentage.GT(sdk.OneDec()) {
+		return slashed, utils.LavaFormatWarning("slash_invalid_percentage", types.SlashPercentageError,
+			utils.LogAttr("percentage", percentage),
+		)
+	}
+
+	_, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return slashed, utils.LavaFormatWarning("slash_cant_get_stake_entry", types.DisciplineStakeEntryNotFoundError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+		)
+	}
+
+	// slash all the delegations or none of them
+	cacheCtx, writeCache := ctx.CacheContext()
+	amount, err := k.dualstakingKeeper.SlashDelegations(cacheCtx, account.String(), chainID, percentage)
+	if err != nil {
+		return slashed, utils.LavaFormatWarning("slash_delegations_failed", err,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", account.String()),
+			utils.LogAttr("percentage", percentage),
+		)
+	}
+	writeCache()
+	slashed.Amount = amount
+
+	details := map[string]string{
+		"providerAddress": account.String(),
+		"chainID":         chainID,
+		"percentage":      percentage.String(),
+		"slashed":         slashed.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SlashEntryEventName, details, "Provider Slashed")
+	return slashed, nil
+}
+
+// BurnSlashed burns the part of slashed stake that was not paid as rewards
+func (k Keeper) BurnSlashed(ctx sdk.Context, amount sdk.Coin) error {
+	return k.dualstakingKeeper.BurnSlashed(ctx, amount)
 }
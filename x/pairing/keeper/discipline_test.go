@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/sigs"
+	dualstakingtypes "github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that a jailed provider is removed from the pairing and is back after posting the bail
+func TestJailAndBail(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(2, 1, 2) // 2 providers, 1 client, 2 providers-to-pair
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	validatorAcct, _ := ts.GetAccount(common.VALIDATOR, 0)
+	validator := sdk.ValAddress(validatorAcct.Addr).String()
+
+	bail := common.NewCoin(ts.TokenDenom(), testStake/10)
+	jailBlocks := ts.EpochBlocks() * 10
+	err := ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcct.Addr, ts.spec.Index, ts.BlockHeight(), jailBlocks, bail)
+	require.NoError(t, err)
+
+	res, err := ts.QueryPairingJailedProviders(ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, res.StakeEntry, 1)
+	require.Equal(t, providerAddr, res.StakeEntry[0].Address)
+	require.Equal(t, uint64(1), res.StakeEntry[0].Jails)
+
+	// the jailed provider is removed from the pairing on the next epoch
+	ts.AdvanceEpoch()
+	pairing, err := ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	require.Len(t, pairing.Providers, 1)
+	require.NotEqual(t, providerAddr, pairing.Providers[0].Address)
+
+	// a bail lower than required fails
+	_, err = ts.TxPairingBailProvider(providerAddr, ts.spec.Index, bail.SubAmount(sdk.OneInt()), validator)
+	require.Error(t, err)
+
+	_, err = ts.TxPairingBailProvider(providerAddr, ts.spec.Index, bail, validator)
+	require.NoError(t, err)
+
+	res, err = ts.QueryPairingJailedProviders(ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, res.StakeEntry, 0)
+
+	// the bail is added to the stake
+	entry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	require.Equal(t, testStake+bail.Amount.Int64(), entry.Stake.Amount.Int64())
+
+	// a released provider can't post a bail again
+	_, err = ts.TxPairingBailProvider(providerAddr, ts.spec.Index, bail, validator)
+	require.Error(t, err)
+
+	ts.AdvanceEpoch()
+	pairing, err = ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	require.Len(t, pairing.Providers, 2)
+}
+
+// Test that a provider is not paid for relays on blocks it was jailed at
+func TestJailedProviderRelayPayment(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, _ := ts.GetAccount(common.CONSUMER, 0)
+	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	epoch := ts.EpochStart()
+	err := ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcct.Addr, ts.spec.Index, epoch, ts.EpochBlocks()*10, common.NewCoin(ts.TokenDenom(), 1))
+	require.NoError(t, err)
+
+	cuSum := ts.spec.ApiCollections[0].Apis[0].ComputeUnits * 10
+	relaySession := ts.newRelaySession(providerAddr, 0, cuSum, epoch, 0)
+	sig, err := sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	relaySession.Sig = sig
+
+	balance := ts.GetBalance(providerAcct.Addr)
+	// the only relay is rejected so the payment fails
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+	require.Equal(t, balance, ts.GetBalance(providerAcct.Addr))
+}
+
+// Test that a provider jailed mid-epoch is not paid for the relays of that epoch
+func TestJailedMidEpochProviderRelayPayment(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, _ := ts.GetAccount(common.CONSUMER, 0)
+	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	epoch := ts.EpochStart()
+	ts.AdvanceBlocks(ts.EpochBlocks() / 2)
+	err := ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcct.Addr, ts.spec.Index, ts.BlockHeight(), ts.EpochBlocks()*10, common.NewCoin(ts.TokenDenom(), 1))
+	require.NoError(t, err)
+
+	cuSum := ts.spec.ApiCollections[0].Apis[0].ComputeUnits * 10
+	relaySession := ts.newRelaySession(providerAddr, 0, cuSum, epoch, 0)
+	sig, err := sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	relaySession.Sig = sig
+
+	balance := ts.GetBalance(providerAcct.Addr)
+	// the relay carries the epoch start, before the jail, but the epoch is rejected as a whole
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+	require.Equal(t, balance, ts.GetBalance(providerAcct.Addr))
+}
+
+// Test that slashing burns the same percentage of the provider's stake and its delegations
+func TestSlashEntry(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	_, delegator := ts.AddAccount(common.CONSUMER, 1, testBalance)
+	delegated := common.NewCoin(ts.TokenDenom(), testStake)
+	_, err := ts.TxDualstakingDelegate(delegator, providerAddr, ts.spec.Index, delegated)
+	require.NoError(t, err)
+
+	// invalid percentage
+	_, err = ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcct.Addr, ts.spec.Index, sdk.NewDecWithPrec(11, 1))
+	require.Error(t, err)
+
+	slashed, err := ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcct.Addr, ts.spec.Index, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, (testStake+delegated.Amount.Int64())/10, slashed.Amount.Int64())
+
+	entry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	require.Equal(t, testStake*9/10, entry.Stake.Amount.Int64())
+	require.Equal(t, delegated.Amount.Int64()*9/10, entry.DelegateTotal.Amount.Int64())
+
+	// the slashed stake is held until it's paid as a reward or burned
+	slashedPool := keepertest.GetModuleAddress(dualstakingtypes.ModuleName)
+	poolBalance := ts.GetBalance(slashedPool)
+	require.GreaterOrEqual(t, poolBalance, slashed.Amount.Int64())
+
+	reward := slashed.SubAmount(slashed.Amount.QuoRaw(2))
+	ok, err := ts.Keepers.Pairing.CreditStakeEntry(ts.Ctx, ts.spec.Index, providerAcct.Addr, reward)
+	require.True(t, ok)
+	require.NoError(t, err)
+	rewards, err := ts.QueryDualstakingDelegatorRewards(providerAddr, providerAddr, ts.spec.Index)
+	require.NoError(t, err)
+	require.Len(t, rewards.Rewards, 1)
+	require.Equal(t, reward, rewards.Rewards[0].Amount)
+
+	require.NoError(t, ts.Keepers.Pairing.BurnSlashed(ts.Ctx, slashed.Sub(reward)))
+	require.Equal(t, poolBalance-slashed.Sub(reward).Amount.Int64(), ts.GetBalance(slashedPool))
+
+	// a provider that is not staked can't be credited
+	_, unstaked := ts.AddAccount(common.CONSUMER, 2, testBalance)
+	ok, err = ts.Keepers.Pairing.CreditStakeEntry(ts.Ctx, ts.spec.Index, sdk.MustAccAddressFromBech32(unstaked), reward)
+	require.False(t, ok)
+	require.Error(t, err)
+}
+
+// Test that a provider jailed without a bail can't be released by posting one
+func TestBailWithoutJailBail(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	validatorAcct, _ := ts.GetAccount(common.VALIDATOR, 0)
+	validator := sdk.ValAddress(validatorAcct.Addr).String()
+
+	err := ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcct.Addr, ts.spec.Index, ts.BlockHeight(), ts.EpochBlocks()*10, common.NewCoin(ts.TokenDenom(), 1))
+	require.NoError(t, err)
+	entry, found, index := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	entry.Bail = nil
+	ts.Keepers.Epochstorage.ModifyStakeEntryCurrent(ts.Ctx, ts.spec.Index, entry, index)
+
+	_, err = ts.TxPairingBailProvider(providerAddr, ts.spec.Index, common.NewCoin(ts.TokenDenom(), testStake), validator)
+	require.ErrorIs(t, err, types.BailNotSetError)
+	entry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	require.True(t, entry.IsJailed(uint64(ts.BlockHeight())))
+}
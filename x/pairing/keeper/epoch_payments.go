@@ -123,7 +123,6 @@ func (k Keeper) RemoveAllEpochPaymentsForBlockAppendAdjustments(ctx sdk.Context,
 		return
 	}
 
-	// TODO: update Qos in providerQosFS. new consumers (cluster.subUsage = 0) get default QoS (what is default?)
 	consumerUsage := map[string]uint64{}
 	type couplingConsumerProvider struct {
 		consumer string
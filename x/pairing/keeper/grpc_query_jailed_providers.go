@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) JailedProviders(goCtx context.Context, req *types.QueryJailedProvidersRequest) (*types.QueryJailedProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakeStorage, found := k.epochStorageKeeper.GetStakeStorageCurrent(ctx, req.ChainID)
+	if !found {
+		return &types.QueryJailedProvidersResponse{StakeEntry: []epochstoragetypes.StakeEntry{}}, nil
+	}
+
+	jailed := []epochstoragetypes.StakeEntry{}
+	for _, stakeEntry := range stakeStorage.GetStakeEntries() {
+		if stakeEntry.IsJailed(uint64(ctx.BlockHeight())) {
+			jailed = append(jailed, stakeEntry)
+		}
+	}
+
+	return &types.QueryJailedProvidersResponse{StakeEntry: jailed}, nil
+}
@@ -46,8 +46,8 @@ func (k Keeper) SubscriptionMonthlyPayout(goCtx context.Context, req *types.Quer
 		if !found {
 			continue
 		}
-		totalTokenAmount := plan.Price.Amount
-		totalCuTracked := subObj.MonthCuTotal - subObj.MonthCuLeft
+		totalTokenAmount := subObj.MonthReward(plan)
+		totalCuTracked := subObj.MonthCuTotal - subObj.MonthCuLeft + subObj.MonthCuOveruse
 		// Sanity check - totalCuTracked > 0
 		if totalCuTracked <= 0 {
 			return nil, utils.LavaFormatWarning("totalCuTracked is zero or negative", fmt.Errorf("critical: Attempt to divide by zero or negative number"),
@@ -57,9 +57,10 @@ func (k Keeper) SubscriptionMonthlyPayout(goCtx context.Context, req *types.Quer
 			)
 		}
 
-		if plan.Price.Amount.Quo(sdk.NewIntFromUint64(totalCuTracked)).GT(sdk.NewIntFromUint64(subsciption.LIMIT_TOKEN_PER_CU)) {
+		if totalTokenAmount.Quo(sdk.NewIntFromUint64(totalCuTracked)).GT(sdk.NewIntFromUint64(subsciption.LIMIT_TOKEN_PER_CU)) {
 			totalTokenAmount = sdk.NewIntFromUint64(subsciption.LIMIT_TOKEN_PER_CU * totalCuTracked)
 		}
+		totalTokenAmount = totalTokenAmount.Add(subObj.OverusePayment(plan))
 
 		totalMonthlyReward := k.subscriptionKeeper.CalcTotalMonthlyReward(ctx, totalTokenAmount, providerCu, totalCuTracked)
 
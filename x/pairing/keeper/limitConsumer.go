@@ -7,6 +7,7 @@ import (
 
 	"github.com/lavanet/lava/utils"
 	planstypes "github.com/lavanet/lava/x/plans/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
 )
 
 func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, allowedCU, totalCUInEpochForUserProvider uint64, clientAddr sdk.AccAddress, chainID string, epoch uint64) (uint64, error) {
@@ -20,19 +21,21 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, allowedCU
 		return 0, err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
-	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
-
 	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
 	if !found {
 		return 0, utils.LavaFormatError("can't find subscription", fmt.Errorf("EnforceClientCUsUsageInEpoch_cant_find_subscription"), utils.Attribute{Key: "subscriptionKey", Value: project.GetSubscription()})
 	}
 
-	if sub.GetMonthCuLeft() == 0 {
+	planPolicy := planPolicyWithOveruse(plan, sub)
+	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
+
+	// subscriptions of plans that allow overuse keep being served beyond the monthly allowance
+	cuLeft := sub.GetMonthCuLeft() + sub.OveruseCuLeft(plan)
+	if cuLeft == 0 {
 		return 0, utils.LavaFormatError("total cu in epoch for consumer exceeded the amount of CU left in the subscription", fmt.Errorf("consumer CU limit exceeded for subscription"), []utils.Attribute{{Key: "subscriptionCuLeft", Value: sub.GetMonthCuLeft()}}...)
 	}
 
-	_, effectiveTotalCu := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.UsedCu, sub.MonthCuLeft)
+	_, effectiveTotalCu := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.UsedCu, cuLeft)
 	if !planstypes.VerifyTotalCuUsage(effectiveTotalCu, totalCUInEpochForUserProvider) {
 		return effectiveTotalCu - project.UsedCu, nil
 	}
@@ -45,3 +48,13 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, allowedCU
 
 	return relayCU, nil
 }
+
+// planPolicyWithOveruse returns the plan policy with the total CU limit of the project
+// extended by the overuse of the subscription (used and still available)
+func planPolicyWithOveruse(plan planstypes.Plan, sub subscriptiontypes.Subscription) planstypes.Policy {
+	planPolicy := plan.GetPlanPolicy()
+	if planPolicy.TotalCuLimit != 0 {
+		planPolicy.TotalCuLimit += sub.MonthCuOveruse + sub.OveruseCuLeft(plan)
+	}
+	return planPolicy
+}
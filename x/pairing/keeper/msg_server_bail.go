@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) BailProvider(goCtx context.Context, msg *types.MsgBailProvider) (*types.MsgBailProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddr, err := sdk.AccAddressFromBech32(msg.GetCreator())
+	if err != nil {
+		return nil, utils.LavaFormatWarning("Bail_get_provider_address", err, utils.Attribute{Key: "providerAddress", Value: msg.GetCreator()})
+	}
+
+	err = k.Keeper.BailEntry(ctx, providerAddr, msg.ChainID, msg.Validator, msg.Bail)
+	return &types.MsgBailProviderResponse{}, err
+}
@@ -101,6 +101,28 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			continue
 		}
 
+		// jailed providers are not paid for relays served during the jail period. The relay only
+		// carries its epoch, so the whole epoch in which the provider was jailed is rejected
+		epochEnd, err := k.getEpochEnd(ctx, uint64(relay.Epoch))
+		if err != nil {
+			utils.LavaFormatWarning("failed to get the end of the relay epoch", err,
+				utils.Attribute{Key: "relayBlock", Value: relay.Epoch},
+			)
+			continue
+		}
+		stakeEntry, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, relay.SpecId, providerAddr)
+		if found && stakeEntry.IsJailedDuring(uint64(relay.Epoch), epochEnd) {
+			utils.LavaFormatWarning("relay payment rejected", types.ProviderJailedError,
+				utils.Attribute{Key: "provider", Value: providerAddr.String()},
+				utils.Attribute{Key: "chainID", Value: relay.SpecId},
+				utils.Attribute{Key: "relayBlock", Value: relay.Epoch},
+				utils.Attribute{Key: "epochEnd", Value: epochEnd},
+				utils.Attribute{Key: "jailStartBlock", Value: stakeEntry.JailStartBlock},
+				utils.Attribute{Key: "jailEndBlock", Value: stakeEntry.JailEndBlock},
+			)
+			continue
+		}
+
 		clientAddr, err := sigs.ExtractSignerAddress(relay)
 		if err != nil {
 			utils.LavaFormatWarning("recover PubKey from relay failed", err,
@@ -230,6 +252,20 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			details["ExcellenceQoSLatency"] = relay.QosExcellenceReport.Latency.String()
 			details["ExcellenceQoSAvailability"] = relay.QosExcellenceReport.Availability.String()
 			details["ExcellenceQoSSync"] = relay.QosExcellenceReport.Sync.String()
+
+			// an invalid report only skips the QoS update, the relay is still paid. reports of a provider's own
+			// subscription are ignored so providers can't rate themselves
+			sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+			selfPaired := clientAddr.String() == relay.Provider || sub.Consumer == relay.Provider || sub.Creator == relay.Provider
+			if found && !selfPaired {
+				err = k.UpdateProviderQos(ctx, relay.SpecId, sub.Cluster, relay.Provider, *relay.QosExcellenceReport, rewardedCU)
+				if err != nil && !types.InvalidQosExcellenceReportError.Is(err) {
+					return nil, utils.LavaFormatError("failed updating the provider QoS excellence", err,
+						utils.Attribute{Key: "provider", Value: relay.Provider},
+						utils.Attribute{Key: "chainID", Value: relay.SpecId},
+					)
+				}
+			}
 		}
 
 		details["projectID"] = project.Index
@@ -103,9 +103,44 @@ func (k Keeper) GetProjectData(ctx sdk.Context, developerKey sdk.AccAddress, cha
 		)
 	}
 
+	if projectKey := project.GetKey(developerKey.String()); projectKey.Expiry != 0 {
+		// the pairing (and the relays paid with it) is valid for the whole epoch, so a
+		// key that expires before the epoch ends can't be used in that epoch at all
+		epochEnd, err := k.getEpochEnd(ctx, blockHeight)
+		if err != nil {
+			return projectstypes.Project{}, err
+		}
+		if projectKey.IsExpired(epochEnd) {
+			return projectstypes.Project{}, utils.LavaFormatWarning("the developer key is expired", fmt.Errorf("cannot get project data"),
+				utils.Attribute{Key: "project", Value: project.Index},
+				utils.Attribute{Key: "developer", Value: developerKey.String()},
+				utils.Attribute{Key: "expiry", Value: projectKey.Expiry},
+				utils.Attribute{Key: "block", Value: blockHeight},
+				utils.Attribute{Key: "epochEnd", Value: epochEnd},
+			)
+		}
+	}
+
 	return project, nil
 }
 
+// getEpochEnd returns the last block of the epoch of a given block
+func (k Keeper) getEpochEnd(ctx sdk.Context, block uint64) (uint64, error) {
+	epochStart, _, err := k.epochStorageKeeper.GetEpochStartForBlock(ctx, block)
+	if err != nil {
+		return 0, utils.LavaFormatWarning("cannot get epoch start", err,
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+	epochBlocks, err := k.epochStorageKeeper.EpochBlocks(ctx, epochStart)
+	if err != nil {
+		return 0, utils.LavaFormatWarning("cannot get epoch blocks", err,
+			utils.Attribute{Key: "block", Value: epochStart},
+		)
+	}
+	return epochStart + epochBlocks - 1, nil
+}
+
 func (k Keeper) GetPairingForClient(ctx sdk.Context, chainID string, clientAddress sdk.AccAddress) (providers []epochstoragetypes.StakeEntry, errorRet error) {
 	project, err := k.GetProjectData(ctx, clientAddress, chainID, uint64(ctx.BlockHeight()))
 	if err != nil {
@@ -181,7 +216,12 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
+	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	if !found {
+		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+	}
+
+	planPolicy := planPolicyWithOveruse(plan, sub)
 	policies := []*planstypes.Policy{&planPolicy}
 	if project.SubscriptionPolicy != nil {
 		policies = append(policies, project.SubscriptionPolicy)
@@ -203,11 +243,7 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
-	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
-	if !found {
-		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
-	}
-	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft()+sub.OveruseCuLeft(plan))
 
 	selectedProvidersMode, selectedProvidersList := k.CalculateEffectiveSelectedProviders(policies)
 
@@ -188,6 +188,144 @@ func TestRelayPaymentSubscriptionCU(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestRelayPaymentSubscriptionOveruse(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+
+	// deposit for overuse of up to 1000 CU
+	overuseCap := uint64(1000)
+	err := ts.TxSubscriptionDepositOveruse(client1Addr, client1Addr, int64(overuseCap*ts.plan.OveruseRate), overuseCap)
+	require.NoError(t, err)
+
+	// waste all the subscription's CU
+	totalCuLimit := ts.plan.PlanPolicy.TotalCuLimit
+	epochCuLimit := ts.plan.PlanPolicy.EpochCuLimit
+
+	i := 0
+	for ; uint64(i) < totalCuLimit/epochCuLimit; i++ {
+		relaySession := ts.newRelaySession(providerAddr, uint64(i), epochCuLimit, ts.BlockHeight(), 0)
+		relaySession.Sig, err = sigs.Sign(client1Acct.SK, *relaySession)
+		require.NoError(t, err)
+		_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+		require.NoError(t, err)
+
+		ts.AdvanceEpoch()
+	}
+
+	sub, err := ts.QuerySubscriptionCurrent(client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sub.Sub.MonthCuLeft)
+
+	// the consumer is still served beyond the monthly allowance
+	relaySession := ts.newRelaySession(providerAddr, uint64(i), 600, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(client1Acct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	sub, err = ts.QuerySubscriptionCurrent(client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(600), sub.Sub.MonthCuOveruse)
+	require.Equal(t, (overuseCap-600)*ts.plan.OveruseRate, sub.Sub.OveruseDeposit)
+
+	// the payout to the provider includes the overuse payment
+	subRes, err := ts.QueryPairingSubscriptionMonthlyPayout(client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, ts.plan.Price.Amount.Uint64()+600*ts.plan.OveruseRate, subRes.Total)
+
+	ts.AdvanceEpoch()
+
+	// only the CU up to the overuse cap are paid
+	relaySession = ts.newRelaySession(providerAddr, uint64(i+1), 600, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(client1Acct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	sub, err = ts.QuerySubscriptionCurrent(client1Addr)
+	require.NoError(t, err)
+	require.Equal(t, overuseCap, sub.Sub.MonthCuOveruse)
+	require.Equal(t, uint64(0), sub.Sub.OveruseDeposit)
+
+	ts.AdvanceEpoch()
+
+	// CU beyond the overuse cap are not served
+	relaySession = ts.newRelaySession(providerAddr, uint64(i+2), 600, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(client1Acct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+}
+
+func TestRelayPaymentDeveloperKeyExpiry(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	devAcct := common.CreateNewAccount(ts.GoCtx, *ts.Keepers, 10000)
+	devAddr := devAcct.Addr.String()
+
+	// the developer key expires at the last block of the epoch
+	epoch := ts.EpochStart()
+	pk := projectstypes.ProjectDeveloperKey(devAddr)
+	pk.Expiry = epoch + ts.EpochBlocks() - 1
+	err := ts.TxProjectAddKeys(projectstypes.ProjectIndex(client1Addr, projectstypes.ADMIN_PROJECT_NAME), client1Addr, pk)
+	require.NoError(t, err)
+
+	_, err = ts.QueryPairingVerifyPairing(ts.spec.Index, devAddr, providerAddr, epoch)
+	require.NoError(t, err)
+
+	relaySession := ts.newRelaySession(providerAddr, 0, 100, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(devAcct.SK, *relaySession)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// relays of the epoch in which the key was valid are still paid
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	// the key can't be used from the next epoch
+	_, err = ts.QueryPairingVerifyPairing(ts.spec.Index, devAddr, providerAddr, ts.EpochStart())
+	require.Error(t, err)
+
+	relaySession = ts.newRelaySession(providerAddr, 1, 100, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(devAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+}
+
+func TestRelayPaymentDeveloperKeyExpiryMidEpoch(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	devAcct := common.CreateNewAccount(ts.GoCtx, *ts.Keepers, 10000)
+	devAddr := devAcct.Addr.String()
+
+	// the developer key expires in the middle of the epoch
+	pk := projectstypes.ProjectDeveloperKey(devAddr)
+	pk.Expiry = ts.BlockHeight() + 1
+	err := ts.TxProjectAddKeys(projectstypes.ProjectIndex(client1Addr, projectstypes.ADMIN_PROJECT_NAME), client1Addr, pk)
+	require.NoError(t, err)
+
+	// the pairing is valid for the whole epoch, so the key can't be used already
+	_, err = ts.QueryPairingVerifyPairing(ts.spec.Index, devAddr, providerAddr, ts.EpochStart())
+	require.Error(t, err)
+
+	relaySession := ts.newRelaySession(providerAddr, 0, 100, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(devAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+}
+
 func TestStrictestPolicyGeolocation(t *testing.T) {
 	ts := newTester(t)
 
@@ -1074,8 +1074,9 @@ func TestGeolocationPairingScores(t *testing.T) {
 			cluster := subRes.Sub.Cluster
 
 			for i := range stakeEntries {
-				// TODO: require err to be nil once the providerQosFS's update is implemented
-				qos, _ := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, stakeEntries[i].Address)
+				// no relays were paid so the providers have no QoS aggregate
+				qos, found := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, stakeEntries[i].Address, ts.EpochStart())
+				require.False(t, found)
 				providerScore := pairingscores.NewPairingScore(&stakeEntries[i], qos)
 				providerScores = append(providerScores, providerScore)
 			}
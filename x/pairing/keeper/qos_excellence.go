@@ -1,27 +1,101 @@
 package keeper
 
 import (
-	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
+	pairingscores "github.com/lavanet/lava/x/pairing/keeper/scores"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) UpdateProviderQos(epochPayments pairingtypes.EpochPayments) {
+// the weight of a new QoS excellence report in the provider's aggregated report is QosReportWeight for every
+// QosReportWeightCu compute units paid with the report, up to QosReportMaxWeight. so a consumer can't move the
+// aggregate with many cheap relays
+var (
+	QosReportWeight    = sdk.NewDecWithPrec(1, 1) // 0.1
+	QosReportWeightCu  = uint64(100)
+	QosReportMaxWeight = sdk.NewDecWithPrec(5, 1) // 0.5
+)
+
+func qosReportWeight(cu uint64) sdk.Dec {
+	weight := QosReportWeight.MulInt64(int64(cu)).QuoInt64(int64(QosReportWeightCu))
+	return sdk.MinDec(weight, QosReportMaxWeight)
 }
 
-// GetQos gets a provider's QoS excellence report from the providerQosFS
-func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error) {
-	var qos pairingtypes.QualityOfServiceReport
-	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
-	found := k.providerQosFS.FindEntry(ctx, key, uint64(ctx.BlockHeight()), &qos)
-	if !found {
-		return qos, utils.LavaFormatWarning("provider of chain and cluster was not found in the store", fmt.Errorf("qos not found"),
+// UpdateProviderQos folds a consumer's QoS excellence report, weighted by the compute units paid with it, into the provider's
+// aggregated report of the consumer's cluster. the aggregate is saved for the next epoch so the pairing of the current epoch
+// is not affected
+func (k Keeper) UpdateProviderQos(ctx sdk.Context, chainID string, cluster string, provider string, report pairingtypes.QualityOfServiceReport, cu uint64) error {
+	invalid := report.Latency.IsNil() || report.Availability.IsNil() || report.Sync.IsNil() || report.Availability.GT(sdk.OneDec())
+	if !invalid {
+		_, err := report.ComputeQoSExcellence()
+		invalid = err != nil
+	}
+	if invalid {
+		return utils.LavaFormatWarning("invalid QoS excellence report", pairingtypes.InvalidQosExcellenceReportError,
 			utils.Attribute{Key: "provider", Value: provider},
 			utils.Attribute{Key: "chainID", Value: chainID},
-			utils.Attribute{Key: "cluster", Value: cluster},
+			utils.Attribute{Key: "report", Value: report.String()},
 		)
 	}
-	return qos, nil
+
+	nextEpoch, err := k.epochStorageKeeper.GetNextEpoch(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return utils.LavaFormatError("failed to get next epoch for QoS update", err)
+	}
+
+	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
+	var qos pairingtypes.QualityOfServiceReport
+	if k.providerQosFS.FindEntry(ctx, key, nextEpoch, &qos) {
+		// aggregate = (1 - weight) * aggregate + weight * report
+		weight := qosReportWeight(cu)
+		if weight.IsZero() {
+			return nil
+		}
+		oldWeight := sdk.OneDec().Sub(weight)
+		qos.Latency = qos.Latency.Mul(oldWeight).Add(report.Latency.Mul(weight))
+		qos.Availability = qos.Availability.Mul(oldWeight).Add(report.Availability.Mul(weight))
+		qos.Sync = qos.Sync.Mul(oldWeight).Add(report.Sync.Mul(weight))
+	} else {
+		qos = report
+	}
+
+	return k.providerQosFS.AppendEntry(ctx, key, nextEpoch, &qos)
+}
+
+// GetQos gets a provider's QoS excellence report from the providerQosFS
+func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (pairingtypes.QualityOfServiceReport, bool) {
+	var qos pairingtypes.QualityOfServiceReport
+	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
+	found := k.providerQosFS.FindEntry(ctx, key, block, &qos)
+	return qos, found
+}
+
+// GetProvidersQos gets the QoS excellence reports of all the providers (or a specific provider) of a chain in all clusters
+func (k Keeper) GetProvidersQos(ctx sdk.Context, chainID string, provider string) []pairingtypes.ProviderQos {
+	providersQos := []pairingtypes.ProviderQos{}
+	block := uint64(ctx.BlockHeight())
+	for _, key := range k.providerQosFS.GetAllEntryIndicesWithPrefix(ctx, chainID+"/") {
+		// key format: chainID/cluster/provider
+		parts := strings.Split(key, "/")
+		if len(parts) != 3 || parts[0] != chainID {
+			continue
+		}
+		cluster, keyProvider := parts[1], parts[2]
+		if provider != "" && keyProvider != provider {
+			continue
+		}
+		qos, found := k.GetQos(ctx, chainID, cluster, keyProvider, block)
+		if !found {
+			continue
+		}
+		providersQos = append(providersQos, pairingtypes.ProviderQos{
+			Provider: keyProvider,
+			Cluster:  cluster,
+			Qos:      qos,
+			Score:    pairingscores.QosScore(qos),
+		})
+	}
+	return providersQos
 }
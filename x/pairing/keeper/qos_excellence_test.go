@@ -2,16 +2,136 @@ package keeper_test
 
 import (
 	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/sigs"
+	pairingscores "github.com/lavanet/lava/x/pairing/keeper/scores"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
 )
 
-// TODO: All tests are not implemented since providerQosFS update and Qos score are not implemented yet
+func newQosReport(latency, availability, sync string) *pairingtypes.QualityOfServiceReport {
+	return &pairingtypes.QualityOfServiceReport{
+		Latency:      sdk.MustNewDecFromStr(latency),
+		Availability: sdk.MustNewDecFromStr(availability),
+		Sync:         sdk.MustNewDecFromStr(sync),
+	}
+}
+
+// payWithQos pays for a relay of the provider that carries a QoS excellence report
+func (ts *tester) payWithQos(providerIdx int, session uint64, qos *pairingtypes.QualityOfServiceReport) {
+	clientAcct, _ := ts.GetAccount(common.CONSUMER, 0)
+	ts.payWithQosCu(clientAcct, providerIdx, session, ts.spec.ApiCollections[0].Apis[0].ComputeUnits, qos)
+}
+
+func (ts *tester) payWithQosCu(clientAcct sigs.Account, providerIdx int, session uint64, cuSum uint64, qos *pairingtypes.QualityOfServiceReport) {
+	_, providerAddr := ts.GetAccount(common.PROVIDER, providerIdx)
+
+	relaySession := ts.newRelaySession(providerAddr, session, cuSum, ts.BlockHeight(), 0)
+	relaySession.QosExcellenceReport = qos
+	sig, err := sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(ts.T, err)
+	relaySession.Sig = sig
+
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(ts.T, err)
+}
+
+func (ts *tester) clientCluster() string {
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	res, err := ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(ts.T, err)
+	return res.Sub.Cluster
+}
 
 // TestProviderQosMap checks that getting a providers' Qos map for specific chainID and cluster works properly
 func TestProviderQosMap(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(2, 1, 0) // 2 providers, 1 client, default providers-to-pair
+
+	_, provider0 := ts.GetAccount(common.PROVIDER, 0)
+	ts.payWithQos(0, 1, newQosReport("0.5", "1", "1"))
+	ts.payWithQos(1, 2, newQosReport("1", "1", "1"))
+	ts.AdvanceEpoch()
+
+	res, err := ts.QueryPairingProvidersQos(ts.spec.Index, "")
+	require.NoError(t, err)
+	require.Len(t, res.ProvidersQos, 2)
+	for _, providerQos := range res.ProvidersQos {
+		require.Equal(t, ts.clientCluster(), providerQos.Cluster)
+	}
+
+	res, err = ts.QueryPairingProvidersQos(ts.spec.Index, provider0)
+	require.NoError(t, err)
+	require.Len(t, res.ProvidersQos, 1)
+	require.Equal(t, provider0, res.ProvidersQos[0].Provider)
+	require.True(t, res.ProvidersQos[0].Score.GT(sdk.OneDec()))
+
+	res, err = ts.QueryPairingProvidersQos("otherChain", "")
+	require.NoError(t, err)
+	require.Len(t, res.ProvidersQos, 0)
 }
 
 // TestGetQos checks that using GetQos() returns the right Qos
 func TestGetQos(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	_, provider := ts.GetAccount(common.PROVIDER, 0)
+	cluster := ts.clientCluster()
+
+	ts.payWithQos(0, 1, newQosReport("0.5", "1", "1"))
+
+	// the aggregate is applied on the next epoch, so the current pairing doesn't change
+	_, found := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.EpochStart())
+	require.False(t, found)
+
+	ts.AdvanceEpoch()
+	qos, found := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.EpochStart())
+	require.True(t, found)
+	require.Equal(t, *newQosReport("0.5", "1", "1"), qos)
+
+	// a new report is folded into the aggregate
+	ts.payWithQos(0, 2, newQosReport("1.5", "1", "1"))
+	ts.AdvanceEpoch()
+	qos, found = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.EpochStart())
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), qos.Latency) // 0.9 * 0.5 + 0.1 * 1.5
+
+	// invalid reports are ignored (the relay is still paid)
+	ts.payWithQos(0, 3, newQosReport("0", "1", "1"))
+	ts.payWithQos(0, 4, newQosReport("1", "2", "1"))
+	ts.AdvanceEpoch()
+	qos, found = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.EpochStart())
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), qos.Latency)
+
+	// reports are weighted by the compute units paid with them, up to QosReportMaxWeight
+	clientAcct, _ := ts.GetAccount(common.CONSUMER, 0)
+	ts.payWithQosCu(clientAcct, 0, 5, 300, newQosReport("1.6", "1", "1"))
+	ts.AdvanceEpoch()
+	qos, _ = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.EpochStart())
+	require.Equal(t, sdk.MustNewDecFromStr("0.9"), qos.Latency) // 0.7 * 0.6 + 0.3 * 1.6
+	ts.payWithQosCu(clientAcct, 0, 6, 1000, newQosReport("1.9", "1", "1"))
+	ts.AdvanceEpoch()
+	qos, _ = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.EpochStart())
+	require.Equal(t, sdk.MustNewDecFromStr("1.4"), qos.Latency) // 0.5 * 0.9 + 0.5 * 1.9
+}
+
+// TestSelfPairedQos checks that a provider's reports on itself don't change its QoS
+func TestSelfPairedQos(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 0, 0) // 1 provider, no clients, default providers-to-pair
+
+	providerAcct, provider := ts.GetAccount(common.PROVIDER, 0)
+	_, err := ts.TxSubscriptionBuy(provider, provider, ts.plan.Index, 1, false, false)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	ts.payWithQosCu(providerAcct, 0, 1, 100, newQosReport("0.5", "1", "1"))
+	ts.AdvanceEpoch()
+	require.Empty(t, ts.Keepers.Pairing.GetProvidersQos(ts.Ctx, ts.spec.Index, provider))
 }
 
 // TestQosReqForSlots checks that if Qos req is active, all slots are assigned with Qos req
@@ -25,6 +145,28 @@ func TestQosScoreCluster(t *testing.T) {
 // TestQosScore checks that the qos score component is as expected (score == ComputeQos(), new users (sub usage less
 // than a month) are not infuenced by Qos score, invalid Qos score == 1)
 func TestQosScore(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(2, 1, 0) // 2 providers, 1 client, default providers-to-pair
+
+	_, provider0 := ts.GetAccount(common.PROVIDER, 0)
+	_, provider1 := ts.GetAccount(common.PROVIDER, 1)
+	cluster := ts.clientCluster()
+
+	// provider0 is faster than average and provider1 is slower
+	ts.payWithQos(0, 1, newQosReport("0.5", "1", "1"))
+	ts.payWithQos(1, 2, newQosReport("2", "0.9", "1.5"))
+	ts.AdvanceEpoch()
+
+	qosReq := pairingscores.QosReq{}
+	score := func(provider string) sdk.Uint {
+		qos, found := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.EpochStart())
+		require.True(t, found)
+		return qosReq.Score(pairingscores.PairingScore{QosExcellenceReport: qos})
+	}
+	neutral := qosReq.Score(pairingscores.PairingScore{})
+
+	require.True(t, score(provider0).GT(neutral))
+	require.True(t, score(provider1).LT(neutral))
 }
 
 // TestUpdateClusteringCriteria checks that updating the clustering criteria doesn't make different version clusters to be mixed
@@ -9,8 +9,15 @@ import (
 
 const qosReqName = "qos-req"
 
+var (
+	MinQosScore = sdk.NewDecWithPrec(5, 1) // 0.5
+	MaxQosScore = sdk.NewDec(2)
+	// score components are integers, the qos score is scaled so 0.5-2 becomes 50-200
+	qosScoreScale = sdk.NewDec(100)
+)
+
 type QosGetter interface {
-	GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error)
+	GetQos(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (pairingtypes.QualityOfServiceReport, bool)
 }
 
 // QosReq implements the ScoreReq interface for provider staking requirement(s)
@@ -22,16 +29,29 @@ func (qr *QosReq) Init(policy planstypes.Policy) bool {
 
 // Score calculates the the provider's qos score
 func (qr *QosReq) Score(score PairingScore) math.Uint {
-	// TODO: update Qos in providerQosFS properly and uncomment this code below
-	// Also, the qos score should range between 0.5-2
+	qosScore := QosScore(score.QosExcellenceReport)
+	return sdk.NewUint(qosScore.Mul(qosScoreScale).TruncateInt().Uint64())
+}
+
+// QosScore maps a QoS excellence report to a weight in the 0.5-2 range.
+// an average provider's excellence is around 1, providers without a valid report get the neutral weight 1
+func QosScore(qos pairingtypes.QualityOfServiceReport) sdk.Dec {
+	if qos.Latency.IsNil() || qos.Availability.IsNil() || qos.Sync.IsNil() {
+		return sdk.OneDec()
+	}
 
-	// qosScore, err := score.QosExcellenceReport.ComputeQoS()
-	// if err != nil {
-	// 	return math.NewUint(1)
-	// }
+	excellence, err := qos.ComputeQoSExcellence()
+	if err != nil {
+		return sdk.OneDec()
+	}
 
-	// return math.Uint(qosScore)
-	return math.NewUint(1)
+	if excellence.LT(MinQosScore) {
+		return MinQosScore
+	}
+	if excellence.GT(MaxQosScore) {
+		return MaxQosScore
+	}
+	return excellence
 }
 
 func (qr *QosReq) GetName() string {
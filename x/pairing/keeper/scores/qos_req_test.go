@@ -0,0 +1,50 @@
+package scores
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQosScore(t *testing.T) {
+	newReport := func(latency, availability, sync string) pairingtypes.QualityOfServiceReport {
+		return pairingtypes.QualityOfServiceReport{
+			Latency:      sdk.MustNewDecFromStr(latency),
+			Availability: sdk.MustNewDecFromStr(availability),
+			Sync:         sdk.MustNewDecFromStr(sync),
+		}
+	}
+
+	// latency 0.5 -> excellence = cbrt(1 / 0.5)
+	goodScore, err := sdk.NewDec(2).ApproxRoot(3)
+	require.NoError(t, err)
+
+	templates := []struct {
+		name     string
+		report   pairingtypes.QualityOfServiceReport
+		expected sdk.Dec
+	}{
+		{"no report", pairingtypes.QualityOfServiceReport{}, sdk.OneDec()},
+		{"invalid report", newReport("0", "1", "1"), sdk.OneDec()},
+		{"average", newReport("1", "1", "1"), sdk.OneDec()},
+		{"good", newReport("0.5", "1", "1"), goodScore},
+		{"excellent", newReport("0.01", "1", "0.01"), MaxQosScore},
+		{"bad", newReport("10", "0.5", "10"), MinQosScore},
+	}
+
+	for _, tt := range templates {
+		t.Run(tt.name, func(t *testing.T) {
+			score := QosScore(tt.report)
+			require.True(t, tt.expected.Equal(score), "expected %s, got %s", tt.expected, score)
+			require.True(t, score.GTE(MinQosScore) && score.LTE(MaxQosScore))
+
+			qosReq := QosReq{}
+			pairingScore := PairingScore{QosExcellenceReport: tt.report}
+			require.Equal(t, sdk.NewUint(score.MulInt64(100).TruncateInt().Uint64()), qosReq.Score(pairingScore))
+			require.NotEqual(t, math.ZeroUint(), qosReq.Score(pairingScore))
+		})
+	}
+}
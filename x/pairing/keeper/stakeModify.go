@@ -2,9 +2,41 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	dualstakingtypes "github.com/lavanet/lava/x/dualstaking/types"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
+// CreditStakeEntry rewards the provider and its delegators on the chain with slashed stake, the reward is paid
+// from the dualstaking module account that holds the slashed tokens and can be claimed like relay rewards
 func (k Keeper) CreditStakeEntry(ctx sdk.Context, chainID string, lookUpAddress sdk.AccAddress, creditAmount sdk.Coin) (bool, error) {
-	// TODO: add a way to rewards for conflict
+	if creditAmount.IsZero() {
+		return true, nil
+	}
+	if creditAmount.Denom != k.stakingKeeper.BondDenom(ctx) {
+		return false, utils.LavaFormatWarning("credit_invalid_denom", types.CreditStakeEntryError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", lookUpAddress.String()),
+			utils.LogAttr("credit", creditAmount),
+		)
+	}
+
+	_, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, lookUpAddress)
+	if !found {
+		return false, utils.LavaFormatWarning("credit_cant_get_stake_entry", types.DisciplineStakeEntryNotFoundError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", lookUpAddress.String()),
+		)
+	}
+
+	_, _, err := k.dualstakingKeeper.RewardProvidersAndDelegators(ctx, lookUpAddress, chainID, creditAmount.Amount, dualstakingtypes.ModuleName, false, false, false)
+	if err != nil {
+		return false, utils.LavaFormatWarning("credit_reward_failed", types.CreditStakeEntryError,
+			utils.LogAttr("chainID", chainID),
+			utils.LogAttr("providerAddress", lookUpAddress.String()),
+			utils.LogAttr("credit", creditAmount),
+			utils.LogAttr("error", err),
+		)
+	}
 	return true, nil
 }
@@ -53,6 +53,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgUnfreeze int = 100
 
+	opWeightMsgBail = "op_weight_msg_bail"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgBail int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -178,6 +182,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pairingsimulation.SimulateMsgUnfreeze(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgBail int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgBail, &weightMsgBail, nil,
+		func(_ *rand.Rand) {
+			weightMsgBail = defaultWeightMsgBail
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBail,
+		pairingsimulation.SimulateMsgBail(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
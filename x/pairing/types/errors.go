@@ -24,4 +24,12 @@ var (
 	UnFreezeInsufficientStakeError                     = sdkerrors.New("UnFreezeInsufficientStakeError Error", 697, "Could not unfreeze provider due to insufficient stake. Stake must be above minimum stake to unfreeze")
 	InvalidCreatorAddressError                         = sdkerrors.New("InvalidCreatorAddressError Error", 698, "The creator address is invalid")
 	AmountCoinError                                    = sdkerrors.New("AmountCoinError Error", 699, "Amount limit coin is invalid")
+	DisciplineStakeEntryNotFoundError                  = sdkerrors.New("DisciplineStakeEntryNotFoundError Error", 700, "Can't get the stake entry of the disciplined provider")
+	ProviderNotJailedError                             = sdkerrors.New("ProviderNotJailedError Error", 701, "The provider is not jailed")
+	InsufficientBailError                              = sdkerrors.New("InsufficientBailError Error", 702, "The bail is lower than the bail the provider was jailed with")
+	SlashPercentageError                               = sdkerrors.New("SlashPercentageError Error", 703, "The slash percentage must be between 0 and 1")
+	ProviderJailedError                                = sdkerrors.New("ProviderJailedError Error", 704, "The provider was jailed at the relay block")
+	InvalidQosExcellenceReportError                    = sdkerrors.New("InvalidQosExcellenceReportError Error", 705, "The QoS excellence report is invalid")
+	BailNotSetError                                    = sdkerrors.New("BailNotSetError Error", 706, "The provider was jailed without a bail, it is released when the jail ends")
+	CreditStakeEntryError                              = sdkerrors.New("CreditStakeEntryError Error", 707, "Could not credit the provider's stake entry")
 )
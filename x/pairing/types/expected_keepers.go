@@ -105,6 +105,8 @@ type DualstakingKeeper interface {
 	RewardProvidersAndDelegators(ctx sdk.Context, providerAddr sdk.AccAddress, chainID string, totalReward math.Int, senderModule string, calcOnlyProvider bool, calcOnlyDelegators bool, calcOnlyContributer bool) (providerReward math.Int, totalRewards math.Int, err error)
 	DelegateFull(ctx sdk.Context, delegator string, validator string, provider string, chainID string, amount sdk.Coin) error
 	UnbondFull(ctx sdk.Context, delegator string, validator string, provider string, chainID string, amount sdk.Coin, unstake bool) error
+	SlashDelegations(ctx sdk.Context, provider string, chainID string, percentage sdk.Dec) (math.Int, error)
+	BurnSlashed(ctx sdk.Context, amount sdk.Coin) error
 }
 
 type FixationStoreKeeper interface {
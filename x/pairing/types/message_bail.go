@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBail = "bail"
+
+var _ sdk.Msg = &MsgBailProvider{}
+
+func NewMsgBail(creator, chainID string, bail sdk.Coin, validator string) *MsgBailProvider {
+	return &MsgBailProvider{
+		Creator:   creator,
+		ChainID:   chainID,
+		Bail:      bail,
+		Validator: validator,
+	}
+}
+
+func (msg *MsgBailProvider) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBailProvider) Type() string {
+	return TypeMsgBail
+}
+
+func (msg *MsgBailProvider) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBailProvider) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBailProvider) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+	if !msg.Bail.IsValid() || msg.Bail.IsZero() {
+		return sdkerrors.Wrapf(AmountCoinError, "invalid bail (%s)", msg.Bail)
+	}
+	return nil
+}
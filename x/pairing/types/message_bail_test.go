@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBail_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBailProvider
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBailProvider{
+				Creator:   "invalid_address",
+				Bail:      sdk.NewInt64Coin("ulava", 100),
+				Validator: sample.ValAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid validator",
+			msg: MsgBailProvider{
+				Creator:   sample.AccAddress(),
+				Bail:      sdk.NewInt64Coin("ulava", 100),
+				Validator: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "zero bail",
+			msg: MsgBailProvider{
+				Creator:   sample.AccAddress(),
+				Bail:      sdk.NewInt64Coin("ulava", 0),
+				Validator: sample.ValAddress(),
+			},
+			err: AmountCoinError,
+		}, {
+			name: "valid",
+			msg: MsgBailProvider{
+				Creator:   sample.AccAddress(),
+				Bail:      sdk.NewInt64Coin("ulava", 100),
+				Validator: sample.ValAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
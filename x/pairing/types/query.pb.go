@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -215,6 +216,251 @@ func (m *QueryProvidersResponse) GetStakeEntry() []types.StakeEntry {
 	return nil
 }
 
+type QueryJailedProvidersRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+}
+
+func (m *QueryJailedProvidersRequest) Reset()         { *m = QueryJailedProvidersRequest{} }
+func (m *QueryJailedProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJailedProvidersRequest) ProtoMessage()    {}
+func (*QueryJailedProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{4}
+}
+func (m *QueryJailedProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailedProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailedProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryJailedProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailedProvidersRequest.Merge(m, src)
+}
+func (m *QueryJailedProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailedProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailedProvidersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJailedProvidersRequest proto.InternalMessageInfo
+
+func (m *QueryJailedProvidersRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+type QueryJailedProvidersResponse struct {
+	StakeEntry []types.StakeEntry `protobuf:"bytes,1,rep,name=stakeEntry,proto3" json:"stakeEntry"`
+}
+
+func (m *QueryJailedProvidersResponse) Reset()         { *m = QueryJailedProvidersResponse{} }
+func (m *QueryJailedProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJailedProvidersResponse) ProtoMessage()    {}
+func (*QueryJailedProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{5}
+}
+func (m *QueryJailedProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryJailedProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryJailedProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryJailedProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailedProvidersResponse.Merge(m, src)
+}
+func (m *QueryJailedProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryJailedProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailedProvidersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJailedProvidersResponse proto.InternalMessageInfo
+
+func (m *QueryJailedProvidersResponse) GetStakeEntry() []types.StakeEntry {
+	if m != nil {
+		return m.StakeEntry
+	}
+	return nil
+}
+
+type QueryProvidersQosRequest struct {
+	ChainID  string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryProvidersQosRequest) Reset()         { *m = QueryProvidersQosRequest{} }
+func (m *QueryProvidersQosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersQosRequest) ProtoMessage()    {}
+func (*QueryProvidersQosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{6}
+}
+func (m *QueryProvidersQosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersQosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersQosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvidersQosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersQosRequest.Merge(m, src)
+}
+func (m *QueryProvidersQosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersQosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersQosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvidersQosRequest proto.InternalMessageInfo
+
+func (m *QueryProvidersQosRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *QueryProvidersQosRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type ProviderQos struct {
+	Provider string                                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Cluster  string                                 `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Qos      QualityOfServiceReport                 `protobuf:"bytes,3,opt,name=qos,proto3" json:"qos"`
+	Score    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+}
+
+func (m *ProviderQos) Reset()         { *m = ProviderQos{} }
+func (m *ProviderQos) String() string { return proto.CompactTextString(m) }
+func (*ProviderQos) ProtoMessage()    {}
+func (*ProviderQos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{7}
+}
+func (m *ProviderQos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderQos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderQos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderQos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderQos.Merge(m, src)
+}
+func (m *ProviderQos) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderQos) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderQos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderQos proto.InternalMessageInfo
+
+func (m *ProviderQos) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderQos) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *ProviderQos) GetQos() QualityOfServiceReport {
+	if m != nil {
+		return m.Qos
+	}
+	return QualityOfServiceReport{}
+}
+
+type QueryProvidersQosResponse struct {
+	ProvidersQos []ProviderQos `protobuf:"bytes,1,rep,name=providersQos,proto3" json:"providersQos"`
+}
+
+func (m *QueryProvidersQosResponse) Reset()         { *m = QueryProvidersQosResponse{} }
+func (m *QueryProvidersQosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersQosResponse) ProtoMessage()    {}
+func (*QueryProvidersQosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e149ce9d21da0d8, []int{8}
+}
+func (m *QueryProvidersQosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersQosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersQosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvidersQosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersQosResponse.Merge(m, src)
+}
+func (m *QueryProvidersQosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersQosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersQosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProvidersQosResponse proto.InternalMessageInfo
+
+func (m *QueryProvidersQosResponse) GetProvidersQos() []ProviderQos {
+	if m != nil {
+		return m.ProvidersQos
+	}
+	return nil
+}
+
 type QueryGetPairingRequest struct {
 	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	Client  string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
@@ -224,7 +470,7 @@ func (m *QueryGetPairingRequest) Reset()         { *m = QueryGetPairingRequest{}
 func (m *QueryGetPairingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPairingRequest) ProtoMessage()    {}
 func (*QueryGetPairingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{4}
+	return fileDescriptor_9e149ce9d21da0d8, []int{9}
 }
 func (m *QueryGetPairingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +525,7 @@ func (m *QueryGetPairingResponse) Reset()         { *m = QueryGetPairingResponse
 func (m *QueryGetPairingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPairingResponse) ProtoMessage()    {}
 func (*QueryGetPairingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{5}
+	return fileDescriptor_9e149ce9d21da0d8, []int{10}
 }
 func (m *QueryGetPairingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +600,7 @@ func (m *QueryVerifyPairingRequest) Reset()         { *m = QueryVerifyPairingReq
 func (m *QueryVerifyPairingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPairingRequest) ProtoMessage()    {}
 func (*QueryVerifyPairingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{6}
+	return fileDescriptor_9e149ce9d21da0d8, []int{11}
 }
 func (m *QueryVerifyPairingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,7 +668,7 @@ func (m *QueryVerifyPairingResponse) Reset()         { *m = QueryVerifyPairingRe
 func (m *QueryVerifyPairingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVerifyPairingResponse) ProtoMessage()    {}
 func (*QueryVerifyPairingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{7}
+	return fileDescriptor_9e149ce9d21da0d8, []int{12}
 }
 func (m *QueryVerifyPairingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +737,7 @@ func (m *QueryGetUniquePaymentStorageClientProviderRequest) String() string {
 }
 func (*QueryGetUniquePaymentStorageClientProviderRequest) ProtoMessage() {}
 func (*QueryGetUniquePaymentStorageClientProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{8}
+	return fileDescriptor_9e149ce9d21da0d8, []int{13}
 }
 func (m *QueryGetUniquePaymentStorageClientProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +785,7 @@ func (m *QueryGetUniquePaymentStorageClientProviderResponse) String() string {
 }
 func (*QueryGetUniquePaymentStorageClientProviderResponse) ProtoMessage() {}
 func (*QueryGetUniquePaymentStorageClientProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{9}
+	return fileDescriptor_9e149ce9d21da0d8, []int{14}
 }
 func (m *QueryGetUniquePaymentStorageClientProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +833,7 @@ func (m *QueryAllUniquePaymentStorageClientProviderRequest) String() string {
 }
 func (*QueryAllUniquePaymentStorageClientProviderRequest) ProtoMessage() {}
 func (*QueryAllUniquePaymentStorageClientProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{10}
+	return fileDescriptor_9e149ce9d21da0d8, []int{15}
 }
 func (m *QueryAllUniquePaymentStorageClientProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +882,7 @@ func (m *QueryAllUniquePaymentStorageClientProviderResponse) String() string {
 }
 func (*QueryAllUniquePaymentStorageClientProviderResponse) ProtoMessage() {}
 func (*QueryAllUniquePaymentStorageClientProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{11}
+	return fileDescriptor_9e149ce9d21da0d8, []int{16}
 }
 func (m *QueryAllUniquePaymentStorageClientProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +933,7 @@ func (m *QueryGetProviderPaymentStorageRequest) Reset()         { *m = QueryGetP
 func (m *QueryGetProviderPaymentStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProviderPaymentStorageRequest) ProtoMessage()    {}
 func (*QueryGetProviderPaymentStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{12}
+	return fileDescriptor_9e149ce9d21da0d8, []int{17}
 }
 func (m *QueryGetProviderPaymentStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +979,7 @@ func (m *QueryGetProviderPaymentStorageResponse) Reset() {
 func (m *QueryGetProviderPaymentStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProviderPaymentStorageResponse) ProtoMessage()    {}
 func (*QueryGetProviderPaymentStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{13}
+	return fileDescriptor_9e149ce9d21da0d8, []int{18}
 }
 func (m *QueryGetProviderPaymentStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +1023,7 @@ func (m *QueryAllProviderPaymentStorageRequest) Reset()         { *m = QueryAllP
 func (m *QueryAllProviderPaymentStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllProviderPaymentStorageRequest) ProtoMessage()    {}
 func (*QueryAllProviderPaymentStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{14}
+	return fileDescriptor_9e149ce9d21da0d8, []int{19}
 }
 func (m *QueryAllProviderPaymentStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,7 +1070,7 @@ func (m *QueryAllProviderPaymentStorageResponse) Reset() {
 func (m *QueryAllProviderPaymentStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllProviderPaymentStorageResponse) ProtoMessage()    {}
 func (*QueryAllProviderPaymentStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{15}
+	return fileDescriptor_9e149ce9d21da0d8, []int{20}
 }
 func (m *QueryAllProviderPaymentStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1121,7 @@ func (m *QueryGetEpochPaymentsRequest) Reset()         { *m = QueryGetEpochPayme
 func (m *QueryGetEpochPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochPaymentsRequest) ProtoMessage()    {}
 func (*QueryGetEpochPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{16}
+	return fileDescriptor_9e149ce9d21da0d8, []int{21}
 }
 func (m *QueryGetEpochPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1165,7 @@ func (m *QueryGetEpochPaymentsResponse) Reset()         { *m = QueryGetEpochPaym
 func (m *QueryGetEpochPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochPaymentsResponse) ProtoMessage()    {}
 func (*QueryGetEpochPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{17}
+	return fileDescriptor_9e149ce9d21da0d8, []int{22}
 }
 func (m *QueryGetEpochPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1209,7 @@ func (m *QueryAllEpochPaymentsRequest) Reset()         { *m = QueryAllEpochPayme
 func (m *QueryAllEpochPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochPaymentsRequest) ProtoMessage()    {}
 func (*QueryAllEpochPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{18}
+	return fileDescriptor_9e149ce9d21da0d8, []int{23}
 }
 func (m *QueryAllEpochPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,7 +1254,7 @@ func (m *QueryAllEpochPaymentsResponse) Reset()         { *m = QueryAllEpochPaym
 func (m *QueryAllEpochPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochPaymentsResponse) ProtoMessage()    {}
 func (*QueryAllEpochPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{19}
+	return fileDescriptor_9e149ce9d21da0d8, []int{24}
 }
 func (m *QueryAllEpochPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,7 +1307,7 @@ func (m *QueryUserEntryRequest) Reset()         { *m = QueryUserEntryRequest{} }
 func (m *QueryUserEntryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUserEntryRequest) ProtoMessage()    {}
 func (*QueryUserEntryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{20}
+	return fileDescriptor_9e149ce9d21da0d8, []int{25}
 }
 func (m *QueryUserEntryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1120,7 +1366,7 @@ func (m *QueryUserEntryResponse) Reset()         { *m = QueryUserEntryResponse{}
 func (m *QueryUserEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUserEntryResponse) ProtoMessage()    {}
 func (*QueryUserEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{21}
+	return fileDescriptor_9e149ce9d21da0d8, []int{26}
 }
 func (m *QueryUserEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1417,7 @@ func (m *QueryStaticProvidersListRequest) Reset()         { *m = QueryStaticProv
 func (m *QueryStaticProvidersListRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStaticProvidersListRequest) ProtoMessage()    {}
 func (*QueryStaticProvidersListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{22}
+	return fileDescriptor_9e149ce9d21da0d8, []int{27}
 }
 func (m *QueryStaticProvidersListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1461,7 @@ func (m *QueryStaticProvidersListResponse) Reset()         { *m = QueryStaticPro
 func (m *QueryStaticProvidersListResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStaticProvidersListResponse) ProtoMessage()    {}
 func (*QueryStaticProvidersListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{23}
+	return fileDescriptor_9e149ce9d21da0d8, []int{28}
 }
 func (m *QueryStaticProvidersListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1506,7 @@ func (m *QueryEffectivePolicyRequest) Reset()         { *m = QueryEffectivePolic
 func (m *QueryEffectivePolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEffectivePolicyRequest) ProtoMessage()    {}
 func (*QueryEffectivePolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{24}
+	return fileDescriptor_9e149ce9d21da0d8, []int{29}
 }
 func (m *QueryEffectivePolicyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1312,7 +1558,7 @@ func (m *QueryEffectivePolicyResponse) Reset()         { *m = QueryEffectivePoli
 func (m *QueryEffectivePolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEffectivePolicyResponse) ProtoMessage()    {}
 func (*QueryEffectivePolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{25}
+	return fileDescriptor_9e149ce9d21da0d8, []int{30}
 }
 func (m *QueryEffectivePolicyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1612,7 @@ func (m *QuerySdkPairingResponse) Reset()         { *m = QuerySdkPairingResponse
 func (m *QuerySdkPairingResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySdkPairingResponse) ProtoMessage()    {}
 func (*QuerySdkPairingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{26}
+	return fileDescriptor_9e149ce9d21da0d8, []int{31}
 }
 func (m *QuerySdkPairingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1431,7 +1677,7 @@ func (m *QueryProviderMonthlyPayoutRequest) Reset()         { *m = QueryProvider
 func (m *QueryProviderMonthlyPayoutRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProviderMonthlyPayoutRequest) ProtoMessage()    {}
 func (*QueryProviderMonthlyPayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{27}
+	return fileDescriptor_9e149ce9d21da0d8, []int{32}
 }
 func (m *QueryProviderMonthlyPayoutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1477,7 +1723,7 @@ func (m *SubscriptionPayout) Reset()         { *m = SubscriptionPayout{} }
 func (m *SubscriptionPayout) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionPayout) ProtoMessage()    {}
 func (*SubscriptionPayout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{28}
+	return fileDescriptor_9e149ce9d21da0d8, []int{33}
 }
 func (m *SubscriptionPayout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,7 +1782,7 @@ func (m *QueryProviderMonthlyPayoutResponse) Reset()         { *m = QueryProvide
 func (m *QueryProviderMonthlyPayoutResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProviderMonthlyPayoutResponse) ProtoMessage()    {}
 func (*QueryProviderMonthlyPayoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{29}
+	return fileDescriptor_9e149ce9d21da0d8, []int{34}
 }
 func (m *QueryProviderMonthlyPayoutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,7 +1834,7 @@ func (m *ProviderPayout) Reset()         { *m = ProviderPayout{} }
 func (m *ProviderPayout) String() string { return proto.CompactTextString(m) }
 func (*ProviderPayout) ProtoMessage()    {}
 func (*ProviderPayout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{30}
+	return fileDescriptor_9e149ce9d21da0d8, []int{35}
 }
 func (m *ProviderPayout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1886,7 @@ func (m *ChainIDPayout) Reset()         { *m = ChainIDPayout{} }
 func (m *ChainIDPayout) String() string { return proto.CompactTextString(m) }
 func (*ChainIDPayout) ProtoMessage()    {}
 func (*ChainIDPayout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{31}
+	return fileDescriptor_9e149ce9d21da0d8, []int{36}
 }
 func (m *ChainIDPayout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1937,7 @@ func (m *QuerySubscriptionMonthlyPayoutRequest) Reset()         { *m = QuerySubs
 func (m *QuerySubscriptionMonthlyPayoutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySubscriptionMonthlyPayoutRequest) ProtoMessage()    {}
 func (*QuerySubscriptionMonthlyPayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{32}
+	return fileDescriptor_9e149ce9d21da0d8, []int{37}
 }
 func (m *QuerySubscriptionMonthlyPayoutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1738,7 +1984,7 @@ func (m *QuerySubscriptionMonthlyPayoutResponse) Reset() {
 func (m *QuerySubscriptionMonthlyPayoutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySubscriptionMonthlyPayoutResponse) ProtoMessage()    {}
 func (*QuerySubscriptionMonthlyPayoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9e149ce9d21da0d8, []int{33}
+	return fileDescriptor_9e149ce9d21da0d8, []int{38}
 }
 func (m *QuerySubscriptionMonthlyPayoutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,6 +2032,11 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.pairing.QueryParamsResponse")
 	proto.RegisterType((*QueryProvidersRequest)(nil), "lavanet.lava.pairing.QueryProvidersRequest")
 	proto.RegisterType((*QueryProvidersResponse)(nil), "lavanet.lava.pairing.QueryProvidersResponse")
+	proto.RegisterType((*QueryJailedProvidersRequest)(nil), "lavanet.lava.pairing.QueryJailedProvidersRequest")
+	proto.RegisterType((*QueryJailedProvidersResponse)(nil), "lavanet.lava.pairing.QueryJailedProvidersResponse")
+	proto.RegisterType((*QueryProvidersQosRequest)(nil), "lavanet.lava.pairing.QueryProvidersQosRequest")
+	proto.RegisterType((*ProviderQos)(nil), "lavanet.lava.pairing.ProviderQos")
+	proto.RegisterType((*QueryProvidersQosResponse)(nil), "lavanet.lava.pairing.QueryProvidersQosResponse")
 	proto.RegisterType((*QueryGetPairingRequest)(nil), "lavanet.lava.pairing.QueryGetPairingRequest")
 	proto.RegisterType((*QueryGetPairingResponse)(nil), "lavanet.lava.pairing.QueryGetPairingResponse")
 	proto.RegisterType((*QueryVerifyPairingRequest)(nil), "lavanet.lava.pairing.QueryVerifyPairingRequest")
@@ -1821,133 +2072,146 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/pairing/query.proto", fileDescriptor_9e149ce9d21da0d8) }
 
 var fileDescriptor_9e149ce9d21da0d8 = []byte{
-	// 2001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0xdc, 0x6a,
-	0x15, 0xaf, 0x27, 0x8f, 0x26, 0xa7, 0x4d, 0x5b, 0x7d, 0x37, 0x49, 0x13, 0x93, 0x9b, 0xa6, 0x5f,
-	0x5f, 0x09, 0x0d, 0xe3, 0x9b, 0xe9, 0xe3, 0x46, 0x7d, 0x41, 0xd2, 0x17, 0x29, 0x81, 0x9b, 0x4c,
-	0x08, 0x0b, 0x36, 0x96, 0xe3, 0xf9, 0x32, 0x71, 0xe3, 0xb1, 0x5d, 0x3f, 0xa6, 0x09, 0xa3, 0x01,
-	0x04, 0x62, 0x7b, 0x85, 0xc4, 0x65, 0x81, 0xc4, 0xf2, 0x4a, 0x88, 0x05, 0xec, 0x91, 0xd8, 0x21,
-	0xd0, 0x5d, 0x20, 0x74, 0xa5, 0x6e, 0x58, 0x00, 0x42, 0x2d, 0xff, 0x00, 0xff, 0x01, 0xf2, 0xf7,
-	0x1d, 0x7b, 0xec, 0x89, 0xc7, 0x33, 0xd3, 0x44, 0x6c, 0xda, 0x7c, 0xf6, 0x79, 0xfc, 0xce, 0xef,
-	0x1c, 0xfb, 0x9c, 0xe3, 0x81, 0x39, 0x53, 0xab, 0x6b, 0x16, 0xf3, 0x95, 0xf0, 0x7f, 0xc5, 0xd1,
-	0x0c, 0xd7, 0xb0, 0xaa, 0xca, 0xab, 0x80, 0xb9, 0x87, 0x45, 0xc7, 0xb5, 0x7d, 0x9b, 0x8c, 0xa3,
-	0x44, 0x31, 0xfc, 0xbf, 0x88, 0x12, 0xf2, 0x78, 0xd5, 0xae, 0xda, 0x5c, 0x40, 0x09, 0xff, 0x12,
-	0xb2, 0xf2, 0x4c, 0xd5, 0xb6, 0xab, 0x26, 0x53, 0x34, 0xc7, 0x50, 0x34, 0xcb, 0xb2, 0x7d, 0xcd,
-	0x37, 0x6c, 0xcb, 0xc3, 0xbb, 0x5f, 0xd5, 0x6d, 0xaf, 0x66, 0x7b, 0xca, 0x8e, 0xe6, 0x31, 0xe1,
-	0x42, 0xa9, 0x2f, 0xed, 0x30, 0x5f, 0x5b, 0x52, 0x1c, 0xad, 0x6a, 0x58, 0x5c, 0x18, 0x65, 0x2f,
-	0x67, 0xe2, 0x72, 0x34, 0x57, 0xab, 0x45, 0xe6, 0x16, 0x32, 0x45, 0x98, 0x63, 0xeb, 0x7b, 0xaa,
-	0xa3, 0x1d, 0xd6, 0x98, 0xe5, 0x47, 0xa2, 0x33, 0x29, 0x51, 0xcf, 0x61, 0x3a, 0xff, 0x07, 0xef,
-	0x5e, 0x4a, 0x1b, 0x32, 0x35, 0xcb, 0x53, 0x1c, 0xdb, 0x34, 0x74, 0xa4, 0x40, 0xbe, 0x95, 0x0d,
-	0xc6, 0xb5, 0xeb, 0x46, 0x85, 0xb9, 0x91, 0x33, 0xd5, 0xf3, 0x6d, 0x57, 0xab, 0x32, 0x54, 0x5a,
-	0xc9, 0x54, 0x0a, 0x2c, 0xe3, 0x55, 0xc0, 0xda, 0x55, 0x54, 0xdd, 0x34, 0xc2, 0x63, 0x64, 0x12,
-	0x4d, 0xdc, 0x4c, 0x99, 0xe0, 0x91, 0xa1, 0x82, 0xe2, 0xf9, 0xda, 0x3e, 0x53, 0x99, 0xe5, 0x47,
-	0x79, 0x92, 0x17, 0xd3, 0x31, 0x06, 0x3b, 0x9e, 0xee, 0x1a, 0x4e, 0x48, 0x69, 0xea, 0x80, 0xd2,
-	0x57, 0xd2, 0xe8, 0x5c, 0xfb, 0x25, 0xd3, 0x7d, 0x2f, 0xfa, 0x03, 0x85, 0x6e, 0xa4, 0x84, 0x2a,
-	0xf6, 0x6b, 0xcb, 0x37, 0x6a, 0x4c, 0xa9, 0x2f, 0xc5, 0x7f, 0x0b, 0x41, 0x3a, 0x0e, 0x64, 0x33,
-	0xcc, 0xe7, 0x06, 0xcf, 0x4f, 0x99, 0xbd, 0x0a, 0x98, 0xe7, 0xd3, 0x4d, 0xf8, 0x20, 0x75, 0xd5,
-	0x73, 0x6c, 0xcb, 0x63, 0xe4, 0x1e, 0x0c, 0x8b, 0x3c, 0x4e, 0x49, 0x73, 0xd2, 0xfc, 0x99, 0xd2,
-	0x4c, 0x31, 0xab, 0xc2, 0x8a, 0x42, 0x6b, 0x75, 0xf0, 0x8b, 0x7f, 0x5d, 0x3a, 0x55, 0x46, 0x0d,
-	0xba, 0x09, 0x13, 0xc2, 0x24, 0x12, 0x15, 0xf9, 0x22, 0x53, 0x70, 0x5a, 0xdf, 0xd3, 0x0c, 0x6b,
-	0xed, 0x09, 0xb7, 0x3a, 0x5a, 0x8e, 0x8e, 0x64, 0x16, 0xc0, 0xdb, 0xb3, 0x5f, 0x3f, 0x73, 0xed,
-	0x1f, 0x30, 0x6b, 0xaa, 0x30, 0x27, 0xcd, 0x8f, 0x94, 0x13, 0x57, 0xe8, 0x3e, 0x4c, 0xb6, 0x9b,
-	0x44, 0xa0, 0xdf, 0x02, 0xe0, 0x34, 0x3f, 0x0d, 0x59, 0x9e, 0x92, 0xe6, 0x06, 0xe6, 0xcf, 0x94,
-	0xae, 0xa5, 0xc1, 0x26, 0x73, 0x52, 0xdc, 0x8a, 0x85, 0x11, 0x75, 0x42, 0xfd, 0xc5, 0xe0, 0x48,
-	0xe1, 0xc2, 0x00, 0x7d, 0x81, 0xce, 0x9e, 0x33, 0x7f, 0x43, 0xc4, 0xd9, 0x3d, 0x80, 0x49, 0x18,
-	0x16, 0xe5, 0xc1, 0xc1, 0x8f, 0x96, 0xf1, 0x44, 0x7f, 0x57, 0x80, 0x8b, 0x47, 0x8c, 0x21, 0xf4,
-	0x35, 0x18, 0x8d, 0x6a, 0xc9, 0x7b, 0x1f, 0xe4, 0x2d, 0x6d, 0x72, 0x05, 0xc6, 0xf4, 0xc0, 0x75,
-	0xc3, 0xf2, 0xe4, 0x3a, 0x1c, 0xc5, 0x60, 0xf9, 0x2c, 0x5e, 0x7c, 0x1a, 0x5e, 0x23, 0xcb, 0x30,
-	0x1d, 0x96, 0x83, 0x6a, 0xb2, 0x5d, 0x5f, 0xf5, 0x6d, 0xd5, 0x62, 0x07, 0xbe, 0x8a, 0x99, 0x9c,
-	0x1a, 0xe0, 0x0a, 0x13, 0xa1, 0xc0, 0x3a, 0xdb, 0xf5, 0xbf, 0x6b, 0x7f, 0x87, 0x1d, 0x44, 0x88,
-	0xc9, 0x1d, 0xb8, 0x18, 0x3e, 0x8a, 0xaa, 0xa9, 0x79, 0xbe, 0x1a, 0x38, 0x15, 0xcd, 0x67, 0x15,
-	0x75, 0xc7, 0xb4, 0xf5, 0xfd, 0xa9, 0x41, 0xae, 0x37, 0x1e, 0xde, 0x5e, 0xd7, 0x3c, 0x7f, 0x5b,
-	0xdc, 0x5c, 0x0d, 0xef, 0x91, 0x25, 0x98, 0xe0, 0x42, 0xaa, 0xbd, 0x9b, 0x76, 0x36, 0xc4, 0x95,
-	0x08, 0xbf, 0xf9, 0xc9, 0x6e, 0xc2, 0x13, 0xfd, 0x11, 0x4c, 0x73, 0xba, 0xbe, 0xc7, 0x5c, 0x63,
-	0xf7, 0xf0, 0xb8, 0xf4, 0x13, 0x19, 0x46, 0x22, 0x92, 0x78, 0x84, 0xa3, 0xe5, 0xf8, 0x4c, 0xc6,
-	0x61, 0x28, 0x19, 0x82, 0x38, 0xd0, 0xcf, 0x25, 0x90, 0xb3, 0x10, 0x60, 0xce, 0xc6, 0x61, 0xa8,
-	0xae, 0x99, 0x46, 0x85, 0x03, 0x18, 0x29, 0x8b, 0x03, 0x59, 0x80, 0x0b, 0x61, 0x68, 0xac, 0xa2,
-	0xb6, 0x12, 0x2a, 0x08, 0x3d, 0x2f, 0xae, 0xc7, 0x75, 0x4b, 0xe6, 0xe0, 0xac, 0x1e, 0xa8, 0x0e,
-	0x73, 0x31, 0x51, 0xc2, 0x39, 0xe8, 0xc1, 0x06, 0x73, 0x45, 0x9a, 0x3e, 0x04, 0xc0, 0x27, 0x5c,
-	0x35, 0x2a, 0x9c, 0xaa, 0x51, 0x9e, 0xea, 0xf0, 0xca, 0x5a, 0x05, 0x6b, 0x74, 0x0d, 0x96, 0xa2,
-	0xb2, 0xda, 0xe6, 0x6f, 0xab, 0x0d, 0xf1, 0xb2, 0xda, 0x12, 0xc5, 0xf2, 0x98, 0x87, 0x1f, 0x79,
-	0x8d, 0xf8, 0x1b, 0x87, 0x21, 0xc3, 0xaa, 0xb0, 0x03, 0x64, 0x4f, 0x1c, 0xe8, 0x9f, 0x25, 0x28,
-	0xf5, 0x63, 0x0b, 0x99, 0xf8, 0x54, 0x02, 0x1a, 0x74, 0x15, 0xc7, 0xd7, 0xc7, 0x72, 0xf6, 0xeb,
-	0xa3, 0xbb, 0x3b, 0x2c, 0xf5, 0x1e, 0x3c, 0xd1, 0x06, 0x52, 0xb2, 0x62, 0x9a, 0xbd, 0x53, 0xf2,
-	0x0c, 0xa0, 0xd5, 0xd6, 0x10, 0xec, 0xf5, 0xa2, 0xe8, 0x81, 0xc5, 0xb0, 0x07, 0x16, 0x45, 0x9b,
-	0xc5, 0x1e, 0x58, 0xdc, 0xd0, 0xaa, 0x0c, 0x75, 0xcb, 0x09, 0x4d, 0xfa, 0x69, 0x01, 0x49, 0xec,
-	0xd1, 0x7b, 0xbf, 0x24, 0x0e, 0xfc, 0x7f, 0x48, 0x24, 0xcf, 0x53, 0x7c, 0x14, 0x38, 0x1f, 0x37,
-	0xba, 0xf2, 0x21, 0xa2, 0x49, 0x11, 0xf2, 0x10, 0xae, 0xc5, 0xef, 0x3d, 0x34, 0x9e, 0x76, 0x9c,
-	0x5f, 0x94, 0x9f, 0x49, 0x70, 0xbd, 0x9b, 0x3e, 0x72, 0xf8, 0x12, 0x26, 0x9d, 0x4c, 0x09, 0x4c,
-	0xe7, 0x62, 0x87, 0xd6, 0x95, 0xa9, 0x83, 0x54, 0x75, 0xb0, 0x48, 0x6d, 0x8c, 0x6a, 0xc5, 0x34,
-	0xf3, 0xa3, 0x3a, 0xa9, 0xba, 0xfa, 0x67, 0xc4, 0x43, 0x8e, 0xc7, 0x1e, 0x78, 0x18, 0x38, 0x59,
-	0x1e, 0x4e, 0xae, 0x4c, 0x6e, 0xc3, 0x4c, 0x94, 0x66, 0xfe, 0xf6, 0x43, 0x3f, 0x5e, 0x7e, 0x75,
-	0x38, 0xf0, 0x61, 0x07, 0x2d, 0xe4, 0xe2, 0x13, 0x18, 0x63, 0xc9, 0x1b, 0x98, 0x81, 0x2b, 0xd9,
-	0x14, 0xa4, 0x6c, 0x60, 0xe4, 0x69, 0x7d, 0xba, 0x8b, 0x38, 0x57, 0x4c, 0x33, 0x13, 0xe7, 0x49,
-	0xe5, 0xfb, 0x0f, 0x12, 0x86, 0x76, 0xd4, 0x51, 0xe7, 0xd0, 0x06, 0x8e, 0x13, 0xda, 0xc9, 0xe5,
-	0x52, 0xc3, 0xb9, 0x6f, 0xdb, 0x63, 0x2e, 0x9f, 0x53, 0x12, 0x7d, 0x5b, 0xab, 0x54, 0x5c, 0xe6,
-	0x79, 0x51, 0xdf, 0xc6, 0x63, 0xb2, 0xa3, 0x17, 0xd2, 0x1d, 0x3d, 0xee, 0xce, 0x03, 0xc9, 0xee,
-	0xfc, 0x1a, 0x47, 0xb3, 0x84, 0x0b, 0xa4, 0xe5, 0x39, 0x8c, 0xe8, 0xb6, 0xe5, 0x05, 0xb5, 0xb8,
-	0xe7, 0xf4, 0x35, 0x4b, 0xc5, 0xca, 0xa1, 0xe3, 0x9a, 0x76, 0xf0, 0x78, 0x1b, 0x47, 0x28, 0x71,
-	0xa0, 0xf7, 0xe1, 0x12, 0x77, 0xbc, 0x15, 0x6e, 0x4b, 0x7a, 0xdc, 0xce, 0xd7, 0x0d, 0xcf, 0xef,
-	0x3a, 0x9d, 0xd0, 0x1a, 0xcc, 0x75, 0x56, 0x3e, 0xf1, 0x61, 0x90, 0x6e, 0xc2, 0x57, 0xb8, 0xbb,
-	0xa7, 0xbb, 0xbb, 0x4c, 0xf7, 0x8d, 0x3a, 0xdb, 0xe0, 0x7b, 0x52, 0x84, 0x53, 0x6e, 0x63, 0x6a,
-	0x34, 0x11, 0xfc, 0x24, 0x0c, 0x87, 0x93, 0x5c, 0x9c, 0x0e, 0x3c, 0xd1, 0x5f, 0x4a, 0x58, 0xff,
-	0x47, 0x6c, 0x22, 0xfc, 0x12, 0x0c, 0x8b, 0x6d, 0x0c, 0xc9, 0x97, 0xdb, 0xca, 0x31, 0xdc, 0xd7,
-	0x8a, 0xa8, 0x83, 0x92, 0x64, 0x05, 0xce, 0x39, 0xcc, 0xaa, 0x18, 0x56, 0x55, 0x45, 0xdd, 0x42,
-	0x57, 0xdd, 0x31, 0xd4, 0x10, 0x47, 0xfa, 0x5f, 0x09, 0xc7, 0xeb, 0xad, 0xca, 0x7e, 0xfb, 0xa8,
-	0xf6, 0x1c, 0x4e, 0x47, 0xf3, 0xa6, 0xc0, 0xf4, 0xb5, 0xec, 0x47, 0xa4, 0xc3, 0x78, 0x5e, 0x8e,
-	0xb4, 0xc9, 0x04, 0x0c, 0xd7, 0xb4, 0x03, 0x55, 0x0f, 0x92, 0x25, 0x11, 0x90, 0x9b, 0x30, 0x18,
-	0xb2, 0xc3, 0x0b, 0xf4, 0x4c, 0xe9, 0x62, 0xda, 0x38, 0xdf, 0x5c, 0xb7, 0x1c, 0xa6, 0x97, 0xb9,
-	0x10, 0x59, 0x83, 0xf3, 0xd1, 0x3a, 0xa6, 0xe2, 0x62, 0x35, 0xc8, 0xf5, 0xe6, 0xd2, 0x7a, 0xf1,
-	0xce, 0x56, 0x5f, 0xc2, 0xe5, 0xaa, 0x7c, 0x2e, 0xba, 0x26, 0xce, 0xf4, 0xeb, 0x70, 0x39, 0xb5,
-	0x0b, 0x7d, 0xdb, 0xb6, 0xfc, 0x3d, 0xf3, 0x70, 0x43, 0x3b, 0xb4, 0x03, 0x3f, 0x91, 0x64, 0x27,
-	0x39, 0x82, 0x25, 0x06, 0x5f, 0xba, 0x0f, 0x64, 0x2b, 0xb1, 0x6c, 0x0a, 0x45, 0x42, 0xe1, 0x6c,
-	0x72, 0x05, 0x45, 0xad, 0xd4, 0x35, 0x32, 0x0d, 0x23, 0xbc, 0xa6, 0xc3, 0xc1, 0x34, 0xf5, 0xbc,
-	0x56, 0xc2, 0xca, 0xd1, 0x6a, 0x76, 0x60, 0xf9, 0xf8, 0xc0, 0xe2, 0x89, 0xfe, 0x10, 0x68, 0x1e,
-	0xda, 0xd6, 0x58, 0xed, 0xdb, 0xbe, 0x66, 0x72, 0xaf, 0x83, 0x65, 0x71, 0x20, 0xab, 0x70, 0xba,
-	0xc2, 0x7c, 0xcd, 0x30, 0xbd, 0xa9, 0x02, 0x7f, 0x22, 0xe6, 0xb3, 0x33, 0x78, 0x34, 0x9a, 0x72,
-	0xa4, 0x48, 0x9f, 0xc0, 0xb9, 0x44, 0x87, 0x0b, 0x03, 0xcd, 0xa1, 0x26, 0x11, 0x45, 0x21, 0x15,
-	0xc5, 0x4b, 0x18, 0x7b, 0x2c, 0x1e, 0x66, 0x34, 0x92, 0x64, 0x42, 0x4a, 0x33, 0xf1, 0x28, 0xac,
-	0xbb, 0x50, 0x28, 0x42, 0x7d, 0xb5, 0x6b, 0xe3, 0xe5, 0x88, 0x51, 0x89, 0x3e, 0xc6, 0x19, 0x23,
-	0x19, 0x55, 0xa7, 0x1c, 0x77, 0x7a, 0x90, 0x69, 0x13, 0xc7, 0x86, 0x1c, 0x23, 0xb9, 0xd4, 0x3f,
-	0x6c, 0xa7, 0xbe, 0x43, 0x7f, 0x49, 0xb1, 0x12, 0xb3, 0x5e, 0xfa, 0xf5, 0x14, 0x0c, 0x71, 0xff,
-	0xe4, 0xa7, 0x12, 0x0c, 0x8b, 0xc2, 0x25, 0xf3, 0x39, 0xcf, 0x5f, 0xea, 0xa3, 0x84, 0xbc, 0xd0,
-	0x83, 0xa4, 0x80, 0x4f, 0xaf, 0xfe, 0xe4, 0xcd, 0x7f, 0x7e, 0x51, 0x98, 0x25, 0x33, 0x4a, 0xce,
-	0xc7, 0x28, 0xf2, 0x2b, 0x09, 0x46, 0x5b, 0x3b, 0xd8, 0xcd, 0x3c, 0xf3, 0x6d, 0x1f, 0x2d, 0xe4,
-	0xc5, 0xde, 0x84, 0x11, 0xce, 0x12, 0x87, 0x73, 0x93, 0x2c, 0x28, 0xb9, 0x9f, 0xa3, 0x3c, 0xa5,
-	0x81, 0xcd, 0xa1, 0x49, 0x7e, 0x23, 0x01, 0xb4, 0x5e, 0x3f, 0x64, 0xb1, 0xc7, 0xb7, 0x94, 0x40,
-	0xd7, 0xdf, 0x3b, 0x8d, 0x3e, 0xe0, 0xf0, 0xee, 0x92, 0xdb, 0xd9, 0xf0, 0xaa, 0x2c, 0xde, 0xd1,
-	0x5b, 0x00, 0x95, 0x86, 0x58, 0xa6, 0x9b, 0xe4, 0x2f, 0x12, 0x8c, 0xa5, 0xd6, 0x62, 0xa2, 0xe4,
-	0xb8, 0xcf, 0x5a, 0xe1, 0xe5, 0x8f, 0x7a, 0x57, 0x40, 0xc8, 0x65, 0x0e, 0x79, 0x9d, 0xbc, 0xc8,
-	0x86, 0x5c, 0xe7, 0x4a, 0x39, 0xa8, 0x95, 0x46, 0x44, 0x7a, 0x53, 0x69, 0xf0, 0x29, 0xa2, 0x49,
-	0x7e, 0x56, 0x00, 0xba, 0xdd, 0xc3, 0x32, 0x94, 0x4f, 0x6e, 0xcf, 0x5b, 0xa6, 0xfc, 0xcd, 0xe3,
-	0x1b, 0x42, 0x36, 0xd6, 0x39, 0x1b, 0xcf, 0xc8, 0x13, 0xe5, 0x18, 0x5f, 0x2e, 0x95, 0x06, 0x1f,
-	0xa3, 0x9b, 0xe4, 0xc7, 0x05, 0xb8, 0xd6, 0xdd, 0xf9, 0x8a, 0x69, 0xe6, 0x52, 0xd1, 0xcf, 0xc2,
-	0x9d, 0x4b, 0x45, 0x5f, 0xbb, 0x33, 0x7d, 0xc2, 0xa9, 0x78, 0x44, 0x1e, 0x1c, 0x87, 0x0a, 0xf2,
-	0x46, 0x82, 0xc9, 0xec, 0x15, 0x88, 0xdc, 0xef, 0xf2, 0x6c, 0xe5, 0x2d, 0x80, 0xf2, 0x83, 0xf7,
-	0x53, 0xc6, 0xd8, 0x1e, 0xf1, 0xd8, 0x96, 0xc9, 0x5d, 0xa5, 0xaf, 0xaf, 0xda, 0x71, 0x62, 0xff,
-	0x26, 0xc1, 0x74, 0xb6, 0x8b, 0x30, 0x99, 0xf7, 0xf3, 0x73, 0xf0, 0xfe, 0x81, 0x75, 0x5d, 0x52,
-	0xe9, 0x5d, 0x1e, 0xd8, 0x47, 0xa4, 0xd8, 0x5f, 0x60, 0xe4, 0xf7, 0x12, 0x8c, 0xa5, 0x76, 0x19,
-	0x52, 0xca, 0x27, 0x38, 0x6b, 0x4b, 0x93, 0x6f, 0xf5, 0xa5, 0x83, 0x90, 0x6f, 0x73, 0xc8, 0x45,
-	0xb2, 0xa8, 0xf4, 0xf0, 0x5b, 0x46, 0x9c, 0x81, 0xdf, 0x4a, 0x70, 0x21, 0x65, 0x2f, 0x24, 0xbe,
-	0x94, 0xcf, 0x5d, 0xdf, 0x98, 0x3b, 0x2d, 0x89, 0x74, 0x91, 0x63, 0xbe, 0x4e, 0xae, 0xf6, 0x82,
-	0x99, 0x7c, 0x2e, 0xc1, 0x68, 0xbc, 0x51, 0xe5, 0x76, 0xc7, 0xf6, 0xd5, 0x2e, 0xb7, 0x3b, 0x1e,
-	0x59, 0xd2, 0xba, 0xb5, 0x9f, 0xc0, 0x63, 0xae, 0xf8, 0xb9, 0x44, 0x69, 0xe0, 0x86, 0xd8, 0x4c,
-	0x34, 0xca, 0x3f, 0x49, 0xf0, 0x41, 0xc6, 0x0a, 0x45, 0xee, 0xe4, 0x60, 0xe8, 0xbc, 0xaf, 0xc9,
-	0x77, 0xfb, 0x55, 0xc3, 0x20, 0x1e, 0xf2, 0x20, 0x3e, 0x26, 0x77, 0xb2, 0x83, 0xf0, 0xb8, 0x6a,
-	0xeb, 0x43, 0xb0, 0x6a, 0x1a, 0x9e, 0x9f, 0x88, 0xe2, 0x8f, 0x12, 0x9c, 0x6f, 0xdb, 0xa2, 0xc8,
-	0x52, 0x0e, 0x94, 0xec, 0x2d, 0x4e, 0x2e, 0xf5, 0xa3, 0x82, 0xc8, 0x57, 0x39, 0xf2, 0x07, 0xe4,
-	0x5e, 0x87, 0xaa, 0x88, 0xd4, 0x70, 0x1d, 0x53, 0x1a, 0xd1, 0x38, 0xd9, 0x54, 0x1a, 0x62, 0x11,
-	0x6c, 0x92, 0xbf, 0x4a, 0x30, 0x91, 0x39, 0xcb, 0x93, 0x8f, 0x7b, 0x18, 0x94, 0xb2, 0xe6, 0x58,
-	0x79, 0xb9, 0x7f, 0x45, 0x0c, 0xe8, 0x1b, 0x3c, 0xa0, 0x7b, 0x64, 0xb9, 0xcb, 0xdb, 0xa4, 0x26,
-	0xb4, 0x55, 0x31, 0x62, 0x27, 0x26, 0x02, 0xf2, 0x0f, 0x09, 0xa6, 0x3b, 0xce, 0xc8, 0xb9, 0x2f,
-	0xca, 0x6e, 0xe3, 0x79, 0xee, 0x8b, 0xb2, 0xeb, 0x58, 0xde, 0xad, 0xbb, 0x25, 0xd7, 0xb2, 0x23,
-	0xe1, 0xc5, 0x69, 0x23, 0x9f, 0x49, 0x00, 0xad, 0xd5, 0xf8, 0x04, 0x67, 0xcb, 0xa3, 0xfb, 0x36,
-	0x5d, 0xe0, 0x88, 0xaf, 0x90, 0xcb, 0x1d, 0x10, 0x57, 0xf6, 0xa3, 0x29, 0x6d, 0x75, 0xe5, 0x8b,
-	0xb7, 0xb3, 0xd2, 0x97, 0x6f, 0x67, 0xa5, 0x7f, 0xbf, 0x9d, 0x95, 0x7e, 0xfe, 0x6e, 0xf6, 0xd4,
-	0x97, 0xef, 0x66, 0x4f, 0xfd, 0xfd, 0xdd, 0xec, 0xa9, 0xef, 0xdf, 0xa8, 0x1a, 0xfe, 0x5e, 0xb0,
-	0x53, 0xd4, 0xed, 0x5a, 0xda, 0xcc, 0x41, 0x6c, 0xc8, 0x3f, 0x74, 0x98, 0xb7, 0x33, 0xcc, 0x7f,
-	0xd4, 0xbc, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x5f, 0xe2, 0xef, 0x1c, 0x1f, 0x00,
-	0x00,
+	// 2213 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x6f, 0xdc, 0x5a,
+	0x15, 0xaf, 0x27, 0x1f, 0x4d, 0x4e, 0x9b, 0xb6, 0xba, 0x2f, 0x4d, 0x53, 0x93, 0xa6, 0xa9, 0xdb,
+	0xa6, 0x09, 0x4d, 0xc7, 0xcd, 0xf4, 0x2b, 0x6a, 0xd3, 0x42, 0xd2, 0xb4, 0x79, 0x29, 0x81, 0x26,
+	0x0e, 0x61, 0xc1, 0xc6, 0x72, 0x3c, 0x37, 0x13, 0x37, 0x1e, 0xdb, 0xf1, 0x47, 0x9a, 0x10, 0x05,
+	0x10, 0x88, 0xed, 0x13, 0x12, 0x8f, 0x05, 0xfb, 0x27, 0x10, 0x0b, 0x58, 0xb0, 0x43, 0x62, 0x87,
+	0x40, 0x6f, 0x81, 0xe0, 0x49, 0x6f, 0x83, 0x10, 0x3c, 0xa1, 0x96, 0x7f, 0x80, 0x2d, 0x2b, 0xe4,
+	0x7b, 0x8f, 0x3d, 0xf6, 0xd4, 0xe3, 0xf1, 0x34, 0x81, 0x4d, 0x33, 0xd7, 0x3e, 0x1f, 0xbf, 0xf3,
+	0x3b, 0xc7, 0xf7, 0x9e, 0x63, 0x17, 0xc6, 0x4c, 0x6d, 0x57, 0xb3, 0xa8, 0x2f, 0x87, 0x7f, 0x65,
+	0x47, 0x33, 0x5c, 0xc3, 0xaa, 0xc9, 0x3b, 0x01, 0x75, 0xf7, 0xcb, 0x8e, 0x6b, 0xfb, 0x36, 0x19,
+	0x44, 0x89, 0x72, 0xf8, 0xb7, 0x8c, 0x12, 0xe2, 0x60, 0xcd, 0xae, 0xd9, 0x4c, 0x40, 0x0e, 0x7f,
+	0x71, 0x59, 0x71, 0xa4, 0x66, 0xdb, 0x35, 0x93, 0xca, 0x9a, 0x63, 0xc8, 0x9a, 0x65, 0xd9, 0xbe,
+	0xe6, 0x1b, 0xb6, 0xe5, 0xe1, 0xdd, 0x2f, 0xeb, 0xb6, 0x57, 0xb7, 0x3d, 0x79, 0x43, 0xf3, 0x28,
+	0x77, 0x21, 0xef, 0x4e, 0x6f, 0x50, 0x5f, 0x9b, 0x96, 0x1d, 0xad, 0x66, 0x58, 0x4c, 0x18, 0x65,
+	0xaf, 0x64, 0xe2, 0x72, 0x34, 0x57, 0xab, 0x47, 0xe6, 0xb2, 0xa1, 0xbb, 0xd4, 0xd4, 0x10, 0xba,
+	0x38, 0x99, 0x29, 0x41, 0x1d, 0x5b, 0xdf, 0x52, 0x1d, 0x6d, 0xbf, 0x4e, 0x2d, 0x3f, 0x32, 0x36,
+	0x92, 0x12, 0xf5, 0x1c, 0xaa, 0xb3, 0x7f, 0xf0, 0xee, 0xe5, 0xb4, 0x21, 0x53, 0xb3, 0x3c, 0xd9,
+	0xb1, 0x4d, 0x43, 0x8f, 0x3c, 0xdd, 0xc9, 0x86, 0xeb, 0xda, 0xbb, 0x46, 0x95, 0xba, 0x91, 0x33,
+	0xd5, 0xf3, 0x6d, 0x57, 0xab, 0x51, 0x54, 0x9a, 0xcb, 0x54, 0x0a, 0x2c, 0x63, 0x27, 0xa0, 0xcd,
+	0x2a, 0xaa, 0x6e, 0x1a, 0xe1, 0x32, 0x32, 0x89, 0x26, 0x6e, 0xa6, 0x4c, 0xb0, 0xc8, 0x50, 0x41,
+	0xf6, 0x7c, 0x6d, 0x9b, 0xaa, 0xd4, 0xf2, 0xa3, 0x4c, 0x8a, 0x53, 0xe9, 0x18, 0x83, 0x0d, 0x4f,
+	0x77, 0x0d, 0x27, 0x24, 0x3d, 0xb5, 0x40, 0xe9, 0xab, 0x69, 0x74, 0xae, 0xfd, 0x8a, 0xea, 0xbe,
+	0x17, 0xfd, 0x40, 0xa1, 0x1b, 0x29, 0xa1, 0xaa, 0xfd, 0xda, 0xf2, 0x8d, 0x3a, 0x95, 0x77, 0xa7,
+	0xe3, 0xdf, 0x5c, 0x50, 0x1a, 0x04, 0xb2, 0x1a, 0x66, 0x7c, 0x85, 0x65, 0x50, 0xa1, 0x3b, 0x01,
+	0xf5, 0x7c, 0x69, 0x15, 0x3e, 0x48, 0x5d, 0xf5, 0x1c, 0xdb, 0xf2, 0x28, 0x79, 0x08, 0xbd, 0x3c,
+	0xd3, 0xc3, 0xc2, 0x98, 0x30, 0x71, 0xaa, 0x32, 0x52, 0xce, 0xaa, 0xc1, 0x32, 0xd7, 0x9a, 0xef,
+	0xfe, 0xf4, 0x8b, 0xcb, 0x27, 0x14, 0xd4, 0x90, 0x56, 0xe1, 0x3c, 0x37, 0x89, 0x44, 0x45, 0xbe,
+	0xc8, 0x30, 0x9c, 0xd4, 0xb7, 0x34, 0xc3, 0x5a, 0x5a, 0x60, 0x56, 0xfb, 0x95, 0x68, 0x49, 0x46,
+	0x01, 0xbc, 0x2d, 0xfb, 0xf5, 0x73, 0xd7, 0xfe, 0x0e, 0xb5, 0x86, 0x4b, 0x63, 0xc2, 0x44, 0x9f,
+	0x92, 0xb8, 0x22, 0x6d, 0xc3, 0x50, 0xb3, 0x49, 0x04, 0xfa, 0x35, 0x00, 0x46, 0xf3, 0xb3, 0x90,
+	0xe5, 0x61, 0x61, 0xac, 0x6b, 0xe2, 0x54, 0xe5, 0x7a, 0x1a, 0x6c, 0x32, 0x27, 0xe5, 0xb5, 0x58,
+	0x18, 0x51, 0x27, 0xd4, 0x5f, 0x74, 0xf7, 0x95, 0xce, 0x75, 0x49, 0x0f, 0xe0, 0x4b, 0xcc, 0xd9,
+	0x0b, 0xcd, 0x30, 0x69, 0xb5, 0x78, 0x14, 0xd2, 0x36, 0x8c, 0x64, 0x2b, 0xfe, 0x0f, 0xb0, 0x4a,
+	0x2b, 0x30, 0x9c, 0xa6, 0x64, 0xd5, 0x2e, 0x40, 0xb4, 0x08, 0x7d, 0x51, 0xfd, 0x32, 0x9a, 0xfb,
+	0x95, 0x78, 0x2d, 0xfd, 0x45, 0x80, 0x53, 0x91, 0xb5, 0x55, 0xdb, 0x4b, 0xc9, 0x0a, 0x69, 0x59,
+	0xe6, 0xc1, 0x0c, 0x3c, 0x3f, 0x36, 0x13, 0x2d, 0xc9, 0x02, 0x74, 0xed, 0xd8, 0xde, 0x70, 0x17,
+	0x2b, 0x9b, 0xa9, 0xec, 0xb2, 0x59, 0x0d, 0x34, 0xd3, 0xf0, 0xf7, 0x5f, 0x6e, 0xae, 0x51, 0x77,
+	0xd7, 0xd0, 0xa9, 0x42, 0x1d, 0xdb, 0xf5, 0x31, 0xc8, 0x50, 0x9d, 0x2c, 0x40, 0x8f, 0xa7, 0xdb,
+	0x2e, 0x1d, 0xee, 0x0e, 0xad, 0xcf, 0x97, 0xc3, 0x3b, 0x7f, 0xfb, 0xe2, 0xf2, 0x78, 0xcd, 0xf0,
+	0xb7, 0x82, 0x8d, 0xb2, 0x6e, 0xd7, 0x65, 0xdc, 0xca, 0xf8, 0x9f, 0x5b, 0x5e, 0x75, 0x5b, 0xf6,
+	0xf7, 0x1d, 0xea, 0x95, 0x17, 0xa8, 0xae, 0x70, 0x65, 0x69, 0x0b, 0x2e, 0x66, 0x70, 0x14, 0x67,
+	0xe3, 0xb4, 0x93, 0xb8, 0x8e, 0xf9, 0xb8, 0xd2, 0xa2, 0xd0, 0x1b, 0xbc, 0x20, 0xcc, 0x94, 0xb2,
+	0xf4, 0x02, 0x0b, 0x74, 0x91, 0xfa, 0x2b, 0x5c, 0xa5, 0x7d, 0x2e, 0x86, 0xa0, 0x97, 0x6f, 0x29,
+	0x48, 0x21, 0xae, 0xa4, 0x5f, 0x95, 0xe0, 0xc2, 0x3b, 0xc6, 0x10, 0xf4, 0x12, 0xf4, 0xc7, 0x7e,
+	0xdf, 0xa7, 0x82, 0x1a, 0xda, 0xe4, 0x2a, 0x0c, 0xe8, 0x81, 0xeb, 0x86, 0x5b, 0x1a, 0xd3, 0x61,
+	0x28, 0xba, 0x95, 0xd3, 0x78, 0xf1, 0x59, 0x78, 0x8d, 0xcc, 0xc0, 0xc5, 0x70, 0x0b, 0x51, 0x4d,
+	0xba, 0xe9, 0xab, 0xbe, 0xad, 0x5a, 0x74, 0xcf, 0x57, 0x91, 0x14, 0x96, 0xe3, 0x6e, 0xe5, 0x7c,
+	0x28, 0xb0, 0x4c, 0x37, 0xfd, 0x6f, 0xda, 0xdf, 0xa0, 0x7b, 0x11, 0x62, 0x72, 0x0f, 0x2e, 0x84,
+	0xdb, 0xb7, 0x6a, 0x6a, 0x9e, 0xaf, 0x06, 0x4e, 0x55, 0xf3, 0x69, 0x55, 0xdd, 0x30, 0x6d, 0x7d,
+	0x9b, 0xe5, 0xb4, 0x5b, 0x19, 0x0c, 0x6f, 0x2f, 0x6b, 0x9e, 0xbf, 0xce, 0x6f, 0xce, 0x87, 0xf7,
+	0xc8, 0x34, 0x9c, 0x67, 0x42, 0xaa, 0xbd, 0x99, 0x76, 0xd6, 0xc3, 0x94, 0x08, 0xbb, 0xf9, 0x72,
+	0x33, 0xe1, 0x49, 0xfa, 0x1e, 0x66, 0xf9, 0x5b, 0xd4, 0x35, 0x36, 0xf7, 0x8f, 0x4a, 0x7f, 0xaa,
+	0xec, 0xbb, 0x9a, 0xca, 0x7e, 0x10, 0x7a, 0x92, 0x21, 0xf0, 0x85, 0xf4, 0x89, 0x00, 0x62, 0x16,
+	0x02, 0xcc, 0xd9, 0x20, 0xf4, 0xec, 0x6a, 0xa6, 0x51, 0x65, 0x00, 0xfa, 0x14, 0xbe, 0x20, 0x93,
+	0x70, 0x2e, 0x0c, 0x8d, 0x56, 0xd5, 0x46, 0x42, 0x39, 0xa1, 0x67, 0xf9, 0xf5, 0xb8, 0x68, 0xc9,
+	0x18, 0x9c, 0xd6, 0x03, 0xd5, 0xa1, 0x2e, 0x26, 0x8a, 0x3b, 0x07, 0x3d, 0x58, 0xa1, 0x2e, 0x4f,
+	0xd3, 0x25, 0x00, 0x3c, 0x15, 0x54, 0xa3, 0xca, 0xa8, 0xea, 0x57, 0xfa, 0xf1, 0xca, 0x52, 0x15,
+	0xf7, 0xb5, 0x25, 0x98, 0x8e, 0xca, 0x6a, 0x9d, 0x9d, 0x70, 0x2b, 0xfc, 0x80, 0x5b, 0xe3, 0xc5,
+	0xf2, 0x94, 0x85, 0x1f, 0x79, 0x8d, 0xf8, 0x1b, 0x84, 0x1e, 0xc3, 0xaa, 0xd2, 0x3d, 0x64, 0x8f,
+	0x2f, 0xa4, 0x3f, 0x08, 0x50, 0xe9, 0xc4, 0x16, 0x32, 0xf1, 0x91, 0x00, 0x52, 0xd0, 0x56, 0x1c,
+	0x8f, 0x9c, 0x99, 0xec, 0x27, 0xb1, 0xbd, 0x3b, 0x2c, 0xf5, 0x02, 0x9e, 0xa4, 0x03, 0xa4, 0x64,
+	0xce, 0x34, 0x8b, 0x53, 0xf2, 0x1c, 0xa0, 0xd1, 0x2c, 0x21, 0xd8, 0xf1, 0x32, 0xdf, 0x87, 0xca,
+	0x61, 0x67, 0x55, 0xe6, 0xcd, 0x1b, 0x76, 0x56, 0xe5, 0x15, 0xad, 0x46, 0x51, 0x57, 0x49, 0x68,
+	0x4a, 0x1f, 0x95, 0xa0, 0xd2, 0x89, 0xf7, 0x4e, 0x49, 0xec, 0xfa, 0xff, 0x90, 0x48, 0x16, 0x53,
+	0x7c, 0x94, 0x18, 0x1f, 0x37, 0xda, 0xf2, 0xc1, 0xa3, 0x49, 0x11, 0xf2, 0x18, 0xae, 0xc7, 0xfb,
+	0x1e, 0x1a, 0x4f, 0x3b, 0xce, 0x2f, 0xca, 0x8f, 0x05, 0x18, 0x6f, 0xa7, 0x8f, 0x1c, 0xbe, 0x82,
+	0x21, 0x27, 0x53, 0x62, 0x58, 0xc8, 0x3b, 0xb7, 0xb2, 0xad, 0x22, 0x55, 0x2d, 0x2c, 0x4a, 0x36,
+	0x46, 0x35, 0x67, 0x9a, 0xf9, 0x51, 0x1d, 0x57, 0x5d, 0xfd, 0x23, 0xe2, 0x21, 0xc7, 0x63, 0x01,
+	0x1e, 0xba, 0x8e, 0x97, 0x87, 0xe3, 0x2b, 0x93, 0xbb, 0xd8, 0x66, 0x2d, 0x52, 0x7e, 0x48, 0xa1,
+	0x1f, 0x2f, 0xbf, 0x3a, 0x1c, 0xb8, 0xd4, 0x42, 0x0b, 0xb9, 0x78, 0x09, 0x03, 0x34, 0x79, 0x03,
+	0x33, 0x70, 0x35, 0x9b, 0x82, 0x94, 0x0d, 0x8c, 0x3c, 0xad, 0x2f, 0x6d, 0x22, 0xce, 0x39, 0xd3,
+	0xcc, 0xc4, 0x79, 0x5c, 0xf9, 0xfe, 0xad, 0x00, 0x97, 0x5a, 0x38, 0x6a, 0x1d, 0x5a, 0xd7, 0x51,
+	0x42, 0x3b, 0xbe, 0x5c, 0x6a, 0x38, 0x2b, 0xac, 0x7b, 0xd4, 0x65, 0x7d, 0x4a, 0xe2, 0xdc, 0xd6,
+	0xaa, 0x55, 0x97, 0x7a, 0x5e, 0x74, 0x6e, 0xe3, 0x32, 0x79, 0xa2, 0x97, 0xd2, 0x27, 0x7a, 0x7c,
+	0x3a, 0x77, 0x25, 0x4f, 0xe7, 0xd7, 0x30, 0xd4, 0xec, 0x02, 0x69, 0x59, 0x84, 0x3e, 0xdd, 0xb6,
+	0xbc, 0xa0, 0x1e, 0x9f, 0x39, 0x1d, 0xf5, 0x52, 0xb1, 0x72, 0xe8, 0xb8, 0xae, 0xed, 0x3d, 0x5d,
+	0xc7, 0x16, 0x8a, 0x2f, 0xa4, 0x47, 0x70, 0x99, 0x39, 0x5e, 0xf3, 0x35, 0xdf, 0xd0, 0xe3, 0xe3,
+	0x7c, 0xd9, 0xf0, 0xfc, 0xf6, 0xb3, 0x44, 0x1d, 0xc6, 0x5a, 0x2b, 0x1f, 0x7b, 0x33, 0x28, 0xad,
+	0xe2, 0xcc, 0xf3, 0x6c, 0x73, 0x93, 0xea, 0xbe, 0xb1, 0x4b, 0x57, 0xd8, 0x6c, 0x1d, 0xe1, 0x14,
+	0x9b, 0x98, 0xea, 0x4f, 0x04, 0x3f, 0x04, 0xbd, 0x61, 0x27, 0x17, 0xa7, 0x03, 0x57, 0xd2, 0x4f,
+	0x05, 0x18, 0xc9, 0xb6, 0x89, 0xf0, 0x2b, 0xd0, 0xcb, 0x27, 0x78, 0x24, 0x5f, 0x6c, 0x2a, 0xc7,
+	0x70, 0xc6, 0x2f, 0xa3, 0x0e, 0x4a, 0x92, 0x39, 0x38, 0xe3, 0x50, 0xab, 0x6a, 0x58, 0x35, 0x15,
+	0x75, 0x4b, 0x6d, 0x75, 0x07, 0x50, 0x83, 0x2f, 0xa5, 0x7f, 0x0b, 0xd8, 0x5e, 0xaf, 0x55, 0xb7,
+	0x9b, 0x5b, 0xb5, 0x45, 0x38, 0x19, 0xf5, 0x9b, 0x1c, 0xd3, 0xad, 0x56, 0x03, 0x4c, 0x66, 0x7b,
+	0xae, 0x44, 0xda, 0xe4, 0x3c, 0xf4, 0xd6, 0xb5, 0x3d, 0x55, 0x0f, 0x92, 0x25, 0x11, 0x90, 0x9b,
+	0xd0, 0x1d, 0xb2, 0x83, 0xd3, 0xd1, 0x85, 0xb4, 0xf1, 0xf0, 0x4e, 0x79, 0xcd, 0xa1, 0xba, 0xc2,
+	0x84, 0xc8, 0x12, 0x9c, 0x8d, 0x46, 0x78, 0x15, 0x87, 0xf1, 0x6e, 0xa6, 0x37, 0x96, 0xd6, 0x8b,
+	0x84, 0xca, 0xbb, 0xd3, 0x38, 0x90, 0x2b, 0x67, 0xa2, 0x6b, 0x7c, 0x2d, 0x7d, 0x05, 0xae, 0xa4,
+	0x06, 0xa1, 0xaf, 0xdb, 0x96, 0xbf, 0x65, 0xee, 0xaf, 0x68, 0xfb, 0x76, 0xe0, 0x27, 0x92, 0xdc,
+	0x6a, 0xde, 0x93, 0xb6, 0x81, 0xac, 0x25, 0x5e, 0x50, 0x70, 0x45, 0x22, 0xc1, 0xe9, 0xe4, 0x6b,
+	0x0b, 0xd4, 0x4a, 0x5d, 0x23, 0x17, 0xa1, 0x8f, 0xd5, 0x74, 0xd8, 0x98, 0xa6, 0x9e, 0xd7, 0x6a,
+	0x58, 0x39, 0x5a, 0xdd, 0x0e, 0x2c, 0x1f, 0x1f, 0x58, 0x5c, 0x49, 0xdf, 0x05, 0x29, 0x0f, 0x6d,
+	0xa3, 0xad, 0xf6, 0x6d, 0x5f, 0x33, 0x99, 0xd7, 0x6e, 0x85, 0x2f, 0xc8, 0x3c, 0x9c, 0xac, 0x52,
+	0x5f, 0x33, 0x4c, 0x6f, 0xb8, 0xc4, 0x9e, 0x88, 0x89, 0xec, 0x0c, 0xbe, 0x1b, 0x8d, 0x12, 0x29,
+	0x4a, 0x0b, 0x70, 0x26, 0x71, 0xc2, 0xd9, 0x41, 0x2e, 0x35, 0x89, 0x28, 0x4a, 0xa9, 0x28, 0x5e,
+	0xc1, 0xc0, 0x53, 0xfe, 0x30, 0xa3, 0x91, 0x24, 0x13, 0x42, 0x9a, 0x89, 0x27, 0x61, 0xdd, 0x85,
+	0x42, 0x11, 0xea, 0x6b, 0x6d, 0x0f, 0x5e, 0x86, 0x18, 0x95, 0xa4, 0xa7, 0xd8, 0x63, 0x24, 0xa3,
+	0x6a, 0x95, 0xe3, 0x56, 0x0f, 0xb2, 0x74, 0x08, 0xe3, 0xed, 0x8c, 0xe4, 0x52, 0xff, 0xb8, 0x99,
+	0xfa, 0x16, 0xe7, 0x4b, 0x8a, 0x95, 0x98, 0xf5, 0xca, 0x7f, 0x44, 0xe8, 0x61, 0xfe, 0xc9, 0x0f,
+	0x05, 0xe8, 0xe5, 0x85, 0x4b, 0x26, 0x72, 0x9e, 0xbf, 0xd4, 0x8b, 0x2c, 0x71, 0xb2, 0x80, 0x24,
+	0x87, 0x2f, 0x5d, 0xfb, 0xc1, 0xe7, 0xff, 0xfa, 0x49, 0x69, 0x94, 0x8c, 0xc8, 0x39, 0xaf, 0x38,
+	0xc9, 0xcf, 0x04, 0xe8, 0x6f, 0xcc, 0x60, 0x37, 0xf3, 0xcc, 0x37, 0xbd, 0x22, 0x12, 0xa7, 0x8a,
+	0x09, 0x23, 0x9c, 0x69, 0x06, 0xe7, 0x26, 0x99, 0x94, 0x73, 0x5f, 0x61, 0x7a, 0xf2, 0x01, 0x1e,
+	0x0e, 0x87, 0xe4, 0x17, 0x02, 0x40, 0x63, 0xfb, 0x21, 0x53, 0x05, 0x77, 0x29, 0x8e, 0xae, 0xb3,
+	0x3d, 0x4d, 0x9a, 0x65, 0xf0, 0xee, 0x93, 0xbb, 0xd9, 0xf0, 0x6a, 0x34, 0x9e, 0xd1, 0x1b, 0x00,
+	0xe5, 0x03, 0x3e, 0x4c, 0x1f, 0x92, 0x3f, 0x0a, 0x30, 0x90, 0x1a, 0x8b, 0x89, 0x9c, 0xe3, 0x3e,
+	0x6b, 0x84, 0x17, 0x6f, 0x17, 0x57, 0x40, 0xc8, 0x0a, 0x83, 0xbc, 0x4c, 0x5e, 0x64, 0x43, 0xde,
+	0x65, 0x4a, 0x39, 0xa8, 0xe5, 0x83, 0x88, 0xf4, 0x43, 0xf9, 0x80, 0x75, 0x11, 0x87, 0xe4, 0x47,
+	0x25, 0x90, 0xd6, 0x0b, 0x0c, 0x43, 0xf9, 0xe4, 0x16, 0x9e, 0x32, 0xc5, 0x0f, 0x8f, 0x6e, 0x08,
+	0xd9, 0x58, 0x66, 0x6c, 0x3c, 0x27, 0x0b, 0xf2, 0x11, 0xde, 0x76, 0xcb, 0x07, 0xac, 0x8d, 0x3e,
+	0x24, 0xdf, 0x2f, 0xc1, 0xf5, 0xf6, 0xce, 0xe7, 0x4c, 0x33, 0x97, 0x8a, 0x4e, 0x06, 0x6e, 0xf1,
+	0xc3, 0xa3, 0x1b, 0x42, 0x2a, 0x16, 0x18, 0x15, 0x4f, 0xc8, 0xec, 0x51, 0xa8, 0x20, 0x9f, 0x0b,
+	0x30, 0x94, 0x3d, 0x02, 0x91, 0x47, 0x6d, 0x9e, 0xad, 0xbc, 0x01, 0x50, 0x9c, 0x7d, 0x3f, 0x65,
+	0x8c, 0xed, 0x09, 0x8b, 0x6d, 0x86, 0xdc, 0x97, 0x3b, 0xfa, 0x12, 0x12, 0x27, 0xf6, 0xcf, 0x02,
+	0x5c, 0xcc, 0x76, 0x11, 0x26, 0xf3, 0x51, 0x7e, 0x0e, 0xde, 0x3f, 0xb0, 0xb6, 0x43, 0xaa, 0x74,
+	0x9f, 0x05, 0x76, 0x9b, 0x94, 0x3b, 0x0b, 0x8c, 0xfc, 0x5a, 0x80, 0x81, 0xd4, 0x2c, 0x43, 0x2a,
+	0xf9, 0x04, 0x67, 0x4d, 0x69, 0xe2, 0x9d, 0x8e, 0x74, 0x10, 0xf2, 0x5d, 0x06, 0xb9, 0x4c, 0xa6,
+	0xe4, 0x02, 0xdf, 0xbf, 0xe2, 0x0c, 0xfc, 0x52, 0x80, 0x73, 0x29, 0x7b, 0x21, 0xf1, 0x95, 0x7c,
+	0xee, 0x3a, 0xc6, 0xdc, 0x6a, 0x48, 0x94, 0xa6, 0x18, 0xe6, 0x71, 0x72, 0xad, 0x08, 0x66, 0xf2,
+	0x89, 0x00, 0xfd, 0xf1, 0x44, 0x95, 0x7b, 0x3a, 0x36, 0x8f, 0x76, 0xe2, 0x54, 0x31, 0xe1, 0x62,
+	0xc7, 0x4f, 0xe0, 0x85, 0xaf, 0x45, 0x43, 0x0d, 0xf9, 0x00, 0x27, 0xc4, 0xc3, 0xc4, 0x41, 0xf9,
+	0x7b, 0x01, 0x3e, 0xc8, 0x18, 0xa1, 0xc8, 0xbd, 0x1c, 0x0c, 0xad, 0xe7, 0x35, 0xf1, 0x7e, 0xa7,
+	0x6a, 0x18, 0xc4, 0x63, 0x16, 0xc4, 0x03, 0x72, 0x2f, 0x3b, 0x08, 0x8f, 0xa9, 0x36, 0x5e, 0x04,
+	0xab, 0xa6, 0xe1, 0xf9, 0x89, 0x28, 0x7e, 0x27, 0xc0, 0xd9, 0xa6, 0x29, 0x8a, 0x4c, 0xe7, 0x40,
+	0xc9, 0x9e, 0xe2, 0xc4, 0x4a, 0x27, 0x2a, 0x88, 0x7c, 0x9e, 0x21, 0x9f, 0x25, 0x0f, 0x5b, 0x54,
+	0x45, 0xa4, 0x86, 0xe3, 0x98, 0x7c, 0x10, 0xb5, 0x93, 0x87, 0xf2, 0x01, 0x1f, 0x04, 0x0f, 0xc9,
+	0x9f, 0x04, 0x38, 0x9f, 0xd9, 0xcb, 0x93, 0x07, 0x05, 0x1a, 0xa5, 0xac, 0x3e, 0x56, 0x9c, 0xe9,
+	0x5c, 0x11, 0x03, 0xfa, 0x2a, 0x0b, 0xe8, 0x21, 0x99, 0x69, 0xb3, 0x9b, 0xd4, 0xb9, 0xb6, 0xca,
+	0x5b, 0xec, 0x44, 0x47, 0x40, 0xfe, 0x2e, 0xc0, 0xc5, 0x96, 0x3d, 0x72, 0xee, 0x46, 0xd9, 0xae,
+	0x3d, 0x17, 0x67, 0xdf, 0x4f, 0xb9, 0xd8, 0xe9, 0x96, 0x1c, 0xcb, 0xde, 0x09, 0x2f, 0x4e, 0x1b,
+	0xf9, 0x8d, 0x00, 0x67, 0x9b, 0xbe, 0x60, 0xe6, 0x16, 0x5b, 0xf6, 0x67, 0x52, 0xb1, 0xd2, 0x89,
+	0x0a, 0x06, 0x30, 0xc3, 0x02, 0xa8, 0x90, 0xdb, 0xd9, 0x01, 0xbc, 0x62, 0x6a, 0x6a, 0x56, 0x43,
+	0xfc, 0x73, 0x01, 0x4e, 0x27, 0xbf, 0xf2, 0x91, 0x72, 0x91, 0x16, 0xbc, 0xf1, 0xc9, 0x54, 0x94,
+	0x0b, 0xcb, 0x23, 0xd6, 0x7b, 0x0c, 0xab, 0x4c, 0x6e, 0xb5, 0xe9, 0xda, 0xd5, 0x1d, 0x3b, 0x09,
+	0xf4, 0x63, 0x01, 0xa0, 0xf1, 0xe2, 0xe1, 0x18, 0x3b, 0xf7, 0x77, 0xdf, 0x66, 0x48, 0x93, 0x0c,
+	0xe2, 0x55, 0x72, 0xa5, 0x45, 0x3d, 0x54, 0xb7, 0xa3, 0x1e, 0x78, 0x7e, 0xee, 0xd3, 0x37, 0xa3,
+	0xc2, 0x67, 0x6f, 0x46, 0x85, 0x7f, 0xbe, 0x19, 0x15, 0x7e, 0xfc, 0x76, 0xf4, 0xc4, 0x67, 0x6f,
+	0x47, 0x4f, 0xfc, 0xf5, 0xed, 0xe8, 0x89, 0x6f, 0xdf, 0x48, 0x7c, 0x72, 0x4d, 0x99, 0xd9, 0x8b,
+	0x0d, 0xb1, 0xef, 0xae, 0x1b, 0xbd, 0xec, 0xbf, 0x19, 0xdc, 0xf9, 0xef, 0x00, 0x9c, 0x30, 0x60,
+	0x40, 0xd0, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1992,6 +2256,10 @@ type QueryClient interface {
 	ProviderMonthlyPayout(ctx context.Context, in *QueryProviderMonthlyPayoutRequest, opts ...grpc.CallOption) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(ctx context.Context, in *QuerySubscriptionMonthlyPayoutRequest, opts ...grpc.CallOption) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries the jailed providers of a specific chain
+	JailedProviders(ctx context.Context, in *QueryJailedProvidersRequest, opts ...grpc.CallOption) (*QueryJailedProvidersResponse, error)
+	// Queries the QoS excellence aggregate of the providers of a specific chain
+	ProvidersQos(ctx context.Context, in *QueryProvidersQosRequest, opts ...grpc.CallOption) (*QueryProvidersQosResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error)
@@ -2140,6 +2408,24 @@ func (c *queryClient) SubscriptionMonthlyPayout(ctx context.Context, in *QuerySu
 	return out, nil
 }
 
+func (c *queryClient) JailedProviders(ctx context.Context, in *QueryJailedProvidersRequest, opts ...grpc.CallOption) (*QueryJailedProvidersResponse, error) {
+	out := new(QueryJailedProvidersResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/JailedProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProvidersQos(ctx context.Context, in *QueryProvidersQosRequest, opts ...grpc.CallOption) (*QueryProvidersQosResponse, error) {
+	out := new(QueryProvidersQosResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/ProvidersQos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SdkPairing(ctx context.Context, in *QueryGetPairingRequest, opts ...grpc.CallOption) (*QuerySdkPairingResponse, error) {
 	out := new(QuerySdkPairingResponse)
 	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Query/SdkPairing", in, out, opts...)
@@ -2181,6 +2467,10 @@ type QueryServer interface {
 	ProviderMonthlyPayout(context.Context, *QueryProviderMonthlyPayoutRequest) (*QueryProviderMonthlyPayoutResponse, error)
 	// Queries the expected monthly payout of a specific subscription
 	SubscriptionMonthlyPayout(context.Context, *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error)
+	// Queries the jailed providers of a specific chain
+	JailedProviders(context.Context, *QueryJailedProvidersRequest) (*QueryJailedProvidersResponse, error)
+	// Queries the QoS excellence aggregate of the providers of a specific chain
+	ProvidersQos(context.Context, *QueryProvidersQosRequest) (*QueryProvidersQosResponse, error)
 	// this line is used by starport scaffolding # 2
 	// Queries a list of SdkPairing items.
 	SdkPairing(context.Context, *QueryGetPairingRequest) (*QuerySdkPairingResponse, error)
@@ -2235,6 +2525,12 @@ func (*UnimplementedQueryServer) ProviderMonthlyPayout(ctx context.Context, req
 func (*UnimplementedQueryServer) SubscriptionMonthlyPayout(ctx context.Context, req *QuerySubscriptionMonthlyPayoutRequest) (*QuerySubscriptionMonthlyPayoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscriptionMonthlyPayout not implemented")
 }
+func (*UnimplementedQueryServer) JailedProviders(ctx context.Context, req *QueryJailedProvidersRequest) (*QueryJailedProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailedProviders not implemented")
+}
+func (*UnimplementedQueryServer) ProvidersQos(ctx context.Context, req *QueryProvidersQosRequest) (*QueryProvidersQosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvidersQos not implemented")
+}
 func (*UnimplementedQueryServer) SdkPairing(ctx context.Context, req *QueryGetPairingRequest) (*QuerySdkPairingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SdkPairing not implemented")
 }
@@ -2513,6 +2809,42 @@ func _Query_SubscriptionMonthlyPayout_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_JailedProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailedProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).JailedProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/JailedProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailedProviders(ctx, req.(*QueryJailedProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProvidersQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersQosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProvidersQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Query/ProvidersQos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProvidersQos(ctx, req.(*QueryProvidersQosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SdkPairing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetPairingRequest)
 	if err := dec(in); err != nil {
@@ -2595,6 +2927,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubscriptionMonthlyPayout",
 			Handler:    _Query_SubscriptionMonthlyPayout_Handler,
 		},
+		{
+			MethodName: "JailedProviders",
+			Handler:    _Query_JailedProviders_Handler,
+		},
+		{
+			MethodName: "ProvidersQos",
+			Handler:    _Query_ProvidersQos_Handler,
+		},
 		{
 			MethodName: "SdkPairing",
 			Handler:    _Query_SdkPairing_Handler,
@@ -2737,6 +3077,204 @@ func (m *QueryProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryJailedProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailedProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailedProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailedProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailedProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailedProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakeEntry) > 0 {
+		for iNdEx := len(m.StakeEntry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersQosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersQosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersQosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderQos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderQos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderQos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Qos.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersQosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersQosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersQosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProvidersQos) > 0 {
+		for iNdEx := len(m.ProvidersQos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvidersQos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetPairingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3993,6 +4531,87 @@ func (m *QueryProvidersResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryJailedProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailedProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakeEntry) > 0 {
+		for _, e := range m.StakeEntry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProvidersQosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderQos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Qos.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvidersQosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProvidersQos) > 0 {
+		for _, e := range m.ProvidersQos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryGetPairingRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4813,6 +5432,551 @@ func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryJailedProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJailedProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJailedProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryJailedProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryJailedProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryJailedProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeEntry = append(m.StakeEntry, types.StakeEntry{})
+			if err := m.StakeEntry[len(m.StakeEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersQosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersQosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersQosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderQos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderQos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderQos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Qos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Qos.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersQosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersQosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersQosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvidersQos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvidersQos = append(m.ProvidersQos, ProviderQos{})
+			if err := m.ProvidersQos[len(m.ProvidersQos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetPairingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -301,11 +301,125 @@ func (m *RelayCacheSet) GetOptionalMetadata() []Metadata {
 	return nil
 }
 
+type RelayCacheInvalidate struct {
+	ChainID   string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	FromBlock int64  `protobuf:"varint,2,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock   int64  `protobuf:"varint,3,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	Method    string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (m *RelayCacheInvalidate) Reset()         { *m = RelayCacheInvalidate{} }
+func (m *RelayCacheInvalidate) String() string { return proto.CompactTextString(m) }
+func (*RelayCacheInvalidate) ProtoMessage()    {}
+func (*RelayCacheInvalidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{4}
+}
+func (m *RelayCacheInvalidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayCacheInvalidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayCacheInvalidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayCacheInvalidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayCacheInvalidate.Merge(m, src)
+}
+func (m *RelayCacheInvalidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayCacheInvalidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayCacheInvalidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayCacheInvalidate proto.InternalMessageInfo
+
+func (m *RelayCacheInvalidate) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *RelayCacheInvalidate) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *RelayCacheInvalidate) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *RelayCacheInvalidate) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+type RelayCacheInvalidateReply struct {
+	Invalidated uint64 `protobuf:"varint,1,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
+}
+
+func (m *RelayCacheInvalidateReply) Reset()         { *m = RelayCacheInvalidateReply{} }
+func (m *RelayCacheInvalidateReply) String() string { return proto.CompactTextString(m) }
+func (*RelayCacheInvalidateReply) ProtoMessage()    {}
+func (*RelayCacheInvalidateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36fbab536e2bbad1, []int{5}
+}
+func (m *RelayCacheInvalidateReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayCacheInvalidateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayCacheInvalidateReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayCacheInvalidateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayCacheInvalidateReply.Merge(m, src)
+}
+func (m *RelayCacheInvalidateReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayCacheInvalidateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayCacheInvalidateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayCacheInvalidateReply proto.InternalMessageInfo
+
+func (m *RelayCacheInvalidateReply) GetInvalidated() uint64 {
+	if m != nil {
+		return m.Invalidated
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CacheRelayReply)(nil), "lavanet.lava.pairing.CacheRelayReply")
 	proto.RegisterType((*CacheUsage)(nil), "lavanet.lava.pairing.CacheUsage")
 	proto.RegisterType((*RelayCacheGet)(nil), "lavanet.lava.pairing.RelayCacheGet")
 	proto.RegisterType((*RelayCacheSet)(nil), "lavanet.lava.pairing.RelayCacheSet")
+	proto.RegisterType((*RelayCacheInvalidate)(nil), "lavanet.lava.pairing.RelayCacheInvalidate")
+	proto.RegisterType((*RelayCacheInvalidateReply)(nil), "lavanet.lava.pairing.RelayCacheInvalidateReply")
 }
 
 func init() {
@@ -313,40 +427,46 @@ func init() {
 }
 
 var fileDescriptor_36fbab536e2bbad1 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4f, 0x8b, 0x13, 0x31,
-	0x14, 0x9f, 0x74, 0xfb, 0x6f, 0xd3, 0x15, 0x35, 0x2c, 0x32, 0x8c, 0x32, 0x0e, 0x23, 0xab, 0x3d,
-	0xcd, 0x40, 0x05, 0x4f, 0x1e, 0x74, 0xad, 0x6c, 0x17, 0x5c, 0xd0, 0x14, 0x41, 0xbc, 0x48, 0xda,
-	0xbe, 0x9d, 0x06, 0xa7, 0x93, 0x31, 0x49, 0x8b, 0xf5, 0x53, 0xf8, 0x01, 0xfc, 0x36, 0x5e, 0xf6,
-	0xb8, 0x07, 0x0f, 0x9e, 0x44, 0xda, 0x4f, 0xe1, 0x4d, 0x26, 0x9d, 0x69, 0xb7, 0xd2, 0x2d, 0x0b,
-	0x1e, 0xf6, 0x94, 0xbc, 0x97, 0xdf, 0x2f, 0xf9, 0xfd, 0x1e, 0xef, 0x05, 0x1f, 0xc4, 0x6c, 0xc2,
-	0x12, 0xd0, 0x61, 0xb6, 0x86, 0x29, 0xe3, 0x92, 0x27, 0x51, 0x28, 0x21, 0x66, 0xd3, 0x17, 0xac,
-	0x3f, 0x84, 0x20, 0x95, 0x42, 0x0b, 0xb2, 0x9f, 0xc3, 0x82, 0x6c, 0x0d, 0x72, 0x98, 0xb3, 0x1f,
-	0x89, 0x48, 0x18, 0x40, 0x98, 0xed, 0x16, 0x58, 0xc7, 0xbb, 0xfc, 0xca, 0x1c, 0x71, 0x37, 0x12,
-	0x22, 0x8a, 0x21, 0x34, 0x51, 0x6f, 0x7c, 0x1a, 0xc2, 0x28, 0xd5, 0xf9, 0xa1, 0xff, 0x0d, 0xe1,
-	0x9b, 0xe6, 0x69, 0x9a, 0x31, 0x28, 0xa4, 0xf1, 0x94, 0x3c, 0xc1, 0x15, 0x99, 0x6d, 0x6c, 0xe4,
-	0xa1, 0x66, 0xa3, 0xe5, 0x05, 0x9b, 0xe4, 0x04, 0x2b, 0x02, 0x5d, 0xc0, 0xc9, 0x1b, 0x7c, 0x5b,
-	0xa4, 0x9a, 0x8b, 0x84, 0xc5, 0x1f, 0x46, 0xa0, 0xd9, 0x80, 0x69, 0x66, 0x97, 0xbc, 0x9d, 0x66,
-	0xa3, 0xe5, 0x6e, 0xbe, 0xe3, 0x24, 0x47, 0x1d, 0x96, 0xcf, 0x7e, 0xdd, 0xb7, 0xe8, 0xad, 0x82,
-	0x5e, 0xe4, 0xfd, 0x57, 0x18, 0x1b, 0x75, 0x6f, 0x15, 0x8b, 0x80, 0xdc, 0xc3, 0xbb, 0x26, 0xea,
-	0x70, 0xad, 0x8c, 0xb8, 0x32, 0x5d, 0x25, 0x88, 0x87, 0x1b, 0x26, 0x38, 0xe1, 0x4a, 0x81, 0xb2,
-	0x4b, 0xe6, 0xfc, 0x62, 0xca, 0xff, 0x8e, 0xf0, 0x0d, 0xba, 0x2c, 0xf6, 0x11, 0x68, 0xf2, 0x0c,
-	0xd7, 0x24, 0x7c, 0x1a, 0x83, 0xd2, 0xb9, 0xd9, 0x87, 0x5b, 0xcc, 0xbe, 0x96, 0x7c, 0xc2, 0x34,
-	0xb4, 0x99, 0x66, 0xb4, 0xa0, 0x65, 0x9a, 0x7a, 0xb1, 0xe8, 0x7f, 0xec, 0x30, 0x35, 0x34, 0x6f,
-	0xee, 0xd1, 0x55, 0x82, 0xd8, 0xb8, 0xd6, 0x1f, 0x32, 0x9e, 0x1c, 0xb7, 0xed, 0x1d, 0x0f, 0x35,
-	0x77, 0x69, 0x11, 0x66, 0xbc, 0x53, 0x9e, 0xb0, 0x98, 0x7f, 0x81, 0x81, 0x5d, 0xf6, 0x50, 0xb3,
-	0x4e, 0x57, 0x09, 0xe2, 0xe0, 0x7a, 0x2a, 0xc5, 0x84, 0x0f, 0x40, 0xda, 0x15, 0x43, 0x5c, 0xc6,
-	0xfe, 0x8f, 0xd2, 0x45, 0x17, 0xdd, 0x6b, 0x75, 0xf1, 0x14, 0xd7, 0x25, 0xa8, 0x54, 0x24, 0x0a,
-	0x8c, 0x89, 0xab, 0x74, 0xcb, 0x92, 0xb1, 0x5e, 0x83, 0xca, 0xb6, 0x1a, 0x54, 0xd7, 0x6b, 0xb0,
-	0xb9, 0xd5, 0x6a, 0xff, 0xd3, 0x6a, 0xad, 0x3f, 0x08, 0xef, 0x19, 0x95, 0x20, 0x4d, 0x61, 0xc9,
-	0x3b, 0x5c, 0x3f, 0x02, 0x6d, 0x52, 0xe4, 0xc1, 0x16, 0x57, 0x45, 0x33, 0x39, 0x07, 0x9b, 0x41,
-	0xff, 0x8c, 0x97, 0x6f, 0x91, 0x63, 0x5c, 0xef, 0x5e, 0xf9, 0xe6, 0x2e, 0x68, 0xe7, 0x4e, 0xb0,
-	0x98, 0xe1, 0xa0, 0x98, 0xe1, 0xe0, 0x65, 0x36, 0xc3, 0xbe, 0x45, 0xda, 0xb8, 0xda, 0x01, 0x16,
-	0xeb, 0x21, 0xb9, 0x04, 0xe3, 0x78, 0x5b, 0x54, 0x99, 0xb1, 0xf2, 0xad, 0xc3, 0xe7, 0x67, 0x33,
-	0x17, 0x9d, 0xcf, 0x5c, 0xf4, 0x7b, 0xe6, 0xa2, 0xaf, 0x73, 0xd7, 0x3a, 0x9f, 0xbb, 0xd6, 0xcf,
-	0xb9, 0x6b, 0xbd, 0x7f, 0x14, 0x71, 0x3d, 0x1c, 0xf7, 0x82, 0xbe, 0x18, 0x85, 0x6b, 0x3f, 0xcd,
-	0xe7, 0xe5, 0x5f, 0xa3, 0xa7, 0x29, 0xa8, 0x5e, 0xd5, 0x3c, 0xfb, 0xf8, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x82, 0x4c, 0x8f, 0x0d, 0xe3, 0x04, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0xf5, 0x36, 0x69, 0x9a, 0x6e, 0xfa, 0xe9, 0x6b, 0x57, 0x55, 0x65, 0x0c, 0x32, 0x96, 0x51,
+	0x21, 0xe2, 0x60, 0x4b, 0x41, 0xe2, 0x04, 0x12, 0x2d, 0x45, 0x6d, 0x25, 0x2a, 0xc1, 0x56, 0x48,
+	0x88, 0x0b, 0xda, 0x24, 0x53, 0x7b, 0x85, 0xed, 0x75, 0xed, 0x6d, 0x44, 0x38, 0x71, 0xe2, 0xcc,
+	0x0f, 0xe0, 0xdf, 0x70, 0xe9, 0xb1, 0x07, 0x0e, 0x9c, 0x10, 0x6a, 0x7f, 0x05, 0x37, 0xe4, 0xb5,
+	0x1d, 0xbb, 0x90, 0x86, 0x48, 0x1c, 0x38, 0xd9, 0xf3, 0xf6, 0xbd, 0xf1, 0xcc, 0xdb, 0xf1, 0xe0,
+	0xcd, 0x80, 0x8d, 0x58, 0x04, 0xd2, 0xcd, 0x9e, 0x6e, 0xcc, 0x78, 0xc2, 0x23, 0xcf, 0x4d, 0x20,
+	0x60, 0xe3, 0xc7, 0x6c, 0xe0, 0x83, 0x13, 0x27, 0x42, 0x0a, 0xb2, 0x5e, 0xd0, 0x9c, 0xec, 0xe9,
+	0x14, 0x34, 0x63, 0xdd, 0x13, 0x9e, 0x50, 0x04, 0x37, 0x7b, 0xcb, 0xb9, 0x86, 0x75, 0x75, 0xca,
+	0x82, 0x71, 0xdd, 0x13, 0xc2, 0x0b, 0xc0, 0x55, 0x51, 0xff, 0xe4, 0xc8, 0x85, 0x30, 0x96, 0xc5,
+	0xa1, 0xfd, 0x09, 0xe1, 0xff, 0xd5, 0xa7, 0x69, 0xa6, 0xa0, 0x10, 0x07, 0x63, 0x72, 0x1f, 0x2f,
+	0x26, 0xd9, 0x8b, 0x8e, 0x2c, 0xd4, 0xed, 0xf4, 0x2c, 0x67, 0x5a, 0x39, 0x4e, 0x25, 0xa0, 0x39,
+	0x9d, 0x3c, 0xc7, 0x6b, 0x22, 0x96, 0x5c, 0x44, 0x2c, 0x78, 0x1d, 0x82, 0x64, 0x43, 0x26, 0x99,
+	0xbe, 0x60, 0x35, 0xba, 0x9d, 0x9e, 0x39, 0x3d, 0xc7, 0x41, 0xc1, 0xda, 0x6e, 0x9e, 0x7e, 0xbb,
+	0xa9, 0xd1, 0xd5, 0x52, 0x5e, 0xe2, 0xf6, 0x53, 0x8c, 0x55, 0x75, 0x2f, 0x52, 0xe6, 0x01, 0xb9,
+	0x81, 0x97, 0x55, 0xb4, 0xc7, 0x65, 0xaa, 0x8a, 0x6b, 0xd2, 0x0a, 0x20, 0x16, 0xee, 0xa8, 0xe0,
+	0x80, 0xa7, 0x29, 0xa4, 0xfa, 0x82, 0x3a, 0xaf, 0x43, 0xf6, 0x67, 0x84, 0xff, 0xa3, 0x13, 0xb3,
+	0x77, 0x41, 0x92, 0x47, 0x78, 0x29, 0x81, 0xe3, 0x13, 0x48, 0x65, 0xd1, 0xec, 0xed, 0x19, 0xcd,
+	0x3e, 0x4b, 0xf8, 0x88, 0x49, 0xd8, 0x61, 0x92, 0xd1, 0x52, 0x96, 0xd5, 0xd4, 0x0f, 0xc4, 0xe0,
+	0xcd, 0x1e, 0x4b, 0x7d, 0xf5, 0xcd, 0x15, 0x5a, 0x01, 0x44, 0xc7, 0x4b, 0x03, 0x9f, 0xf1, 0x68,
+	0x7f, 0x47, 0x6f, 0x58, 0xa8, 0xbb, 0x4c, 0xcb, 0x30, 0xd3, 0x1d, 0xf1, 0x88, 0x05, 0xfc, 0x1d,
+	0x0c, 0xf5, 0xa6, 0x85, 0xba, 0x6d, 0x5a, 0x01, 0xc4, 0xc0, 0xed, 0x38, 0x11, 0x23, 0x3e, 0x84,
+	0x44, 0x5f, 0x54, 0xc2, 0x49, 0x6c, 0x7f, 0x59, 0xa8, 0x77, 0x71, 0xf8, 0x4f, 0xbb, 0x78, 0x80,
+	0xdb, 0x09, 0xa4, 0xb1, 0x88, 0x52, 0xd0, 0x9b, 0x73, 0x4e, 0xcb, 0x44, 0x71, 0xd9, 0x83, 0xc5,
+	0x59, 0x1e, 0xb4, 0x2e, 0x7b, 0x30, 0x7d, 0xd4, 0x96, 0xfe, 0x6a, 0xd4, 0xde, 0x23, 0xbc, 0x5e,
+	0xd9, 0xba, 0x1f, 0x8d, 0x58, 0xc0, 0x87, 0x4c, 0x42, 0xbd, 0x7b, 0xf4, 0xfb, 0x1d, 0x26, 0x22,
+	0xdc, 0xce, 0x8c, 0x52, 0xae, 0x35, 0x68, 0x05, 0x64, 0x3a, 0x29, 0xf2, 0xb3, 0x86, 0x3a, 0x2b,
+	0x43, 0xb2, 0x81, 0x5b, 0x21, 0x48, 0x5f, 0xe4, 0x17, 0xbf, 0x4c, 0x8b, 0xc8, 0x7e, 0x88, 0xaf,
+	0x4d, 0xab, 0x20, 0xff, 0x2b, 0x2d, 0xdc, 0xe1, 0x13, 0x68, 0x58, 0x8c, 0x7f, 0x1d, 0xea, 0xfd,
+	0x40, 0x78, 0x45, 0xe9, 0x21, 0x51, 0x19, 0xc8, 0x4b, 0xdc, 0xde, 0x05, 0xa9, 0x20, 0x72, 0x6b,
+	0xc6, 0xbd, 0x94, 0xbf, 0x83, 0xb1, 0x39, 0x9d, 0xf4, 0xcb, 0x82, 0xb0, 0x35, 0xb2, 0x8f, 0xdb,
+	0x87, 0x73, 0x67, 0x3e, 0x04, 0x69, 0x6c, 0x38, 0xf9, 0x16, 0x72, 0xca, 0x2d, 0xe4, 0x3c, 0xc9,
+	0xb6, 0x90, 0xad, 0x91, 0x1d, 0xdc, 0xda, 0x03, 0x16, 0x48, 0x9f, 0x5c, 0xc1, 0x31, 0xac, 0x19,
+	0x55, 0xa9, 0xc5, 0x60, 0x6b, 0xbd, 0x0f, 0x08, 0xaf, 0xd5, 0x7b, 0xdf, 0x1a, 0x86, 0x3c, 0x22,
+	0xc7, 0x78, 0xb5, 0x6e, 0x63, 0xc0, 0xc6, 0x29, 0xb9, 0xfb, 0xa7, 0x72, 0x2b, 0x85, 0xe1, 0xce,
+	0xcf, 0x2d, 0x9c, 0xd9, 0xde, 0x3a, 0x3d, 0x37, 0xd1, 0xd9, 0xb9, 0x89, 0xbe, 0x9f, 0x9b, 0xe8,
+	0xe3, 0x85, 0xa9, 0x9d, 0x5d, 0x98, 0xda, 0xd7, 0x0b, 0x53, 0x7b, 0x75, 0xc7, 0xe3, 0xd2, 0x3f,
+	0xe9, 0x3b, 0x03, 0x11, 0xba, 0x97, 0x96, 0xf6, 0xdb, 0xc9, 0xda, 0x96, 0xe3, 0x18, 0xd2, 0x7e,
+	0x4b, 0xf5, 0x7f, 0xef, 0xe7, 0x00, 0xa2, 0x17, 0x65, 0x2f, 0x2e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -501,6 +621,78 @@ var _RelayerCache_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lavanet/lava/pairing/relayCache.proto",
 }
 
+// RelayerCacheAdminClient is the client API for RelayerCacheAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RelayerCacheAdminClient interface {
+	InvalidateRelays(ctx context.Context, in *RelayCacheInvalidate, opts ...grpc.CallOption) (*RelayCacheInvalidateReply, error)
+}
+
+type relayerCacheAdminClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRelayerCacheAdminClient(cc grpc1.ClientConn) RelayerCacheAdminClient {
+	return &relayerCacheAdminClient{cc}
+}
+
+func (c *relayerCacheAdminClient) InvalidateRelays(ctx context.Context, in *RelayCacheInvalidate, opts ...grpc.CallOption) (*RelayCacheInvalidateReply, error) {
+	out := new(RelayCacheInvalidateReply)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.RelayerCacheAdmin/InvalidateRelays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelayerCacheAdminServer is the server API for RelayerCacheAdmin service.
+type RelayerCacheAdminServer interface {
+	InvalidateRelays(context.Context, *RelayCacheInvalidate) (*RelayCacheInvalidateReply, error)
+}
+
+// UnimplementedRelayerCacheAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedRelayerCacheAdminServer struct {
+}
+
+func (*UnimplementedRelayerCacheAdminServer) InvalidateRelays(ctx context.Context, req *RelayCacheInvalidate) (*RelayCacheInvalidateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateRelays not implemented")
+}
+
+func RegisterRelayerCacheAdminServer(s grpc1.Server, srv RelayerCacheAdminServer) {
+	s.RegisterService(&_RelayerCacheAdmin_serviceDesc, srv)
+}
+
+func _RelayerCacheAdmin_InvalidateRelays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCacheInvalidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerCacheAdminServer).InvalidateRelays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.RelayerCacheAdmin/InvalidateRelays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerCacheAdminServer).InvalidateRelays(ctx, req.(*RelayCacheInvalidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RelayerCacheAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lavanet.lava.pairing.RelayerCacheAdmin",
+	HandlerType: (*RelayerCacheAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InvalidateRelays",
+			Handler:    _RelayerCacheAdmin_InvalidateRelays_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lavanet/lava/pairing/relayCache.proto",
+}
+
 func (m *CacheRelayReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -741,6 +933,81 @@ func (m *RelayCacheSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayCacheInvalidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheInvalidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheInvalidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.ToBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromBlock != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.FromBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintRelayCache(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayCacheInvalidateReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayCacheInvalidateReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayCacheInvalidateReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Invalidated != 0 {
+		i = encodeVarintRelayCache(dAtA, i, uint64(m.Invalidated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRelayCache(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRelayCache(v)
 	base := offset
@@ -852,6 +1119,41 @@ func (m *RelayCacheSet) Size() (n int) {
 	return n
 }
 
+func (m *RelayCacheInvalidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	if m.FromBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.FromBlock))
+	}
+	if m.ToBlock != 0 {
+		n += 1 + sovRelayCache(uint64(m.ToBlock))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovRelayCache(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayCacheInvalidateReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Invalidated != 0 {
+		n += 1 + sovRelayCache(uint64(m.Invalidated))
+	}
+	return n
+}
+
 func sovRelayCache(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1544,6 +1846,227 @@ func (m *RelayCacheSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RelayCacheInvalidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheInvalidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheInvalidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBlock", wireType)
+			}
+			m.FromBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBlock", wireType)
+			}
+			m.ToBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayCacheInvalidateReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayCacheInvalidateReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayCacheInvalidateReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidated", wireType)
+			}
+			m.Invalidated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Invalidated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRelayCache(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -618,6 +618,110 @@ func (m *MsgUnfreezeProviderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnfreezeProviderResponse proto.InternalMessageInfo
 
+type MsgBailProvider struct {
+	Creator   string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainID   string     `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Bail      types.Coin `protobuf:"bytes,3,opt,name=bail,proto3" json:"bail"`
+	Validator string     `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *MsgBailProvider) Reset()         { *m = MsgBailProvider{} }
+func (m *MsgBailProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgBailProvider) ProtoMessage()    {}
+func (*MsgBailProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b85a84d2198a91, []int{11}
+}
+func (m *MsgBailProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBailProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBailProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBailProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBailProvider.Merge(m, src)
+}
+func (m *MsgBailProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBailProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBailProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBailProvider proto.InternalMessageInfo
+
+func (m *MsgBailProvider) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBailProvider) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *MsgBailProvider) GetBail() types.Coin {
+	if m != nil {
+		return m.Bail
+	}
+	return types.Coin{}
+}
+
+func (m *MsgBailProvider) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+type MsgBailProviderResponse struct {
+}
+
+func (m *MsgBailProviderResponse) Reset()         { *m = MsgBailProviderResponse{} }
+func (m *MsgBailProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBailProviderResponse) ProtoMessage()    {}
+func (*MsgBailProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07b85a84d2198a91, []int{12}
+}
+func (m *MsgBailProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBailProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBailProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBailProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBailProviderResponse.Merge(m, src)
+}
+func (m *MsgBailProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBailProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBailProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBailProviderResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStakeProvider)(nil), "lavanet.lava.pairing.MsgStakeProvider")
 	proto.RegisterType((*MsgStakeProviderResponse)(nil), "lavanet.lava.pairing.MsgStakeProviderResponse")
@@ -630,61 +734,65 @@ func init() {
 	proto.RegisterType((*MsgFreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgFreezeProviderResponse")
 	proto.RegisterType((*MsgUnfreezeProvider)(nil), "lavanet.lava.pairing.MsgUnfreezeProvider")
 	proto.RegisterType((*MsgUnfreezeProviderResponse)(nil), "lavanet.lava.pairing.MsgUnfreezeProviderResponse")
+	proto.RegisterType((*MsgBailProvider)(nil), "lavanet.lava.pairing.MsgBailProvider")
+	proto.RegisterType((*MsgBailProviderResponse)(nil), "lavanet.lava.pairing.MsgBailProviderResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/pairing/tx.proto", fileDescriptor_07b85a84d2198a91) }
 
 var fileDescriptor_07b85a84d2198a91 = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xeb, 0x46,
-	0x14, 0x8e, 0x89, 0x09, 0xc9, 0x09, 0xbf, 0x03, 0x2a, 0xc6, 0x40, 0x9a, 0xba, 0x6a, 0x93, 0x4a,
-	0xad, 0x5d, 0xe8, 0xa2, 0x52, 0x77, 0x0d, 0x2d, 0x15, 0x2d, 0x51, 0x91, 0x51, 0x17, 0xed, 0x26,
-	0x9a, 0xd8, 0x83, 0x19, 0xb0, 0x3d, 0x96, 0x67, 0x88, 0xa0, 0x4f, 0xd1, 0x7d, 0x57, 0x7d, 0x1b,
-	0x96, 0x2c, 0xbb, 0xba, 0xba, 0x82, 0x77, 0xb8, 0xeb, 0x2b, 0x4f, 0x6c, 0x13, 0x3b, 0x01, 0x45,
-	0xba, 0x77, 0x15, 0x9f, 0x39, 0xdf, 0x39, 0xdf, 0x77, 0x7e, 0x26, 0x03, 0xfb, 0x3e, 0x1e, 0xe1,
-	0x90, 0x08, 0x2b, 0xf9, 0xb5, 0x22, 0x4c, 0x63, 0x1a, 0x7a, 0x96, 0xb8, 0x35, 0xa3, 0x98, 0x09,
-	0x86, 0xb6, 0x52, 0xb7, 0x99, 0xfc, 0x9a, 0xa9, 0x5b, 0x6f, 0x39, 0x8c, 0x07, 0x8c, 0x5b, 0x43,
-	0xcc, 0x89, 0x35, 0x3a, 0x18, 0x12, 0x81, 0x0f, 0x2c, 0x87, 0xd1, 0x70, 0x1c, 0xa5, 0x6f, 0x79,
-	0xcc, 0x63, 0xf2, 0xd3, 0x4a, 0xbe, 0xd2, 0xd3, 0x6e, 0x81, 0x8a, 0x44, 0xcc, 0xb9, 0xe4, 0x82,
-	0xc5, 0xd8, 0x23, 0x16, 0x09, 0xdd, 0x88, 0xd1, 0x50, 0xa4, 0xc8, 0xf6, 0x4c, 0x51, 0x31, 0xf1,
-	0xf1, 0xdd, 0x18, 0x61, 0xfc, 0x5b, 0x85, 0xf5, 0x3e, 0xf7, 0xce, 0x05, 0xbe, 0x26, 0x67, 0x31,
-	0x1b, 0x51, 0x97, 0xc4, 0x48, 0x83, 0x25, 0x27, 0x26, 0x58, 0xb0, 0x58, 0x53, 0xda, 0x4a, 0xb7,
-	0x61, 0x67, 0xa6, 0xf4, 0x5c, 0x62, 0x1a, 0x9e, 0xfc, 0xa4, 0x2d, 0xa4, 0x9e, 0xb1, 0x89, 0xbe,
-	0x87, 0x1a, 0x0e, 0xd8, 0x4d, 0x28, 0xb4, 0x6a, 0x5b, 0xe9, 0x36, 0x0f, 0x77, 0xcc, 0x71, 0x6d,
-	0x66, 0x52, 0x9b, 0x99, 0xd6, 0x66, 0x1e, 0x31, 0x1a, 0xf6, 0xd4, 0xfb, 0x37, 0x9f, 0x56, 0xec,
-	0x14, 0x8e, 0x7e, 0x81, 0x46, 0xa6, 0x9a, 0x6b, 0x6a, 0xbb, 0xda, 0x6d, 0x1e, 0x7e, 0x6e, 0x16,
-	0xba, 0x35, 0x59, 0xa1, 0xf9, 0x73, 0x8a, 0x4d, 0xb3, 0x3c, 0xc7, 0xa2, 0x36, 0x34, 0x3d, 0xc2,
-	0x7c, 0xe6, 0x60, 0x41, 0x59, 0xa8, 0x2d, 0xb6, 0x95, 0xee, 0xa2, 0x3d, 0x79, 0x94, 0xa8, 0x0f,
-	0x58, 0x48, 0xaf, 0x49, 0xac, 0xd5, 0xc6, 0xea, 0x53, 0x13, 0x1d, 0xc3, 0xaa, 0x4b, 0x7c, 0xe2,
-	0x61, 0x41, 0x06, 0x3e, 0x0d, 0xa8, 0xd0, 0x96, 0xe6, 0xab, 0x62, 0x25, 0x0b, 0x3b, 0x4d, 0xa2,
-	0x90, 0x05, 0x9b, 0x79, 0x1e, 0x87, 0x05, 0x01, 0xe5, 0x3c, 0xd1, 0x52, 0x6f, 0x2b, 0x5d, 0xd5,
-	0x46, 0x99, 0xeb, 0x28, 0xf7, 0xa0, 0x3d, 0x68, 0x8c, 0xb0, 0x4f, 0x5d, 0xd9, 0xec, 0x86, 0x14,
-	0xf5, 0x7c, 0x60, 0xe8, 0xa0, 0x95, 0x87, 0x63, 0x13, 0x1e, 0xb1, 0x90, 0x13, 0xe3, 0x02, 0x50,
-	0x9f, 0x7b, 0x7f, 0x84, 0xfc, 0x83, 0x47, 0x57, 0xd0, 0x50, 0x2d, 0x6b, 0xd8, 0x03, 0x7d, 0x9a,
-	0x27, 0x57, 0xf1, 0x4e, 0x81, 0xb5, 0x3e, 0xf7, 0xec, 0x64, 0xa5, 0xce, 0xf0, 0x5d, 0x40, 0x42,
-	0xf1, 0x8a, 0x86, 0x1f, 0xa0, 0x26, 0x97, 0x8f, 0x6b, 0x0b, 0x72, 0xd0, 0x86, 0x39, 0xeb, 0x5a,
-	0x98, 0x32, 0xdb, 0x39, 0x91, 0x1d, 0xb2, 0xd3, 0x08, 0xf4, 0x35, 0x6c, 0xb8, 0x84, 0x3b, 0x31,
-	0x8d, 0x92, 0x59, 0x9e, 0x8b, 0x04, 0xa9, 0xa9, 0x32, 0xff, 0xb4, 0x03, 0xfd, 0x09, 0x5b, 0x3e,
-	0x16, 0x84, 0x8b, 0xc1, 0xd0, 0x67, 0xce, 0xf5, 0x20, 0x26, 0x11, 0x8b, 0x05, 0xd7, 0x16, 0x25,
-	0x6f, 0x67, 0x36, 0xef, 0xa9, 0x8c, 0xe8, 0x25, 0x01, 0xb6, 0xc4, 0xdb, 0xc8, 0x2f, 0x1f, 0xf1,
-	0x5f, 0xd5, 0x7a, 0x75, 0x5d, 0x35, 0x7e, 0x87, 0x8d, 0x29, 0x38, 0xda, 0x86, 0x25, 0x1e, 0x11,
-	0x67, 0x40, 0xdd, 0xb4, 0xf2, 0x5a, 0x62, 0x9e, 0xb8, 0xe8, 0x33, 0x58, 0x9e, 0x94, 0x23, 0x27,
-	0xa0, 0xda, 0xcd, 0x89, 0xec, 0x46, 0x0f, 0xb6, 0x4b, 0x8d, 0xcc, 0x9a, 0x8c, 0x3a, 0xb0, 0x16,
-	0x93, 0x2b, 0xe2, 0x08, 0xe2, 0x0e, 0xd2, 0xfe, 0x25, 0xe9, 0xeb, 0xf6, 0x6a, 0x76, 0x2c, 0xc3,
-	0xb8, 0x81, 0x61, 0xa3, 0xcf, 0xbd, 0xe3, 0x98, 0x90, 0xbf, 0xe7, 0x59, 0x09, 0x1d, 0xea, 0xe3,
-	0x1d, 0x70, 0xc7, 0x03, 0x69, 0xd8, 0xb9, 0x8d, 0x3e, 0x49, 0x46, 0x85, 0x39, 0x0b, 0xd3, 0x8d,
-	0x48, 0x2d, 0x63, 0x17, 0x76, 0xa6, 0x28, 0xf2, 0x6d, 0xf8, 0x0d, 0x36, 0xe5, 0xae, 0x5c, 0x7c,
-	0x04, 0x05, 0xc6, 0x3e, 0xec, 0xce, 0x48, 0x96, 0x71, 0x1d, 0xfe, 0xa7, 0x42, 0xb5, 0xcf, 0x3d,
-	0xe4, 0xc1, 0x4a, 0xf1, 0xdf, 0xeb, 0xcb, 0xd9, 0xc3, 0x2d, 0x5f, 0x24, 0xdd, 0x9c, 0x0f, 0x97,
-	0x4f, 0x21, 0x80, 0xb5, 0xf2, 0x6d, 0xeb, 0xbe, 0x98, 0xa2, 0x84, 0xd4, 0xbf, 0x9d, 0x17, 0x99,
-	0xd3, 0xb9, 0xb0, 0x5c, 0xb8, 0x55, 0x5f, 0xbc, 0x98, 0x61, 0x12, 0xa6, 0x7f, 0x33, 0x17, 0x2c,
-	0x67, 0xb9, 0x82, 0xd5, 0xd2, 0xba, 0x74, 0x5e, 0x4c, 0x50, 0x04, 0xea, 0xd6, 0x9c, 0xc0, 0x9c,
-	0x2b, 0x82, 0xf5, 0xa9, 0xd5, 0xf8, 0xea, 0x95, 0xbe, 0x14, 0xa1, 0xfa, 0xc1, 0xdc, 0xd0, 0x8c,
-	0xb1, 0xf7, 0xe3, 0xfd, 0x63, 0x4b, 0x79, 0x78, 0x6c, 0x29, 0x6f, 0x1f, 0x5b, 0xca, 0x3f, 0x4f,
-	0xad, 0xca, 0xc3, 0x53, 0xab, 0xf2, 0xff, 0x53, 0xab, 0xf2, 0x57, 0xc7, 0xa3, 0xe2, 0xf2, 0x66,
-	0x68, 0x3a, 0x2c, 0xb0, 0x0a, 0x8f, 0xe4, 0xed, 0xf3, 0xdb, 0x7d, 0x17, 0x11, 0x3e, 0xac, 0xc9,
-	0x77, 0xf2, 0xbb, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xd0, 0x0c, 0x12, 0xe0, 0x07, 0x00,
-	0x00,
+	// 816 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x37, 0x4e, 0x9a, 0xbc, 0xec, 0xf6, 0xcf, 0x6c, 0x45, 0x5d, 0xef, 0x6e, 0x08, 0x46,
+	0x90, 0x20, 0xb1, 0x36, 0xed, 0x1e, 0x90, 0xb8, 0x91, 0x85, 0x45, 0x0b, 0x1b, 0xb1, 0x72, 0xc5,
+	0x01, 0x2e, 0xd1, 0xc4, 0x9e, 0xba, 0xd3, 0xda, 0x1e, 0xcb, 0x33, 0x8d, 0x5a, 0xbe, 0x00, 0x57,
+	0x8e, 0x48, 0x7c, 0xa1, 0x1e, 0x7b, 0xe4, 0x84, 0x50, 0xfb, 0x1d, 0x38, 0x23, 0x8f, 0xff, 0x34,
+	0x76, 0x92, 0xca, 0x52, 0x39, 0xd9, 0x33, 0xef, 0xf7, 0xde, 0xfb, 0xbd, 0xf7, 0x7e, 0x33, 0x1a,
+	0x78, 0xe1, 0xe3, 0x39, 0x0e, 0x89, 0xb0, 0x92, 0xaf, 0x15, 0x61, 0x1a, 0xd3, 0xd0, 0xb3, 0xc4,
+	0x85, 0x19, 0xc5, 0x4c, 0x30, 0xb4, 0x9b, 0x99, 0xcd, 0xe4, 0x6b, 0x66, 0x66, 0xbd, 0xef, 0x30,
+	0x1e, 0x30, 0x6e, 0xcd, 0x30, 0x27, 0xd6, 0xfc, 0x60, 0x46, 0x04, 0x3e, 0xb0, 0x1c, 0x46, 0xc3,
+	0xd4, 0x4b, 0xdf, 0xf5, 0x98, 0xc7, 0xe4, 0xaf, 0x95, 0xfc, 0x65, 0xbb, 0xa3, 0x52, 0x2a, 0x12,
+	0x31, 0xe7, 0x84, 0x0b, 0x16, 0x63, 0x8f, 0x58, 0x24, 0x74, 0x23, 0x46, 0x43, 0x91, 0x21, 0x07,
+	0x2b, 0x49, 0xc5, 0xc4, 0xc7, 0x97, 0x29, 0xc2, 0xf8, 0xb3, 0x09, 0xdb, 0x13, 0xee, 0x1d, 0x09,
+	0x7c, 0x46, 0xde, 0xc7, 0x6c, 0x4e, 0x5d, 0x12, 0x23, 0x0d, 0x36, 0x9c, 0x98, 0x60, 0xc1, 0x62,
+	0x4d, 0x19, 0x28, 0xa3, 0xae, 0x9d, 0x2f, 0xa5, 0xe5, 0x04, 0xd3, 0xf0, 0xed, 0x37, 0xda, 0xa3,
+	0xcc, 0x92, 0x2e, 0xd1, 0x97, 0xd0, 0xc6, 0x01, 0x3b, 0x0f, 0x85, 0xd6, 0x1c, 0x28, 0xa3, 0xde,
+	0xe1, 0xbe, 0x99, 0xd6, 0x66, 0x26, 0xb5, 0x99, 0x59, 0x6d, 0xe6, 0x6b, 0x46, 0xc3, 0xb1, 0x7a,
+	0xf5, 0xf7, 0x87, 0x0d, 0x3b, 0x83, 0xa3, 0xef, 0xa0, 0x9b, 0xb3, 0xe6, 0x9a, 0x3a, 0x68, 0x8e,
+	0x7a, 0x87, 0x1f, 0x9b, 0xa5, 0x6e, 0x2d, 0x56, 0x68, 0x7e, 0x9b, 0x61, 0xb3, 0x28, 0x77, 0xbe,
+	0x68, 0x00, 0x3d, 0x8f, 0x30, 0x9f, 0x39, 0x58, 0x50, 0x16, 0x6a, 0xad, 0x81, 0x32, 0x6a, 0xd9,
+	0x8b, 0x5b, 0x09, 0xfb, 0x80, 0x85, 0xf4, 0x8c, 0xc4, 0x5a, 0x3b, 0x65, 0x9f, 0x2d, 0xd1, 0x1b,
+	0xd8, 0x74, 0x89, 0x4f, 0x3c, 0x2c, 0xc8, 0xd4, 0xa7, 0x01, 0x15, 0xda, 0x46, 0xbd, 0x2a, 0x9e,
+	0xe4, 0x6e, 0xef, 0x12, 0x2f, 0x64, 0xc1, 0xd3, 0x22, 0x8e, 0xc3, 0x82, 0x80, 0x72, 0x9e, 0x70,
+	0xe9, 0x0c, 0x94, 0x91, 0x6a, 0xa3, 0xdc, 0xf4, 0xba, 0xb0, 0xa0, 0xe7, 0xd0, 0x9d, 0x63, 0x9f,
+	0xba, 0xb2, 0xd9, 0x5d, 0x49, 0xea, 0x6e, 0xc3, 0xd0, 0x41, 0xab, 0x0e, 0xc7, 0x26, 0x3c, 0x62,
+	0x21, 0x27, 0xc6, 0x31, 0xa0, 0x09, 0xf7, 0x7e, 0x0a, 0xf9, 0x83, 0x47, 0x57, 0xe2, 0xd0, 0xac,
+	0x72, 0x78, 0x0e, 0xfa, 0x72, 0x9e, 0x82, 0xc5, 0xbf, 0x0a, 0x6c, 0x4d, 0xb8, 0x67, 0x27, 0x92,
+	0x7a, 0x8f, 0x2f, 0x03, 0x12, 0x8a, 0x7b, 0x38, 0x7c, 0x05, 0x6d, 0x29, 0x3e, 0xae, 0x3d, 0x92,
+	0x83, 0x36, 0xcc, 0x55, 0xc7, 0xc2, 0x94, 0xd1, 0x8e, 0x88, 0xec, 0x90, 0x9d, 0x79, 0xa0, 0xcf,
+	0x61, 0xc7, 0x25, 0xdc, 0x89, 0x69, 0x94, 0xcc, 0xf2, 0x48, 0x24, 0x48, 0x4d, 0x95, 0xf1, 0x97,
+	0x0d, 0xe8, 0x67, 0xd8, 0xf5, 0xb1, 0x20, 0x5c, 0x4c, 0x67, 0x3e, 0x73, 0xce, 0xa6, 0x31, 0x89,
+	0x58, 0x2c, 0xb8, 0xd6, 0x92, 0x79, 0x87, 0xab, 0xf3, 0xbe, 0x93, 0x1e, 0xe3, 0xc4, 0xc1, 0x96,
+	0x78, 0x1b, 0xf9, 0xd5, 0x2d, 0xfe, 0xbd, 0xda, 0x69, 0x6e, 0xab, 0xc6, 0x8f, 0xb0, 0xb3, 0x04,
+	0x47, 0x7b, 0xb0, 0xc1, 0x23, 0xe2, 0x4c, 0xa9, 0x9b, 0x55, 0xde, 0x4e, 0x96, 0x6f, 0x5d, 0xf4,
+	0x11, 0x3c, 0x5e, 0xa4, 0x23, 0x27, 0xa0, 0xda, 0xbd, 0x85, 0xe8, 0xc6, 0x18, 0xf6, 0x2a, 0x8d,
+	0xcc, 0x9b, 0x8c, 0x86, 0xb0, 0x15, 0x93, 0x53, 0xe2, 0x08, 0xe2, 0x4e, 0xb3, 0xfe, 0x25, 0xe1,
+	0x3b, 0xf6, 0x66, 0xbe, 0x2d, 0xdd, 0xb8, 0x81, 0x61, 0x67, 0xc2, 0xbd, 0x37, 0x31, 0x21, 0xbf,
+	0xd6, 0x91, 0x84, 0x0e, 0x9d, 0x54, 0x03, 0x6e, 0x3a, 0x90, 0xae, 0x5d, 0xac, 0xd1, 0x07, 0xc9,
+	0xa8, 0x30, 0x67, 0x61, 0xa6, 0x88, 0x6c, 0x65, 0x3c, 0x83, 0xfd, 0xa5, 0x14, 0x85, 0x1a, 0x7e,
+	0x80, 0xa7, 0x52, 0x2b, 0xc7, 0xff, 0x03, 0x03, 0xe3, 0x05, 0x3c, 0x5b, 0x11, 0xac, 0xc8, 0xf5,
+	0x47, 0xaa, 0xbc, 0x31, 0xa6, 0xfe, 0x83, 0xd4, 0xff, 0x0a, 0xd4, 0x19, 0xa6, 0x7e, 0xdd, 0x6b,
+	0x4b, 0x82, 0xcb, 0x47, 0x46, 0xad, 0x1e, 0x99, 0x7d, 0xd8, 0xab, 0x30, 0xcb, 0x59, 0x1f, 0xfe,
+	0xd6, 0x82, 0xe6, 0x84, 0x7b, 0xc8, 0x83, 0x27, 0xe5, 0x3b, 0xf7, 0xd3, 0xd5, 0x92, 0xac, 0x1e,
+	0x7f, 0xdd, 0xac, 0x87, 0x2b, 0xb4, 0x13, 0xc0, 0x56, 0xf5, 0x8e, 0x18, 0xad, 0x0d, 0x51, 0x41,
+	0xea, 0x5f, 0xd4, 0x45, 0x16, 0xe9, 0x5c, 0x78, 0x5c, 0xba, 0x0b, 0x3e, 0x59, 0x1b, 0x61, 0x11,
+	0xa6, 0xbf, 0xac, 0x05, 0x2b, 0xb2, 0x9c, 0xc2, 0x66, 0x45, 0xe4, 0xc3, 0xb5, 0x01, 0xca, 0x40,
+	0xdd, 0xaa, 0x09, 0x2c, 0x72, 0x45, 0xb0, 0xbd, 0x24, 0xe8, 0xcf, 0xee, 0xe9, 0x4b, 0x19, 0xaa,
+	0x1f, 0xd4, 0x86, 0x2e, 0xf6, 0xb0, 0xa4, 0xea, 0xf5, 0x3d, 0x5c, 0x84, 0xe9, 0x2f, 0x6b, 0xc1,
+	0xf2, 0x2c, 0xe3, 0xaf, 0xaf, 0x6e, 0xfa, 0xca, 0xf5, 0x4d, 0x5f, 0xf9, 0xe7, 0xa6, 0xaf, 0xfc,
+	0x7e, 0xdb, 0x6f, 0x5c, 0xdf, 0xf6, 0x1b, 0x7f, 0xdd, 0xf6, 0x1b, 0xbf, 0x0c, 0x3d, 0x2a, 0x4e,
+	0xce, 0x67, 0xa6, 0xc3, 0x02, 0xab, 0xf4, 0x80, 0xb8, 0xb8, 0x7b, 0xd7, 0x5c, 0x46, 0x84, 0xcf,
+	0xda, 0xf2, 0x0d, 0xf1, 0xea, 0xbf, 0x01, 0x00, 0x29, 0xff, 0x4a, 0xf7, 0xfc, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -704,6 +812,7 @@ type MsgClient interface {
 	RelayPayment(ctx context.Context, in *MsgRelayPayment, opts ...grpc.CallOption) (*MsgRelayPaymentResponse, error)
 	FreezeProvider(ctx context.Context, in *MsgFreezeProvider, opts ...grpc.CallOption) (*MsgFreezeProviderResponse, error)
 	UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvider, opts ...grpc.CallOption) (*MsgUnfreezeProviderResponse, error)
+	BailProvider(ctx context.Context, in *MsgBailProvider, opts ...grpc.CallOption) (*MsgBailProviderResponse, error)
 }
 
 type msgClient struct {
@@ -759,6 +868,15 @@ func (c *msgClient) UnfreezeProvider(ctx context.Context, in *MsgUnfreezeProvide
 	return out, nil
 }
 
+func (c *msgClient) BailProvider(ctx context.Context, in *MsgBailProvider, opts ...grpc.CallOption) (*MsgBailProviderResponse, error) {
+	out := new(MsgBailProviderResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.pairing.Msg/BailProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	StakeProvider(context.Context, *MsgStakeProvider) (*MsgStakeProviderResponse, error)
@@ -766,6 +884,7 @@ type MsgServer interface {
 	RelayPayment(context.Context, *MsgRelayPayment) (*MsgRelayPaymentResponse, error)
 	FreezeProvider(context.Context, *MsgFreezeProvider) (*MsgFreezeProviderResponse, error)
 	UnfreezeProvider(context.Context, *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error)
+	BailProvider(context.Context, *MsgBailProvider) (*MsgBailProviderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -787,6 +906,9 @@ func (*UnimplementedMsgServer) FreezeProvider(ctx context.Context, req *MsgFreez
 func (*UnimplementedMsgServer) UnfreezeProvider(ctx context.Context, req *MsgUnfreezeProvider) (*MsgUnfreezeProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeProvider not implemented")
 }
+func (*UnimplementedMsgServer) BailProvider(ctx context.Context, req *MsgBailProvider) (*MsgBailProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BailProvider not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -882,6 +1004,24 @@ func _Msg_UnfreezeProvider_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BailProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBailProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BailProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.pairing.Msg/BailProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BailProvider(ctx, req.(*MsgBailProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.pairing.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -906,6 +1046,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnfreezeProvider",
 			Handler:    _Msg_UnfreezeProvider_Handler,
 		},
+		{
+			MethodName: "BailProvider",
+			Handler:    _Msg_BailProvider_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/pairing/tx.proto",
@@ -1360,6 +1504,83 @@ func (m *MsgUnfreezeProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBailProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBailProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBailProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Bail.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBailProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBailProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBailProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1568,6 +1789,38 @@ func (m *MsgUnfreezeProviderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBailProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bail.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBailProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2849,6 +3102,235 @@ func (m *MsgUnfreezeProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBailProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBailProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBailProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBailProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBailProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBailProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
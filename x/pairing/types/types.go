@@ -11,6 +11,9 @@ const (
 	LatestBlocksReportEventName = "provider_latest_block_report"
 	RejectedCuEventName         = "rejected_cu"
 	UnstakeProposalEventName    = "unstake_gov_proposal"
+	JailEntryEventName          = "provider_jail"
+	BailEntryEventName          = "provider_bail"
+	SlashEntryEventName         = "provider_slash"
 )
 
 // unstake description strings
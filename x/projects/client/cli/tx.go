@@ -32,6 +32,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDelKeys())
 	cmd.AddCommand(CmdSetPolicy())
 	cmd.AddCommand(CmdSetSubscriptionPolicy())
+	cmd.AddCommand(CmdSetProjectEnabled())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
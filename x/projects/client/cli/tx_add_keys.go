@@ -15,11 +15,14 @@ func CmdAddKeys() *cobra.Command {
 		Short: "Add developer/admin keys to an existing project",
 		Long: `The add-keys command allows the project admin to add new project keys (admin/developer) to the project.
 		To add the keys you can optionally provide a YAML file of the new project keys (see example in cookbook/project/example_project_keys.yml).
-		Another way to add keys is with the --admin-key and --developer-key flags.`,
+		Another way to add keys is with the --admin-key and --developer-key flags.
+		Developer keys can be limited with an expiry block, after which they can't be used to send relays
+		(use the "expiry" field in the YAML file, or the --expiry flag for the --developer-key keys).`,
 		Example: `required flags: --from <admin-key> (the project's subscription address is also considered admin)
 				  
 		lavad tx project add-keys [project-id] [project-keys-file-path] --from <admin-key>
-		lavad tx project add-keys [project-id] --admin-key <other-admin-key> --admin-key <another-admin-key> --developer-key <developer-key> --from <admin-key>`,
+		lavad tx project add-keys [project-id] --admin-key <other-admin-key> --admin-key <another-admin-key> --developer-key <developer-key> --from <admin-key>
+		lavad tx project add-keys [project-id] --developer-key <developer-key> --expiry <block> --from <admin-key>`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			projectID := args[0]
@@ -36,9 +39,15 @@ func CmdAddKeys() *cobra.Command {
 				if err != nil {
 					return err
 				}
+				expiry, err := cmd.Flags().GetUint64("expiry")
+				if err != nil {
+					return err
+				}
 				var developerKeys []types.ProjectKey
 				for _, developerFlagValue := range developerFlagsValue {
-					developerKeys = append(developerKeys, types.ProjectDeveloperKey(developerFlagValue))
+					developerKey := types.ProjectDeveloperKey(developerFlagValue)
+					developerKey.Expiry = expiry
+					developerKeys = append(developerKeys, developerKey)
 				}
 
 				adminAddresses, err := cmd.Flags().GetStringSlice("admin-key")
@@ -74,6 +83,7 @@ func CmdAddKeys() *cobra.Command {
 
 	cmd.Flags().StringSlice("developer-key", []string{}, "Developer keys to add")
 	cmd.Flags().StringSlice("admin-key", []string{}, "Admin keys to add")
+	cmd.Flags().Uint64("expiry", 0, "Block after which the developer keys expire (0 for no expiry)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -109,6 +110,13 @@ func (k Keeper) CreateProject(ctx sdk.Context, subAddr string, projectData types
 	project.SubscriptionPolicy = project.AdminPolicy
 
 	for _, projectKey := range projectData.GetProjectKeys() {
+		if !projectKey.IsExpiryValid(ctxBlock) {
+			return utils.LavaFormatWarning("create project failed", fmt.Errorf("invalid key expiry"),
+				utils.Attribute{Key: "key", Value: projectKey.Key},
+				utils.Attribute{Key: "expiry", Value: projectKey.Expiry},
+				utils.Attribute{Key: "block", Value: ctxBlock},
+			)
+		}
 		err = k.registerKey(ctx, projectKey, &project, epoch)
 		if err != nil {
 			return err
@@ -160,6 +168,88 @@ func (k Keeper) DeleteProject(ctx sdk.Context, creator, projectID string) error
 	return k.projectsFS.DelEntry(ctx, project.Index, nextEpoch)
 }
 
+// TransferProjects moves all the projects of a subscription to a new subscription
+// address, replacing the old address in the projects keys with the new one
+// (takes effect at the beginning of next epoch)
+func (k Keeper) TransferProjects(ctx sdk.Context, fromSub, toSub string) error {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: TransferProjects failed to get next epoch", err,
+			utils.Attribute{Key: "subscription", Value: fromSub},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	for _, projectID := range k.projectsFS.GetAllEntryIndicesWithPrefix(ctx, fromSub) {
+		var project types.Project
+		if found := k.projectsFS.FindEntry(ctx, projectID, nextEpoch, &project); !found {
+			// already pending deletion
+			continue
+		}
+
+		name := strings.TrimPrefix(project.Index, types.ProjectIndex(fromSub, ""))
+		newProject, err := types.NewProject(toSub, name, project.Enabled)
+		if err != nil {
+			return utils.LavaFormatWarning("transfer project failed", err,
+				utils.Attribute{Key: "projectID", Value: projectID},
+				utils.Attribute{Key: "subscription", Value: toSub},
+			)
+		}
+		newProject.AdminPolicy = project.AdminPolicy
+		newProject.SubscriptionPolicy = project.SubscriptionPolicy
+		newProject.UsedCu = project.UsedCu
+		newProject.Snapshot = project.Snapshot
+
+		// keys of other addresses keep their developer key entry (pointed to the new project),
+		// while the old subscription address is unregistered and replaced by the new one
+		var subKeys []types.ProjectKey
+		for _, projectKey := range project.GetProjectKeys() {
+			if projectKey.Key == fromSub {
+				subKeys = append(subKeys, projectKey)
+				continue
+			}
+
+			if projectKey.IsType(types.ProjectKey_DEVELOPER) {
+				devkeyData := types.ProtoDeveloperData{ProjectID: newProject.Index}
+				err = k.developerKeysFS.AppendEntry(ctx, projectKey.Key, nextEpoch, &devkeyData)
+				if err != nil {
+					return utils.LavaFormatWarning("transfer project failed", err,
+						utils.Attribute{Key: "projectID", Value: projectID},
+						utils.Attribute{Key: "key", Value: projectKey.Key},
+					)
+				}
+			}
+			newProject.AppendKey(projectKey)
+		}
+
+		for _, projectKey := range subKeys {
+			err = k.unregisterKey(ctx, projectKey, &project, nextEpoch)
+			if err != nil {
+				return err
+			}
+			projectKey.Key = toSub
+			err = k.registerKey(ctx, projectKey, &newProject, nextEpoch)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = k.projectsFS.DelEntry(ctx, project.Index, nextEpoch)
+		if err != nil {
+			return err
+		}
+
+		err = k.projectsFS.AppendEntry(ctx, newProject.Index, nextEpoch, &newProject)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // registerKey adds a key to a project. For developer keys it also updates the
 // developer key registry (that maps them to projects). The block argument is
 // expected to be current block height (takes effect immediately).
@@ -186,7 +276,9 @@ func (k Keeper) registerKey(ctx sdk.Context, key types.ProjectKey, project *type
 			)
 		}
 
-		project.AppendKey(types.ProjectDeveloperKey(key.Key))
+		devKey := types.ProjectDeveloperKey(key.Key)
+		devKey.Expiry = key.Expiry
+		project.AppendKey(devKey)
 
 		// by now, the key was either not found, or found and belongs to us already.
 		// if the former, then we surely need to add it.
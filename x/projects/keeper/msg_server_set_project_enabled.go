@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/projects/types"
+)
+
+func (k msgServer) SetProjectEnabled(goCtx context.Context, msg *types.MsgSetProjectEnabled) (*types.MsgSetProjectEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, utils.LavaFormatWarning("invalid address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	err := k.SetProjectEnabledFlag(ctx, msg.Project, msg.Creator, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetProjectEnabledResponse{}, nil
+}
@@ -122,6 +122,17 @@ func (k Keeper) AddKeysToProject(ctx sdk.Context, projectID, adminKey string, pr
 		)
 	}
 
+	for _, projectKey := range projectKeys {
+		if !projectKey.IsExpiryValid(ctxBlock) {
+			return utils.LavaFormatWarning("failed to add keys", fmt.Errorf("invalid key expiry"),
+				utils.Attribute{Key: "project", Value: projectID},
+				utils.Attribute{Key: "key", Value: projectKey.Key},
+				utils.Attribute{Key: "expiry", Value: projectKey.Expiry},
+				utils.Attribute{Key: "block", Value: ctxBlock},
+			)
+		}
+	}
+
 	for _, projectKey := range projectKeys {
 		err := k.registerKey(ctx, projectKey, &project, epoch)
 		if err != nil {
@@ -356,6 +367,60 @@ func (k Keeper) SetProjectPolicy(ctx sdk.Context, projectIDs []string, policy *p
 	return nil
 }
 
+// SetProjectEnabledFlag enables or disables a project. The change will take effect in the
+// beginning of the next epoch. The adminKey must be valid (and specifically, not already
+// marked for deletion by next epoch).
+func (k Keeper) SetProjectEnabledFlag(ctx sdk.Context, projectID, adminKey string, enabled bool) error {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return utils.LavaFormatError("critical: SetProjectEnabledFlag failed to get NextEpoch", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	projectNextEpoch, _, err := k.getProjectForBlock(ctx, projectID, nextEpoch)
+	if err != nil {
+		return utils.LavaFormatWarning("failed to set project enabled (peek)", err,
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	// all checks for admin key are done respective of next epoch (because any
+	// deletion earlier in this epoch thereof should be effecitive immediately
+	// but would be marked there).
+
+	if !projectNextEpoch.IsAdminKey(adminKey) {
+		return utils.LavaFormatWarning("failed to set project enabled",
+			fmt.Errorf("requesting key must be admin key"),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "key", Value: adminKey},
+		)
+	}
+
+	projectNextEpoch.Enabled = enabled
+
+	err = k.projectsFS.AppendEntry(ctx, projectID, nextEpoch, &projectNextEpoch)
+	if err != nil {
+		return utils.LavaFormatError("critical: failed to set project enabled",
+			fmt.Errorf("append entry: %w", err),
+			utils.Attribute{Key: "project", Value: projectID},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	details := map[string]string{
+		"creator": adminKey,
+		"project": projectID,
+		"enabled": strconv.FormatBool(enabled),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.SetProjectEnabledEventName, details, "set project enabled successfully")
+	return nil
+}
+
 // GetAllProjectsForSubscription returns a list of all projectID for a subscription
 func (k Keeper) GetAllProjectsForSubscription(ctx sdk.Context, subscription string) []string {
 	return k.projectsFS.GetAllEntryIndicesWithPrefix(ctx, subscription)
@@ -1310,3 +1310,111 @@ func TestMaxKeysInProject(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestSetProjectEnabled(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 1) // 1 sub, 0 adm, 1 dev
+	ts.setupProjectData()
+
+	projectData := ts.ProjectData("pd1")
+	plan := ts.Plan("free")
+
+	_, sub1Addr := ts.Account("sub1")
+	_, adm1Addr := ts.Account("pd_adm_1")
+	_, dev1Addr := ts.Account("dev1")
+
+	err := ts.Keepers.Projects.CreateProject(ts.Ctx, sub1Addr, projectData, plan)
+	require.NoError(t, err)
+	ts.AdvanceBlock()
+
+	projectID := types.ProjectIndex(sub1Addr, projectData.Name)
+	err = ts.TxProjectAddKeys(projectID, adm1Addr, types.ProjectDeveloperKey(dev1Addr))
+	require.NoError(t, err)
+
+	// developer key is not an admin (should fail)
+	err = ts.TxProjectSetEnabled(projectID, dev1Addr, false)
+	require.Error(t, err)
+
+	// unknown project (should fail)
+	err = ts.TxProjectSetEnabled(projectID+"x", adm1Addr, false)
+	require.Error(t, err)
+
+	err = ts.TxProjectSetEnabled(projectID, adm1Addr, false)
+	require.NoError(t, err)
+
+	// change takes effect in next epoch
+	res, err := ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.True(t, res.Project.Enabled)
+	require.False(t, res.PendingProject.Enabled)
+
+	ts.AdvanceEpoch()
+	res, err = ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.False(t, res.Project.Enabled)
+
+	// subscription owner (admin) enables the project back
+	err = ts.TxProjectSetEnabled(projectID, sub1Addr, true)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+	res, err = ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.True(t, res.Project.Enabled)
+}
+
+func TestDeveloperKeyExpiry(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 2) // 1 sub, 0 adm, 2 dev
+	ts.setupProjectData()
+
+	projectData := ts.ProjectData("pd1")
+	plan := ts.Plan("free")
+
+	_, sub1Addr := ts.Account("sub1")
+	_, adm1Addr := ts.Account("pd_adm_1")
+	_, dev1Addr := ts.Account("dev1")
+	_, dev2Addr := ts.Account("dev2")
+
+	err := ts.Keepers.Projects.CreateProject(ts.Ctx, sub1Addr, projectData, plan)
+	require.NoError(t, err)
+	ts.AdvanceBlock()
+
+	projectID := types.ProjectIndex(sub1Addr, projectData.Name)
+	expiry := ts.BlockHeight() + 100
+
+	// expiry in the past (should fail)
+	pk := types.ProjectDeveloperKey(dev1Addr)
+	pk.Expiry = ts.BlockHeight() - 1
+	err = ts.TxProjectAddKeys(projectID, adm1Addr, pk)
+	require.Error(t, err)
+
+	// expiry for admin key (should fail)
+	pk = types.ProjectAdminKey(dev1Addr)
+	pk.Expiry = expiry
+	err = ts.TxProjectAddKeys(projectID, adm1Addr, pk)
+	require.Error(t, err)
+
+	pk = types.ProjectDeveloperKey(dev1Addr)
+	pk.Expiry = expiry
+	err = ts.TxProjectAddKeys(projectID, adm1Addr, pk, types.ProjectDeveloperKey(dev2Addr))
+	require.NoError(t, err)
+
+	res, err := ts.QueryProjectInfo(projectID)
+	require.NoError(t, err)
+	require.Equal(t, expiry, res.Project.GetKey(dev1Addr).Expiry)
+	require.Equal(t, uint64(0), res.Project.GetKey(dev2Addr).Expiry)
+
+	key := res.Project.GetKey(dev1Addr)
+	require.False(t, key.IsExpired(expiry))
+	require.True(t, key.IsExpired(expiry+1))
+
+	// re-adding the key updates its expiry
+	pk.Expiry = expiry + 100
+	err = ts.TxProjectAddKeys(projectID, adm1Addr, pk)
+	require.NoError(t, err)
+
+	res, err = ts.QueryProjectInfo(projectID)
+	require.NoError(t, err)
+	require.Equal(t, expiry+100, res.Project.GetKey(dev1Addr).Expiry)
+}
@@ -41,6 +41,10 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgSetSubscriptionPolicy int = 100
 
+	opWeightMsgSetProjectEnabled = "op_weight_msg_set_project_enabled"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetProjectEnabled int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -113,6 +117,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		projectssimulation.SimulateMsgSetSubscriptionPolicy(am.keeper),
 	))
 
+	var weightMsgSetProjectEnabled int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetProjectEnabled, &weightMsgSetProjectEnabled, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetProjectEnabled = defaultWeightMsgSetProjectEnabled
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetProjectEnabled,
+		projectssimulation.SimulateMsgSetProjectEnabled(am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -13,6 +13,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDelKeys{}, "projects/DelKeys", nil)
 	cdc.RegisterConcrete(&MsgSetPolicy{}, "projects/SetPolicy", nil)
 	cdc.RegisterConcrete(&MsgSetSubscriptionPolicy{}, "projects/SetSubscriptionPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetProjectEnabled{}, "projects/SetProjectEnabled", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -29,6 +30,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetSubscriptionPolicy{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetProjectEnabled{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetProjectEnabled = "set_project_enabled"
+
+var _ sdk.Msg = &MsgSetProjectEnabled{}
+
+func NewMsgSetProjectEnabled(creator, project string, enabled bool) *MsgSetProjectEnabled {
+	return &MsgSetProjectEnabled{
+		Creator: creator,
+		Project: project,
+		Enabled: enabled,
+	}
+}
+
+func (msg *MsgSetProjectEnabled) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProjectEnabled) Type() string {
+	return TypeMsgSetProjectEnabled
+}
+
+func (msg *MsgSetProjectEnabled) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetProjectEnabled) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProjectEnabled) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Project == "" {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidRequest, "project id is empty")
+	}
+
+	return nil
+}
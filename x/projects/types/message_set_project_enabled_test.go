@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetProjectEnabled_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetProjectEnabled
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetProjectEnabled{
+				Creator: "invalid_address",
+				Project: "project",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "empty project",
+			msg: MsgSetProjectEnabled{
+				Creator: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgSetProjectEnabled{
+				Creator: sample.AccAddress(),
+				Project: "project",
+				Enabled: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
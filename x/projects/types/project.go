@@ -65,6 +65,20 @@ func (projectKey ProjectKey) IsType(kind ProjectKey_Type) bool {
 	return projectKey.Kinds&uint32(kind) != 0x0
 }
 
+// IsExpired checks if the (developer) key expired by a given block
+func (projectKey ProjectKey) IsExpired(block uint64) bool {
+	return projectKey.Expiry != 0 && block > projectKey.Expiry
+}
+
+// IsExpiryValid checks that an expiry is only set for developer keys, and that
+// it did not pass by a given block
+func (projectKey ProjectKey) IsExpiryValid(block uint64) bool {
+	if projectKey.Expiry == 0 {
+		return true
+	}
+	return projectKey.IsType(ProjectKey_DEVELOPER) && !projectKey.IsExpired(block)
+}
+
 func (projectKey ProjectKey) IsTypeValid() bool {
 	const keyKindsAll = (uint32(ProjectKey_ADMIN) | uint32(ProjectKey_DEVELOPER))
 
@@ -85,6 +99,9 @@ func (project *Project) AppendKey(key ProjectKey) bool {
 	for i, projectKey := range project.ProjectKeys {
 		if projectKey.Key == key.Key {
 			project.ProjectKeys[i].Kinds |= key.Kinds
+			if key.IsType(ProjectKey_DEVELOPER) {
+				project.ProjectKeys[i].Expiry = key.Expiry
+			}
 			return true
 		}
 	}
@@ -97,6 +114,9 @@ func (project *Project) DeleteKey(key ProjectKey) bool {
 	for i, projectKey := range project.ProjectKeys {
 		if projectKey.Key == key.Key {
 			project.ProjectKeys[i].Kinds &= ^key.Kinds
+			if !project.ProjectKeys[i].IsType(ProjectKey_DEVELOPER) {
+				project.ProjectKeys[i].Expiry = 0
+			}
 			if project.ProjectKeys[i].Kinds == uint32(ProjectKey_NONE) {
 				if i < length-1 {
 					project.ProjectKeys[i] = project.ProjectKeys[length-1]
@@ -153,8 +153,9 @@ func (m *Project) GetSnapshot() uint64 {
 }
 
 type ProjectKey struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
-	Kinds uint32 `protobuf:"varint,4,opt,name=kinds,proto3" json:"kinds"`
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Kinds  uint32 `protobuf:"varint,4,opt,name=kinds,proto3" json:"kinds"`
+	Expiry uint64 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry"`
 }
 
 func (m *ProjectKey) Reset()         { *m = ProjectKey{} }
@@ -204,6 +205,13 @@ func (m *ProjectKey) GetKinds() uint32 {
 	return 0
 }
 
+func (m *ProjectKey) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
 type ProtoDeveloperData struct {
 	ProjectID string `protobuf:"bytes,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
 }
@@ -330,41 +338,42 @@ func init() {
 }
 
 var fileDescriptor_9027839604ae2915 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x8f, 0xd2, 0x40,
-	0x14, 0x67, 0x68, 0x81, 0xf6, 0xc1, 0x26, 0xcd, 0x88, 0xd9, 0x4a, 0x4c, 0x8b, 0x78, 0x69, 0x3c,
-	0x94, 0x04, 0x2f, 0x5e, 0x45, 0x30, 0x61, 0x55, 0x20, 0x8d, 0xf1, 0xb0, 0x17, 0x52, 0xe8, 0x84,
-	0xad, 0x40, 0xdb, 0x30, 0x65, 0xb3, 0xbd, 0xf9, 0x11, 0xfc, 0x18, 0x26, 0x7e, 0x09, 0x8f, 0x7b,
-	0xdc, 0xa3, 0x27, 0x62, 0xe0, 0xc6, 0xa7, 0x30, 0xd3, 0x19, 0x96, 0xd6, 0x6c, 0xb2, 0x5e, 0x3a,
-	0xf3, 0xde, 0xfc, 0xde, 0xbf, 0xfe, 0x7e, 0x0f, 0x5e, 0x2e, 0xdd, 0x6b, 0x37, 0x20, 0x71, 0x9b,
-	0x9d, 0xed, 0x68, 0x1d, 0x7e, 0x25, 0xb3, 0x98, 0x1e, 0x2f, 0x76, 0xb4, 0x0e, 0xe3, 0x10, 0x3f,
-	0x15, 0x20, 0x9b, 0x9d, 0xf6, 0x11, 0xd4, 0xa8, 0xcf, 0xc3, 0x79, 0x98, 0x22, 0xda, 0xec, 0xc6,
-	0xc1, 0x0d, 0x33, 0x9f, 0x71, 0xe9, 0x06, 0xb4, 0x1d, 0x85, 0x4b, 0x7f, 0x96, 0x70, 0x40, 0xeb,
-	0xa7, 0x04, 0x95, 0x31, 0xcf, 0x81, 0xeb, 0x50, 0xf2, 0x03, 0x8f, 0xdc, 0xe8, 0xa8, 0x89, 0x2c,
-	0xd5, 0xe1, 0x06, 0x6e, 0x41, 0x8d, 0x6e, 0xa6, 0x74, 0xb6, 0xf6, 0xa3, 0xd8, 0x0f, 0x03, 0xbd,
-	0x98, 0x3e, 0xe6, 0x7c, 0x58, 0x87, 0x0a, 0x09, 0xdc, 0xe9, 0x92, 0x78, 0xba, 0xdc, 0x44, 0x96,
-	0xe2, 0x1c, 0x4d, 0x7c, 0x09, 0x35, 0xd1, 0xe2, 0x64, 0x41, 0x12, 0xaa, 0x97, 0x9a, 0x92, 0x55,
-	0xed, 0xbc, 0xb0, 0x1f, 0x1c, 0xc2, 0x16, 0x9d, 0x7c, 0x20, 0x49, 0xb7, 0x7e, 0xbb, 0x35, 0x0b,
-	0x87, 0xad, 0x99, 0x0b, 0x77, 0xaa, 0xd1, 0x3d, 0x82, 0xe2, 0x11, 0xd4, 0x5c, 0x6f, 0xe5, 0x07,
-	0x13, 0x3e, 0x91, 0x5e, 0x6e, 0x22, 0xab, 0xda, 0x69, 0xfc, 0x93, 0x9b, 0xcd, 0x6c, 0x8f, 0x53,
-	0x44, 0x57, 0x63, 0x09, 0xb3, 0x31, 0x4e, 0x35, 0xb5, 0xf8, 0x33, 0x3e, 0x87, 0xca, 0x86, 0x12,
-	0x6f, 0x32, 0xdb, 0xe8, 0x95, 0x26, 0xb2, 0x64, 0xa7, 0xcc, 0xcc, 0x77, 0x1b, 0xec, 0xc1, 0x93,
-	0xec, 0xbc, 0xc7, 0x82, 0xca, 0xa3, 0x05, 0xcf, 0x0f, 0x5b, 0xf3, 0xa1, 0x50, 0x07, 0x67, 0x9d,
-	0xa2, 0x7c, 0x03, 0x14, 0x1a, 0xb8, 0x11, 0xbd, 0x0a, 0x63, 0x5d, 0x4d, 0xeb, 0xdf, 0xdb, 0x17,
-	0xb2, 0x22, 0x69, 0x72, 0xeb, 0x1b, 0x02, 0x38, 0xfd, 0x23, 0xfc, 0x0c, 0xa4, 0x05, 0x49, 0x38,
-	0x5d, 0xdd, 0xca, 0x61, 0x6b, 0x32, 0xd3, 0x61, 0x1f, 0x6c, 0x42, 0x69, 0xe1, 0x07, 0x1e, 0x4d,
-	0xf9, 0x38, 0xeb, 0xaa, 0x87, 0xad, 0xc9, 0x1d, 0x0e, 0x3f, 0x5a, 0xaf, 0x40, 0xfe, 0x9c, 0x44,
-	0x04, 0x2b, 0x20, 0x0f, 0x47, 0xc3, 0xbe, 0x56, 0xc0, 0x2a, 0x94, 0xde, 0xf6, 0x3e, 0x0d, 0x86,
-	0x1a, 0xc2, 0x67, 0xa0, 0xf6, 0xfa, 0x5f, 0xfa, 0x1f, 0x47, 0xe3, 0xbe, 0xa3, 0x15, 0x2f, 0x64,
-	0xa5, 0xa8, 0x49, 0xa2, 0x85, 0x37, 0x80, 0xc7, 0x4c, 0x39, 0x3d, 0x72, 0x4d, 0x96, 0x61, 0x44,
-	0xd6, 0x3d, 0x37, 0x76, 0xf1, 0x73, 0x50, 0x05, 0x33, 0x83, 0x9e, 0x90, 0xcf, 0xc9, 0xc1, 0xe3,
-	0x5b, 0xbf, 0x10, 0x54, 0x45, 0xf3, 0x69, 0x0c, 0x06, 0x39, 0x70, 0x57, 0x44, 0xc0, 0xd3, 0x7b,
-	0x56, 0x48, 0x52, 0x5e, 0x48, 0x03, 0xc8, 0x72, 0xaf, 0xcb, 0xff, 0xab, 0x23, 0x99, 0xe9, 0x28,
-	0xaf, 0x9b, 0x0e, 0x94, 0x05, 0x81, 0xa5, 0xc7, 0x08, 0x74, 0x04, 0x92, 0x8f, 0xd0, 0x7d, 0xff,
-	0x63, 0x67, 0xa0, 0xdb, 0x9d, 0x81, 0xee, 0x76, 0x06, 0xfa, 0xb3, 0x33, 0xd0, 0xf7, 0xbd, 0x51,
-	0xb8, 0xdb, 0x1b, 0x85, 0xdf, 0x7b, 0xa3, 0x70, 0x69, 0xcd, 0xfd, 0xf8, 0x6a, 0x33, 0xb5, 0x67,
-	0xe1, 0xaa, 0x9d, 0xdb, 0xbb, 0x9b, 0xd3, 0x2e, 0xc7, 0x49, 0x44, 0xe8, 0xb4, 0x9c, 0x2e, 0xdf,
-	0xeb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xee, 0xd2, 0x34, 0xbb, 0xf1, 0x03, 0x00, 0x00,
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0x65, 0x63, 0x1b, 0xec, 0x21, 0x91, 0xac, 0x6d, 0xaa, 0xb8, 0xa8, 0xb2, 0xa9, 0x7b, 0xb1,
+	0x7a, 0x30, 0x12, 0xbd, 0xf4, 0x5a, 0x17, 0x2a, 0x91, 0xb6, 0x80, 0xac, 0xaa, 0x87, 0x5c, 0x90,
+	0xc1, 0x2b, 0xe2, 0x02, 0xb6, 0x85, 0x4d, 0x84, 0xff, 0xa2, 0x1f, 0xd1, 0x43, 0xa5, 0xfe, 0x44,
+	0x8f, 0x39, 0xe6, 0xd8, 0x93, 0x55, 0xc1, 0x8d, 0xaf, 0xa8, 0xd6, 0xbb, 0x04, 0x5c, 0x45, 0x4a,
+	0x2f, 0x9e, 0x9d, 0xd9, 0x37, 0x33, 0xfb, 0x3c, 0x6f, 0xe0, 0xe5, 0xdc, 0xbb, 0xf1, 0x42, 0x92,
+	0xb6, 0xa8, 0x6d, 0xc5, 0xcb, 0xe8, 0x2b, 0x99, 0xa4, 0xc9, 0xfe, 0x60, 0xc7, 0xcb, 0x28, 0x8d,
+	0xf0, 0x53, 0x0e, 0xb2, 0xa9, 0xb5, 0xf7, 0xa0, 0xc6, 0xf9, 0x34, 0x9a, 0x46, 0x05, 0xa2, 0x45,
+	0x4f, 0x0c, 0xdc, 0x30, 0xca, 0x15, 0xe7, 0x5e, 0x98, 0xb4, 0xe2, 0x68, 0x1e, 0x4c, 0x32, 0x06,
+	0x30, 0x7f, 0x0a, 0x50, 0x1b, 0xb2, 0x1a, 0xf8, 0x1c, 0xa4, 0x20, 0xf4, 0xc9, 0x5a, 0x43, 0x4d,
+	0x64, 0x29, 0x2e, 0x73, 0xb0, 0x09, 0xa7, 0xc9, 0x6a, 0x9c, 0x4c, 0x96, 0x41, 0x9c, 0x06, 0x51,
+	0xa8, 0x9d, 0x14, 0x97, 0xa5, 0x18, 0xd6, 0xa0, 0x46, 0x42, 0x6f, 0x3c, 0x27, 0xbe, 0x26, 0x36,
+	0x91, 0x25, 0xbb, 0x7b, 0x17, 0x5f, 0xc1, 0x29, 0x7f, 0xe2, 0x68, 0x46, 0xb2, 0x44, 0x93, 0x9a,
+	0x82, 0x55, 0x6f, 0xbf, 0xb0, 0x1f, 0x24, 0x61, 0xf3, 0x97, 0x7c, 0x20, 0x99, 0x73, 0x7e, 0x9b,
+	0x1b, 0x95, 0x5d, 0x6e, 0x94, 0xd2, 0xdd, 0x7a, 0x7c, 0x8f, 0x48, 0xf0, 0x00, 0x4e, 0x3d, 0x7f,
+	0x11, 0x84, 0x23, 0xc6, 0x48, 0xab, 0x36, 0x91, 0x55, 0x6f, 0x37, 0xfe, 0xa9, 0x4d, 0x39, 0xdb,
+	0xc3, 0x02, 0xe1, 0xa8, 0xb4, 0xe0, 0x71, 0x8e, 0x5b, 0x2f, 0x3c, 0x76, 0x8d, 0x2f, 0xa0, 0xb6,
+	0x4a, 0x88, 0x3f, 0x9a, 0xac, 0xb4, 0x5a, 0x13, 0x59, 0xa2, 0x5b, 0xa5, 0xee, 0xbb, 0x15, 0xf6,
+	0xe1, 0xc9, 0x31, 0xdf, 0x7d, 0x43, 0xf9, 0xd1, 0x86, 0x17, 0xbb, 0xdc, 0x78, 0x28, 0xd5, 0xc5,
+	0xc7, 0x41, 0xde, 0xbe, 0x01, 0x72, 0x12, 0x7a, 0x71, 0x72, 0x1d, 0xa5, 0x9a, 0x52, 0xf4, 0xbf,
+	0xf7, 0x2f, 0x45, 0x59, 0x50, 0x45, 0xf3, 0x3b, 0x02, 0x38, 0xfc, 0x23, 0xfc, 0x0c, 0x84, 0x19,
+	0xc9, 0xd8, 0xb8, 0x9c, 0xda, 0x2e, 0x37, 0xa8, 0xeb, 0xd2, 0x0f, 0x36, 0x40, 0x9a, 0x05, 0xa1,
+	0x9f, 0x14, 0xf3, 0x38, 0x73, 0x94, 0x5d, 0x6e, 0xb0, 0x80, 0xcb, 0x0c, 0x36, 0xa1, 0x4a, 0xd6,
+	0x71, 0xb0, 0xcc, 0x34, 0x89, 0xb6, 0x72, 0x60, 0x97, 0x1b, 0x3c, 0xe2, 0x72, 0x6b, 0xbe, 0x02,
+	0xf1, 0x73, 0x16, 0x13, 0x2c, 0x83, 0xd8, 0x1f, 0xf4, 0xbb, 0x6a, 0x05, 0x2b, 0x20, 0xbd, 0xed,
+	0x7c, 0xea, 0xf5, 0x55, 0x84, 0xcf, 0x40, 0xe9, 0x74, 0xbf, 0x74, 0x3f, 0x0e, 0x86, 0x5d, 0x57,
+	0x3d, 0xb9, 0x14, 0xe5, 0x13, 0x55, 0xe0, 0xcf, 0x7c, 0x03, 0x78, 0x48, 0xd5, 0xd5, 0x21, 0x37,
+	0x64, 0x1e, 0xc5, 0x64, 0xd9, 0xf1, 0x52, 0x0f, 0x3f, 0x07, 0x85, 0x4f, 0xaf, 0xd7, 0xe1, 0x12,
+	0x3b, 0x04, 0x58, 0xbe, 0xf9, 0x0b, 0x41, 0x9d, 0x13, 0x2c, 0x72, 0x30, 0x88, 0xa1, 0xb7, 0x20,
+	0x1c, 0x5e, 0x9c, 0x8f, 0xc5, 0x26, 0x94, 0xc5, 0xd6, 0x83, 0x63, 0x7d, 0x68, 0xe2, 0xff, 0x6a,
+	0x4d, 0xa4, 0x5a, 0x2b, 0x6b, 0xab, 0x0d, 0x55, 0x3e, 0x64, 0xe9, 0xb1, 0x21, 0xbb, 0x1c, 0xc9,
+	0x28, 0x38, 0xef, 0x7f, 0x6c, 0x74, 0x74, 0xbb, 0xd1, 0xd1, 0xdd, 0x46, 0x47, 0x7f, 0x36, 0x3a,
+	0xfa, 0xb6, 0xd5, 0x2b, 0x77, 0x5b, 0xbd, 0xf2, 0x7b, 0xab, 0x57, 0xae, 0xac, 0x69, 0x90, 0x5e,
+	0xaf, 0xc6, 0xf6, 0x24, 0x5a, 0xb4, 0x4a, 0xbb, 0xb9, 0x3e, 0xec, 0x7b, 0x9a, 0xc5, 0x24, 0x19,
+	0x57, 0x8b, 0x05, 0x7d, 0xfd, 0x77, 0x00, 0xc0, 0xb5, 0x19, 0x08, 0x15, 0x04, 0x00, 0x00,
 }
 
 func (this *Project) Equal(that interface{}) bool {
@@ -442,6 +451,9 @@ func (this *ProjectKey) Equal(that interface{}) bool {
 	if this.Kinds != that1.Kinds {
 		return false
 	}
+	if this.Expiry != that1.Expiry {
+		return false
+	}
 	return true
 }
 func (this *ProtoDeveloperData) Equal(that interface{}) bool {
@@ -621,6 +633,11 @@ func (m *ProjectKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiry != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Kinds != 0 {
 		i = encodeVarintProject(dAtA, i, uint64(m.Kinds))
 		i--
@@ -796,6 +813,9 @@ func (m *ProjectKey) Size() (n int) {
 	if m.Kinds != 0 {
 		n += 1 + sovProject(uint64(m.Kinds))
 	}
+	if m.Expiry != 0 {
+		n += 1 + sovProject(uint64(m.Expiry))
+	}
 	return n
 }
 
@@ -1202,6 +1222,25 @@ func (m *ProjectKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
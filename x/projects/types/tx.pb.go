@@ -413,6 +413,102 @@ func (m *MsgSetSubscriptionPolicyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSubscriptionPolicyResponse proto.InternalMessageInfo
 
+type MsgSetProjectEnabled struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetProjectEnabled) Reset()         { *m = MsgSetProjectEnabled{} }
+func (m *MsgSetProjectEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectEnabled) ProtoMessage()    {}
+func (*MsgSetProjectEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f8e20515314f9d, []int{8}
+}
+func (m *MsgSetProjectEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectEnabled.Merge(m, src)
+}
+func (m *MsgSetProjectEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectEnabled proto.InternalMessageInfo
+
+func (m *MsgSetProjectEnabled) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetProjectEnabled) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *MsgSetProjectEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetProjectEnabledResponse struct {
+}
+
+func (m *MsgSetProjectEnabledResponse) Reset()         { *m = MsgSetProjectEnabledResponse{} }
+func (m *MsgSetProjectEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProjectEnabledResponse) ProtoMessage()    {}
+func (*MsgSetProjectEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f8e20515314f9d, []int{9}
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProjectEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProjectEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetProjectEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProjectEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProjectEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetProjectEnabledResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddKeys)(nil), "lavanet.lava.projects.MsgAddKeys")
 	proto.RegisterType((*MsgAddKeysResponse)(nil), "lavanet.lava.projects.MsgAddKeysResponse")
@@ -422,40 +518,45 @@ func init() {
 	proto.RegisterType((*MsgSetPolicyResponse)(nil), "lavanet.lava.projects.MsgSetPolicyResponse")
 	proto.RegisterType((*MsgSetSubscriptionPolicy)(nil), "lavanet.lava.projects.MsgSetSubscriptionPolicy")
 	proto.RegisterType((*MsgSetSubscriptionPolicyResponse)(nil), "lavanet.lava.projects.MsgSetSubscriptionPolicyResponse")
+	proto.RegisterType((*MsgSetProjectEnabled)(nil), "lavanet.lava.projects.MsgSetProjectEnabled")
+	proto.RegisterType((*MsgSetProjectEnabledResponse)(nil), "lavanet.lava.projects.MsgSetProjectEnabledResponse")
 }
 
 func init() { proto.RegisterFile("lavanet/lava/projects/tx.proto", fileDescriptor_a4f8e20515314f9d) }
 
 var fileDescriptor_a4f8e20515314f9d = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xbf, 0x0b, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x8d, 0xb4, 0xf6, 0xb5, 0x53, 0x68, 0x25, 0x64, 0x48, 0x63, 0xba, 0x44, 0x84,
-	0x04, 0xe2, 0xe0, 0x6c, 0x71, 0xb2, 0x14, 0x4a, 0x3a, 0x08, 0x82, 0x48, 0x9a, 0x1e, 0x31, 0x1a,
-	0x73, 0x21, 0x77, 0x2d, 0xcd, 0x28, 0x38, 0x3a, 0x38, 0xfb, 0x17, 0x75, 0xec, 0xe8, 0x24, 0xd2,
-	0xfe, 0x23, 0x92, 0xe6, 0x2e, 0xfd, 0x61, 0x7f, 0x68, 0x27, 0xa7, 0xbc, 0xbb, 0xf7, 0x7d, 0xef,
-	0x3e, 0xb9, 0xef, 0xf1, 0x40, 0x8f, 0xfd, 0x85, 0x9f, 0x60, 0xe6, 0x14, 0x5f, 0x27, 0xcd, 0xc8,
-	0x07, 0x1c, 0x30, 0xea, 0xb0, 0xa5, 0x9d, 0x66, 0x84, 0x11, 0xa5, 0xcb, 0xf3, 0x76, 0xf1, 0xb5,
-	0x45, 0x5e, 0xeb, 0x9f, 0x2f, 0xe3, 0x41, 0x59, 0xab, 0xf5, 0x8e, 0x45, 0xb1, 0x9f, 0x50, 0x27,
-	0x25, 0x71, 0x14, 0xe4, 0x5c, 0xd0, 0x09, 0x49, 0x48, 0x76, 0xa1, 0x53, 0x44, 0xe5, 0xae, 0xf9,
-	0x15, 0x01, 0x8c, 0x68, 0xf8, 0x62, 0x36, 0x1b, 0xe2, 0x9c, 0x2a, 0x2a, 0x34, 0x82, 0x0c, 0xfb,
-	0x8c, 0x64, 0x2a, 0x32, 0x90, 0xd5, 0xf4, 0xc4, 0xb2, 0xc8, 0xf0, 0x03, 0xd5, 0x5a, 0x99, 0xe1,
-	0x4b, 0xe5, 0x15, 0xb4, 0x79, 0xf8, 0xee, 0x23, 0xce, 0xa9, 0x2a, 0x1b, 0xb2, 0xd5, 0x72, 0x1f,
-	0xdb, 0x67, 0x7f, 0xc6, 0x1e, 0x97, 0xc1, 0x10, 0xe7, 0x83, 0x07, 0xab, 0x9f, 0x3d, 0xc9, 0x6b,
-	0xa5, 0xd5, 0x0e, 0x35, 0x3b, 0xa0, 0xec, 0x69, 0x3c, 0x4c, 0x53, 0x92, 0x50, 0x2c, 0x20, 0x5f,
-	0xe2, 0xf8, 0x3f, 0x82, 0xe4, 0x34, 0x15, 0xe4, 0x02, 0xda, 0x23, 0x1a, 0x4e, 0x30, 0x1b, 0xef,
-	0x6e, 0xfd, 0x2e, 0x4a, 0x17, 0xea, 0xa5, 0x67, 0xaa, 0x6c, 0x20, 0xab, 0xe5, 0x6a, 0x27, 0x7c,
-	0x85, 0xab, 0x76, 0xd9, 0xdf, 0xe3, 0x4a, 0xf3, 0x11, 0x74, 0x0e, 0xcf, 0xad, 0x78, 0xbe, 0x20,
-	0x50, 0xcb, 0xc4, 0x64, 0x3e, 0xa5, 0x41, 0x16, 0xa5, 0x2c, 0x22, 0xc9, 0x4d, 0x38, 0x0d, 0x1e,
-	0x8a, 0x6b, 0x50, 0x6b, 0x86, 0x6c, 0x35, 0xbd, 0x6a, 0x7d, 0x17, 0x9e, 0x09, 0xc6, 0x25, 0x0a,
-	0x81, 0xea, 0x7e, 0x97, 0x41, 0x1e, 0xd1, 0x50, 0x79, 0x0d, 0x0d, 0xf1, 0x10, 0x2f, 0x39, 0xb3,
-	0x7f, 0x1d, 0xda, 0x93, 0x9b, 0x12, 0x71, 0x40, 0xd1, 0x58, 0x3c, 0x9e, 0x2b, 0x8d, 0xb9, 0xe4,
-	0x5a, 0xe3, 0x13, 0xd3, 0x95, 0xb7, 0xd0, 0xdc, 0x3b, 0xde, 0xbf, 0x5c, 0x57, 0x89, 0xb4, 0xa7,
-	0x7f, 0x21, 0xaa, 0xda, 0x7f, 0x46, 0xd0, 0x3d, 0x6f, 0xa0, 0x73, 0xb5, 0xcd, 0x9f, 0x05, 0xda,
-	0xf3, 0x7f, 0x2c, 0x10, 0x0c, 0x83, 0xc1, 0x6a, 0xa3, 0xa3, 0xf5, 0x46, 0x47, 0xbf, 0x36, 0x3a,
-	0xfa, 0xb6, 0xd5, 0xa5, 0xf5, 0x56, 0x97, 0x7e, 0x6c, 0x75, 0xe9, 0x8d, 0x15, 0x46, 0xec, 0xfd,
-	0x7c, 0x6a, 0x07, 0xe4, 0x93, 0x73, 0x34, 0x7d, 0x96, 0x07, 0xb3, 0x2d, 0x4f, 0x31, 0x9d, 0xd6,
-	0x77, 0xc3, 0xe6, 0xd9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xd1, 0x97, 0xef, 0x01, 0x05,
-	0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x75, 0xd5, 0x34, 0x2f, 0x5d, 0xb0, 0x52, 0x64, 0x9d, 0xd0, 0xd5, 0xb8, 0x8b,
+	0x2b, 0x24, 0x5b, 0x4a, 0x07, 0x66, 0x22, 0x58, 0xa8, 0x22, 0x55, 0xee, 0x80, 0x84, 0x84, 0x90,
+	0xe3, 0x9c, 0x4c, 0xc0, 0xf8, 0x2c, 0xdf, 0xa5, 0xaa, 0x47, 0x24, 0x46, 0x06, 0xf8, 0xaf, 0x3a,
+	0x76, 0x64, 0x42, 0x28, 0xf9, 0x47, 0x90, 0x73, 0x3f, 0xd2, 0xd0, 0xa4, 0xa6, 0x99, 0x98, 0xfc,
+	0xde, 0xbd, 0xef, 0x7b, 0xef, 0x73, 0x77, 0xcf, 0x07, 0x24, 0x8b, 0x2f, 0xe3, 0x9c, 0x8a, 0xb0,
+	0xfe, 0x86, 0x45, 0xc9, 0x3e, 0xd2, 0x44, 0xf0, 0x50, 0x5c, 0x05, 0x45, 0xc9, 0x04, 0xb3, 0x0f,
+	0x55, 0x3c, 0xa8, 0xbf, 0x81, 0x8e, 0xe3, 0xe3, 0xf5, 0x69, 0xca, 0x90, 0xb9, 0xf8, 0x68, 0x55,
+	0x94, 0xc5, 0x39, 0x0f, 0x0b, 0x96, 0x4d, 0x92, 0x4a, 0x09, 0x7a, 0x29, 0x4b, 0xd9, 0xc2, 0x0c,
+	0x6b, 0x4b, 0xae, 0x7a, 0xdf, 0x10, 0xc0, 0x90, 0xa7, 0x2f, 0xc6, 0xe3, 0x33, 0x5a, 0x71, 0xdb,
+	0x81, 0x76, 0x52, 0xd2, 0x58, 0xb0, 0xd2, 0x41, 0x2e, 0xf2, 0x3b, 0x91, 0x76, 0xeb, 0x88, 0x6a,
+	0xe8, 0xec, 0xc8, 0x88, 0x72, 0xed, 0xd7, 0x70, 0xa0, 0xcc, 0xf7, 0x9f, 0x68, 0xc5, 0x1d, 0xcb,
+	0xb5, 0xfc, 0x6e, 0xff, 0x69, 0xb0, 0x76, 0x33, 0xc1, 0xb9, 0x34, 0xce, 0x68, 0x35, 0xd8, 0xbd,
+	0xfe, 0x75, 0xd4, 0x8a, 0xba, 0x85, 0x59, 0xe1, 0x5e, 0x0f, 0xec, 0x25, 0x4d, 0x44, 0x79, 0xc1,
+	0x72, 0x4e, 0x35, 0xe4, 0x4b, 0x9a, 0xfd, 0x47, 0x90, 0x8a, 0xc6, 0x40, 0x5e, 0xc2, 0xc1, 0x90,
+	0xa7, 0x17, 0x54, 0x9c, 0x2f, 0x4e, 0x7d, 0x2b, 0xca, 0x3e, 0xec, 0xc9, 0x3b, 0x73, 0x2c, 0x17,
+	0xf9, 0xdd, 0x3e, 0xfe, 0x8b, 0xaf, 0xbe, 0xd5, 0x40, 0xd6, 0x8f, 0x94, 0xd2, 0x7b, 0x0c, 0xbd,
+	0xdb, 0x7d, 0x0d, 0xcf, 0x57, 0x04, 0x8e, 0x0c, 0x5c, 0x4c, 0x47, 0x3c, 0x29, 0x27, 0x85, 0x98,
+	0xb0, 0xbc, 0x11, 0x0e, 0xc3, 0xbe, 0x3e, 0x06, 0x67, 0xc7, 0xb5, 0xfc, 0x4e, 0x64, 0xfc, 0xad,
+	0xf0, 0x3c, 0x70, 0x37, 0x51, 0x18, 0xd4, 0xb1, 0xd9, 0x82, 0xec, 0xf4, 0x2a, 0x8f, 0x47, 0x19,
+	0x1d, 0x6f, 0x75, 0x84, 0x0e, 0xb4, 0xa9, 0x4c, 0x5f, 0x40, 0xee, 0x47, 0xda, 0xf5, 0x08, 0x3c,
+	0x59, 0xd7, 0x45, 0x53, 0xf4, 0x7f, 0xec, 0x82, 0x35, 0xe4, 0xa9, 0xfd, 0x06, 0xda, 0xfa, 0x77,
+	0xd8, 0x34, 0x1f, 0xcb, 0x19, 0xc5, 0x27, 0x8d, 0x12, 0xdd, 0xa0, 0x2e, 0xac, 0x47, 0xf8, 0x9e,
+	0xc2, 0x4a, 0x82, 0x4f, 0x1a, 0x25, 0xa6, 0xf0, 0x3b, 0xe8, 0x2c, 0xe7, 0xee, 0x78, 0x73, 0x9e,
+	0x11, 0xe1, 0x67, 0xff, 0x20, 0x32, 0xe5, 0xbf, 0x20, 0x38, 0x5c, 0x3f, 0x46, 0xe1, 0xbd, 0x65,
+	0xee, 0x26, 0xe0, 0xe7, 0x0f, 0x4c, 0x30, 0x0c, 0x53, 0x78, 0x74, 0x77, 0x3e, 0x1a, 0x76, 0xb1,
+	0x22, 0xc6, 0xa7, 0x0f, 0x10, 0xeb, 0xb6, 0x83, 0xc1, 0xf5, 0x8c, 0xa0, 0x9b, 0x19, 0x41, 0xbf,
+	0x67, 0x04, 0x7d, 0x9f, 0x93, 0xd6, 0xcd, 0x9c, 0xb4, 0x7e, 0xce, 0x49, 0xeb, 0xad, 0x9f, 0x4e,
+	0xc4, 0x87, 0xe9, 0x28, 0x48, 0xd8, 0xe7, 0x70, 0xe5, 0xe9, 0xbd, 0xba, 0xf5, 0xb0, 0x57, 0x05,
+	0xe5, 0xa3, 0xbd, 0xc5, 0x4b, 0x7b, 0xfa, 0x67, 0x00, 0xb9, 0x0d, 0x6a, 0x12, 0xfe, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +575,7 @@ type MsgClient interface {
 	DelKeys(ctx context.Context, in *MsgDelKeys, opts ...grpc.CallOption) (*MsgDelKeysResponse, error)
 	SetPolicy(ctx context.Context, in *MsgSetPolicy, opts ...grpc.CallOption) (*MsgSetPolicyResponse, error)
 	SetSubscriptionPolicy(ctx context.Context, in *MsgSetSubscriptionPolicy, opts ...grpc.CallOption) (*MsgSetSubscriptionPolicyResponse, error)
+	SetProjectEnabled(ctx context.Context, in *MsgSetProjectEnabled, opts ...grpc.CallOption) (*MsgSetProjectEnabledResponse, error)
 }
 
 type msgClient struct {
@@ -520,12 +622,22 @@ func (c *msgClient) SetSubscriptionPolicy(ctx context.Context, in *MsgSetSubscri
 	return out, nil
 }
 
+func (c *msgClient) SetProjectEnabled(ctx context.Context, in *MsgSetProjectEnabled, opts ...grpc.CallOption) (*MsgSetProjectEnabledResponse, error) {
+	out := new(MsgSetProjectEnabledResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.projects.Msg/SetProjectEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddKeys(context.Context, *MsgAddKeys) (*MsgAddKeysResponse, error)
 	DelKeys(context.Context, *MsgDelKeys) (*MsgDelKeysResponse, error)
 	SetPolicy(context.Context, *MsgSetPolicy) (*MsgSetPolicyResponse, error)
 	SetSubscriptionPolicy(context.Context, *MsgSetSubscriptionPolicy) (*MsgSetSubscriptionPolicyResponse, error)
+	SetProjectEnabled(context.Context, *MsgSetProjectEnabled) (*MsgSetProjectEnabledResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -544,6 +656,9 @@ func (*UnimplementedMsgServer) SetPolicy(ctx context.Context, req *MsgSetPolicy)
 func (*UnimplementedMsgServer) SetSubscriptionPolicy(ctx context.Context, req *MsgSetSubscriptionPolicy) (*MsgSetSubscriptionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSubscriptionPolicy not implemented")
 }
+func (*UnimplementedMsgServer) SetProjectEnabled(ctx context.Context, req *MsgSetProjectEnabled) (*MsgSetProjectEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectEnabled not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -621,6 +736,24 @@ func _Msg_SetSubscriptionPolicy_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetProjectEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProjectEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProjectEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.projects.Msg/SetProjectEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProjectEnabled(ctx, req.(*MsgSetProjectEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.projects.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -641,6 +774,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSubscriptionPolicy",
 			Handler:    _Msg_SetSubscriptionPolicy_Handler,
 		},
+		{
+			MethodName: "SetProjectEnabled",
+			Handler:    _Msg_SetProjectEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/projects/tx.proto",
@@ -940,6 +1077,76 @@ func (m *MsgSetSubscriptionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetProjectEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProjectEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProjectEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProjectEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1077,6 +1284,35 @@ func (m *MsgSetSubscriptionPolicyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetProjectEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetProjectEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1879,6 +2115,190 @@ func (m *MsgSetSubscriptionPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetProjectEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetProjectEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetProjectEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetProjectEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetProjectEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetProjectEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
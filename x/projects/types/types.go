@@ -19,4 +19,5 @@ const (
 	SetAdminPolicyEventName        = "set_admin_policy_event"
 	SetSubscriptionPolicyEventName = "set_subscription_policy_event"
 	ProjectResetFailEventName      = "project_reset_failed"
+	SetProjectEnabledEventName     = "set_project_enabled_event"
 )
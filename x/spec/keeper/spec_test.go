@@ -848,6 +848,62 @@ func TestCookbookSpecs(t *testing.T) {
 	}
 }
 
+func TestSpecEncodingValidation(t *testing.T) {
+	ts := newTester(t)
+
+	encodings := []struct {
+		encoding string
+		valid    bool
+	}{
+		{encoding: "", valid: true},
+		{encoding: types.EncodingBase64, valid: true},
+		{encoding: types.EncodingHex, valid: true},
+		{encoding: types.EncodingHexLittleEndian, valid: true},
+		{encoding: types.EncodingBech32, valid: true},
+		{encoding: types.EncodingBase58, valid: true},
+		{encoding: "base32", valid: false},
+		{encoding: "HEX", valid: false},
+	}
+
+	mockSpec := func() types.Spec {
+		spec := common.CreateMockSpec()
+		spec.BlocksInFinalizationProof = 1
+		spec.AverageBlockTime = 1000
+		spec.AllowedBlockLagForQosSync = 1
+		spec.DataReliabilityEnabled = false
+		spec.ApiCollections[0].CollectionData.ApiInterface = types.APIInterfaceRest
+		return spec
+	}
+
+	for _, tt := range encodings {
+		t.Run("parse directive "+tt.encoding, func(t *testing.T) {
+			spec := mockSpec()
+			spec.ApiCollections[0].ParseDirectives = []*types.ParseDirective{{
+				FunctionTag:   types.FUNCTION_TAG_GET_BLOCK_BY_NUM,
+				ApiName:       "block",
+				ResultParsing: types.BlockParser{ParserArg: []string{"hash"}, ParserFunc: types.PARSER_FUNC_PARSE_CANONICAL, Encoding: tt.encoding},
+			}}
+			_, err := ts.Keepers.Spec.ValidateSpec(ts.Ctx, spec)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+
+		t.Run("api "+tt.encoding, func(t *testing.T) {
+			spec := mockSpec()
+			spec.ApiCollections[0].Apis[0].BlockParsing.Encoding = tt.encoding
+			_, err := ts.Keepers.Spec.ValidateSpec(ts.Ctx, spec)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func getAllFilesInDirectory(directory string) ([]string, error) {
 	var files []string
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 )
 
 // this means the current collection data can be expanded from other, i.e other is allowed to be in InheritanceApis
@@ -156,3 +157,28 @@ func (sc SpecCategory) Combine(other SpecCategory) SpecCategory {
 	}
 	return returnedCategory
 }
+
+func (rule *Rule) Validate() error {
+	if rule == nil {
+		return nil
+	}
+	for _, method := range rule.Methods {
+		if method == "" || strings.Contains(strings.TrimSuffix(method, "*"), "*") {
+			return fmt.Errorf("invalid rule method %q, only a trailing * is supported", method)
+		}
+	}
+	for _, paramRule := range rule.Params {
+		if paramRule == nil {
+			return fmt.Errorf("empty param rule")
+		}
+		switch paramRule.Parser.ParserFunc {
+		case PARSER_FUNC_PARSE_BY_ARG, PARSER_FUNC_PARSE_CANONICAL, PARSER_FUNC_PARSE_DICTIONARY, PARSER_FUNC_PARSE_DICTIONARY_OR_ORDERED:
+		default:
+			return fmt.Errorf("unsupported param rule parser func %s", paramRule.Parser.ParserFunc)
+		}
+		if len(paramRule.Parser.ParserArg) == 0 {
+			return fmt.Errorf("param rule parser is missing parser args")
+		}
+	}
+	return nil
+}
@@ -146,7 +146,7 @@ func (x Verification_VerificationSeverity) String() string {
 }
 
 func (Verification_VerificationSeverity) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{3, 0}
+	return fileDescriptor_c9f7567a181f534f, []int{4, 0}
 }
 
 type Header_HeaderType int32
@@ -177,7 +177,7 @@ func (x Header_HeaderType) String() string {
 }
 
 func (Header_HeaderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{6, 0}
+	return fileDescriptor_c9f7567a181f534f, []int{7, 0}
 }
 
 type ApiCollection struct {
@@ -341,7 +341,10 @@ func (m *Extension) GetRule() *Rule {
 }
 
 type Rule struct {
-	Block uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Block      uint64       `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Methods    []string     `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	Params     []*ParamRule `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	BlockRange uint64       `protobuf:"varint,4,opt,name=block_range,json=blockRange,proto3" json:"block_range,omitempty"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
@@ -384,6 +387,79 @@ func (m *Rule) GetBlock() uint64 {
 	return 0
 }
 
+func (m *Rule) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *Rule) GetParams() []*ParamRule {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *Rule) GetBlockRange() uint64 {
+	if m != nil {
+		return m.BlockRange
+	}
+	return 0
+}
+
+type ParamRule struct {
+	Parser BlockParser `protobuf:"bytes,1,opt,name=parser,proto3" json:"parser"`
+	Values []string    `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *ParamRule) Reset()         { *m = ParamRule{} }
+func (m *ParamRule) String() string { return proto.CompactTextString(m) }
+func (*ParamRule) ProtoMessage()    {}
+func (*ParamRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f7567a181f534f, []int{3}
+}
+func (m *ParamRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamRule.Merge(m, src)
+}
+func (m *ParamRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamRule proto.InternalMessageInfo
+
+func (m *ParamRule) GetParser() BlockParser {
+	if m != nil {
+		return m.Parser
+	}
+	return BlockParser{}
+}
+
+func (m *ParamRule) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 type Verification struct {
 	Name           string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ParseDirective *ParseDirective                   `protobuf:"bytes,2,opt,name=parse_directive,json=parseDirective,proto3" json:"parse_directive,omitempty"`
@@ -395,7 +471,7 @@ func (m *Verification) Reset()         { *m = Verification{} }
 func (m *Verification) String() string { return proto.CompactTextString(m) }
 func (*Verification) ProtoMessage()    {}
 func (*Verification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{3}
+	return fileDescriptor_c9f7567a181f534f, []int{4}
 }
 func (m *Verification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +538,7 @@ func (m *ParseValue) Reset()         { *m = ParseValue{} }
 func (m *ParseValue) String() string { return proto.CompactTextString(m) }
 func (*ParseValue) ProtoMessage()    {}
 func (*ParseValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{4}
+	return fileDescriptor_c9f7567a181f534f, []int{5}
 }
 func (m *ParseValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +599,7 @@ func (m *CollectionData) Reset()         { *m = CollectionData{} }
 func (m *CollectionData) String() string { return proto.CompactTextString(m) }
 func (*CollectionData) ProtoMessage()    {}
 func (*CollectionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{5}
+	return fileDescriptor_c9f7567a181f534f, []int{6}
 }
 func (m *CollectionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +666,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{6}
+	return fileDescriptor_c9f7567a181f534f, []int{7}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +730,7 @@ func (m *Api) Reset()         { *m = Api{} }
 func (m *Api) String() string { return proto.CompactTextString(m) }
 func (*Api) ProtoMessage()    {}
 func (*Api) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{7}
+	return fileDescriptor_c9f7567a181f534f, []int{8}
 }
 func (m *Api) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,7 +819,7 @@ func (m *ParseDirective) Reset()         { *m = ParseDirective{} }
 func (m *ParseDirective) String() string { return proto.CompactTextString(m) }
 func (*ParseDirective) ProtoMessage()    {}
 func (*ParseDirective) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{8}
+	return fileDescriptor_c9f7567a181f534f, []int{9}
 }
 func (m *ParseDirective) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +887,7 @@ func (m *BlockParser) Reset()         { *m = BlockParser{} }
 func (m *BlockParser) String() string { return proto.CompactTextString(m) }
 func (*BlockParser) ProtoMessage()    {}
 func (*BlockParser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{9}
+	return fileDescriptor_c9f7567a181f534f, []int{10}
 }
 func (m *BlockParser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -880,7 +956,7 @@ func (m *SpecCategory) Reset()         { *m = SpecCategory{} }
 func (m *SpecCategory) String() string { return proto.CompactTextString(m) }
 func (*SpecCategory) ProtoMessage()    {}
 func (*SpecCategory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f7567a181f534f, []int{10}
+	return fileDescriptor_c9f7567a181f534f, []int{11}
 }
 func (m *SpecCategory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -953,6 +1029,7 @@ func init() {
 	proto.RegisterType((*ApiCollection)(nil), "lavanet.lava.spec.ApiCollection")
 	proto.RegisterType((*Extension)(nil), "lavanet.lava.spec.Extension")
 	proto.RegisterType((*Rule)(nil), "lavanet.lava.spec.Rule")
+	proto.RegisterType((*ParamRule)(nil), "lavanet.lava.spec.ParamRule")
 	proto.RegisterType((*Verification)(nil), "lavanet.lava.spec.Verification")
 	proto.RegisterType((*ParseValue)(nil), "lavanet.lava.spec.ParseValue")
 	proto.RegisterType((*CollectionData)(nil), "lavanet.lava.spec.CollectionData")
@@ -968,95 +1045,100 @@ func init() {
 }
 
 var fileDescriptor_c9f7567a181f534f = []byte{
-	// 1400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x13, 0x35, 0x25, 0xda, 0x96, 0x46, 0x7f, 0xcc, 0x6c, 0xfc, 0xcb, 0x4f, 0x49, 0x1d, 0xc9, 0x65,
-	0xd2, 0xd6, 0x70, 0x50, 0x1b, 0x75, 0x5a, 0xa0, 0x08, 0x0a, 0x14, 0x94, 0x44, 0x27, 0x6a, 0x6c,
-	0xc9, 0x58, 0xcb, 0x6e, 0xdd, 0x0b, 0xb1, 0x26, 0xd7, 0xf2, 0x22, 0x14, 0xc9, 0x92, 0x4b, 0xc3,
-	0xee, 0xb5, 0xb7, 0x9e, 0xfa, 0x29, 0x8a, 0x02, 0x05, 0x0a, 0xf4, 0xd0, 0xef, 0x90, 0x63, 0x8e,
-	0x3d, 0x19, 0x85, 0x73, 0x28, 0x9a, 0x63, 0xee, 0x05, 0x8a, 0x5d, 0x52, 0xb2, 0xe8, 0x28, 0x09,
-	0x72, 0x92, 0xe6, 0xcd, 0xdb, 0xb7, 0xb3, 0x33, 0xb3, 0xb3, 0x84, 0x0f, 0x5d, 0x72, 0x42, 0x3c,
-	0xca, 0xd7, 0xc5, 0xef, 0x7a, 0x14, 0x50, 0x7b, 0x9d, 0x04, 0xcc, 0xb2, 0x7d, 0xd7, 0xa5, 0x36,
-	0x67, 0xbe, 0xb7, 0x16, 0x84, 0x3e, 0xf7, 0xd1, 0xb5, 0x94, 0xb7, 0x26, 0x7e, 0xd7, 0x04, 0xef,
-	0xd6, 0xe2, 0xc0, 0x1f, 0xf8, 0xd2, 0xbb, 0x2e, 0xfe, 0x25, 0x44, 0xfd, 0xdf, 0x3c, 0x54, 0x8c,
-	0x80, 0xb5, 0xc6, 0x02, 0xa8, 0x06, 0xf3, 0xd4, 0x23, 0x87, 0x2e, 0x75, 0x6a, 0xca, 0xb2, 0xb2,
-	0x52, 0xc0, 0x23, 0x13, 0xed, 0xc0, 0xc2, 0xe5, 0x46, 0x96, 0x43, 0x38, 0xa9, 0xe5, 0x96, 0x95,
-	0x95, 0xd2, 0xc6, 0xfb, 0x6b, 0xaf, 0x6c, 0xb7, 0x76, 0xa9, 0xd8, 0x26, 0x9c, 0x34, 0xd5, 0xa7,
-	0xe7, 0x8d, 0x19, 0x5c, 0xb5, 0x33, 0x28, 0x5a, 0x05, 0x95, 0x04, 0x2c, 0xaa, 0xe5, 0x97, 0xf3,
-	0x2b, 0xa5, 0x8d, 0x1b, 0x53, 0x64, 0x8c, 0x80, 0x61, 0xc9, 0x41, 0xf7, 0x61, 0xfe, 0x98, 0x12,
-	0x87, 0x86, 0x51, 0x4d, 0x95, 0xf4, 0x9b, 0x53, 0xe8, 0x8f, 0x24, 0x03, 0x8f, 0x98, 0x68, 0x0b,
-	0x34, 0xe6, 0x1d, 0xd3, 0x90, 0x71, 0xe2, 0xd9, 0xd4, 0x92, 0x9b, 0xcd, 0xca, 0xd5, 0x6f, 0x8f,
-	0x19, 0x2f, 0x4c, 0x2c, 0x35, 0x44, 0x08, 0x5b, 0xa0, 0x05, 0x24, 0x8c, 0xa8, 0xe5, 0xb0, 0x50,
-	0xf0, 0x4e, 0x68, 0x54, 0x9b, 0x7b, 0xad, 0xda, 0x8e, 0xa0, 0xb6, 0x47, 0x4c, 0xbc, 0x10, 0x64,
-	0xec, 0x08, 0x7d, 0x01, 0x40, 0x4f, 0x39, 0xf5, 0x22, 0xe6, 0x7b, 0x51, 0x6d, 0x5e, 0xea, 0x2c,
-	0x4d, 0xd1, 0x31, 0x47, 0x24, 0x3c, 0xc1, 0x47, 0x26, 0x54, 0x4e, 0x68, 0xc8, 0x8e, 0x98, 0x4d,
-	0xb8, 0x14, 0x28, 0x48, 0x81, 0xc6, 0x14, 0x81, 0xfd, 0x09, 0x1e, 0xce, 0xae, 0xd2, 0xbf, 0x83,
-	0xe2, 0x58, 0x1f, 0x21, 0x50, 0x3d, 0x32, 0xa4, 0xb2, 0xee, 0x45, 0x2c, 0xff, 0xa3, 0x3b, 0x50,
-	0xb1, 0x63, 0x6b, 0x18, 0xbb, 0x9c, 0x05, 0x2e, 0xa3, 0xa1, 0x2c, 0x79, 0x0e, 0x97, 0xed, 0x78,
-	0x7b, 0x8c, 0xa1, 0x7b, 0xa0, 0x86, 0xb1, 0x4b, 0x6b, 0x79, 0xd9, 0x0e, 0xff, 0x9f, 0x12, 0x03,
-	0x8e, 0x5d, 0x8a, 0x25, 0x49, 0x5f, 0x02, 0x55, 0x58, 0x68, 0x11, 0x66, 0x0f, 0x5d, 0xdf, 0x7e,
-	0x22, 0xb7, 0x53, 0x71, 0x62, 0xe8, 0x3f, 0xe7, 0xa0, 0x3c, 0x19, 0xf0, 0xd4, 0xa0, 0xbe, 0x82,
-	0x85, 0x2b, 0x85, 0x78, 0x43, 0x27, 0x5e, 0xa9, 0x43, 0x35, 0x5b, 0x07, 0xf4, 0x19, 0xcc, 0x9d,
-	0x10, 0x37, 0xa6, 0xa3, 0x2e, 0xbc, 0xfd, 0x3a, 0x89, 0x7d, 0xc1, 0xc2, 0x29, 0x19, 0xed, 0x40,
-	0x21, 0xa2, 0x22, 0x97, 0xfc, 0xac, 0xa6, 0x2e, 0x2b, 0x2b, 0xd5, 0x8d, 0x4f, 0xdf, 0x92, 0xfa,
-	0x8c, 0xb1, 0x9b, 0xae, 0xc5, 0x63, 0x15, 0xfd, 0x63, 0x58, 0x9c, 0xc6, 0x40, 0x05, 0x50, 0x37,
-	0x09, 0x73, 0xb5, 0x19, 0x54, 0x82, 0xf9, 0xaf, 0x49, 0xe8, 0x31, 0x6f, 0xa0, 0x29, 0xfa, 0xf7,
-	0x00, 0x97, 0x61, 0xa1, 0x25, 0x28, 0x8e, 0x9b, 0x23, 0x4d, 0xd5, 0x25, 0x80, 0x3e, 0x80, 0x2a,
-	0x3d, 0x0d, 0xa8, 0xcd, 0xa9, 0x63, 0xc9, 0xf8, 0x65, 0xba, 0x8a, 0xb8, 0x32, 0x42, 0x13, 0x91,
-	0x8f, 0x60, 0xc1, 0x25, 0x9c, 0x46, 0xdc, 0x72, 0x58, 0x24, 0xdb, 0x5e, 0x56, 0x54, 0xc5, 0xd5,
-	0x04, 0x6e, 0xa7, 0xa8, 0xfe, 0x7b, 0x0e, 0xaa, 0xd9, 0xcb, 0x82, 0xf6, 0xa1, 0x22, 0x26, 0x11,
-	0xf3, 0x38, 0x0d, 0x8f, 0x88, 0x9d, 0xd6, 0xab, 0xf9, 0xc9, 0x8b, 0xf3, 0x46, 0xd6, 0xf1, 0xf2,
-	0xbc, 0xb1, 0x34, 0x24, 0x41, 0xc4, 0xc3, 0xd8, 0xe6, 0x71, 0x48, 0x1f, 0xe8, 0x19, 0xb7, 0x8e,
-	0xcb, 0x24, 0x60, 0x9d, 0x91, 0x29, 0x74, 0xa5, 0xcf, 0x23, 0xae, 0x15, 0x10, 0x7e, 0x9c, 0x44,
-	0x9e, 0xe8, 0x66, 0x1c, 0xaf, 0xea, 0x66, 0xdc, 0x3a, 0x2e, 0x8f, 0xec, 0x1d, 0xc2, 0x8f, 0xd1,
-	0x7d, 0x50, 0xf9, 0x59, 0x90, 0x1c, 0xb0, 0xd8, 0x6c, 0xbc, 0x38, 0x6f, 0x48, 0xfb, 0xe5, 0x79,
-	0xe3, 0x7a, 0x56, 0x45, 0xa0, 0x3a, 0x96, 0x4e, 0xf4, 0x00, 0xe6, 0x88, 0xe3, 0x58, 0xbe, 0x27,
-	0x4b, 0x5e, 0x6c, 0xde, 0x79, 0x71, 0xde, 0x48, 0x91, 0x97, 0xe7, 0x8d, 0xff, 0x5d, 0x39, 0x96,
-	0xc4, 0x75, 0x3c, 0x4b, 0x1c, 0xa7, 0xe7, 0xe9, 0x7f, 0x2b, 0x30, 0x97, 0x8c, 0xa7, 0xa9, 0x2d,
-	0xfd, 0x39, 0xa8, 0x4f, 0x98, 0xe7, 0xc8, 0xe3, 0x55, 0x37, 0xee, 0xbe, 0x76, 0xb6, 0xa5, 0x3f,
-	0xfd, 0xb3, 0x80, 0x62, 0xb9, 0x02, 0x35, 0xa1, 0x7c, 0x14, 0x7b, 0xc9, 0x50, 0xe6, 0x64, 0x20,
-	0x4f, 0x54, 0x9d, 0x3a, 0x08, 0x36, 0xf7, 0xba, 0xad, 0x7e, 0xa7, 0xd7, 0xb5, 0xfa, 0xc6, 0x43,
-	0x5c, 0x1a, 0x2d, 0xea, 0x93, 0x81, 0xfe, 0x18, 0xe0, 0x52, 0x17, 0x55, 0xa0, 0x18, 0x90, 0x28,
-	0xb2, 0x22, 0xea, 0x39, 0xda, 0x0c, 0xaa, 0x02, 0x48, 0x33, 0xa4, 0x81, 0x7b, 0xa6, 0x29, 0x63,
-	0xf7, 0xa1, 0xcf, 0x8f, 0xb5, 0x1c, 0x5a, 0x80, 0x92, 0x34, 0xd9, 0xc0, 0xf3, 0x43, 0xaa, 0xe5,
-	0xf5, 0x3f, 0x72, 0x90, 0x37, 0x02, 0xf6, 0x86, 0x97, 0x64, 0x94, 0x80, 0xdc, 0x95, 0x41, 0xe3,
-	0x0f, 0x83, 0x98, 0x53, 0x2b, 0xf6, 0x18, 0x8f, 0xd2, 0xd6, 0x2b, 0xa7, 0xe0, 0x9e, 0xc0, 0xd0,
-	0x1a, 0x5c, 0xa7, 0xa7, 0x3c, 0x24, 0x56, 0x96, 0xaa, 0x4a, 0xea, 0x35, 0xe9, 0x6a, 0x4d, 0xf2,
-	0x0d, 0x28, 0xd8, 0x84, 0xd3, 0x81, 0x1f, 0x9e, 0xd5, 0xe6, 0xe4, 0x84, 0x98, 0x96, 0x97, 0xdd,
-	0x80, 0xda, 0xad, 0x94, 0x96, 0xbe, 0x54, 0xe3, 0x65, 0xa8, 0x03, 0x15, 0x39, 0x99, 0x2c, 0x31,
-	0x37, 0x98, 0x37, 0xa8, 0xcd, 0x4b, 0x9d, 0xfa, 0x14, 0x9d, 0xa6, 0xe0, 0xc9, 0x4b, 0x19, 0xa6,
-	0x32, 0xe5, 0xc3, 0x11, 0xc4, 0xbc, 0x01, 0xba, 0x0d, 0xc0, 0xd9, 0x90, 0xfa, 0x31, 0xb7, 0x86,
-	0x62, 0x60, 0x8b, 0xa0, 0x8b, 0x29, 0xb2, 0x1d, 0xe9, 0xff, 0x28, 0x50, 0xcd, 0x0e, 0xab, 0x57,
-	0x6a, 0xab, 0xbc, 0x7b, 0x6d, 0xd1, 0x3d, 0xb8, 0x76, 0xa9, 0x41, 0x87, 0x81, 0xb8, 0xcb, 0x69,
-	0xe6, 0xb5, 0x31, 0x2f, 0xc5, 0xd1, 0x63, 0xa8, 0x86, 0x34, 0x8a, 0x5d, 0x3e, 0x3e, 0x6e, 0xfe,
-	0x1d, 0x8e, 0x5b, 0x49, 0xd6, 0x8e, 0xce, 0x7b, 0x13, 0x0a, 0xe2, 0x6e, 0xcb, 0x52, 0xcb, 0x0b,
-	0x83, 0xe7, 0x49, 0xc0, 0xba, 0x64, 0x48, 0xf5, 0xdf, 0x14, 0x28, 0x4d, 0xac, 0x17, 0xa9, 0x91,
-	0x73, 0x39, 0xb4, 0x48, 0x28, 0x8e, 0x99, 0x17, 0x03, 0x2c, 0x41, 0x8c, 0x70, 0x80, 0xbe, 0x14,
-	0x3d, 0x26, 0xdd, 0x22, 0xe2, 0xf4, 0x92, 0x4c, 0x8b, 0x69, 0xc7, 0xc0, 0xbb, 0x26, 0xb6, 0x44,
-	0x36, 0x70, 0xaa, 0xb8, 0x19, 0x7b, 0xb6, 0xe8, 0x2e, 0x87, 0x1e, 0x11, 0x71, 0xb0, 0x64, 0x00,
-	0xca, 0x7b, 0x8f, 0xcb, 0x29, 0x98, 0xcc, 0xbf, 0x5b, 0x50, 0xa0, 0x9e, 0xed, 0x3b, 0xe2, 0xd8,
-	0x49, 0xbc, 0x63, 0x5b, 0xff, 0x55, 0x81, 0xf2, 0x64, 0x9f, 0xa0, 0xbb, 0x42, 0x91, 0xd3, 0x70,
-	0xc8, 0x3c, 0x16, 0x71, 0x66, 0xa7, 0x3d, 0x9e, 0x05, 0xc5, 0x23, 0xe7, 0xfa, 0x36, 0x71, 0x65,
-	0xc8, 0x05, 0x9c, 0x18, 0x48, 0x87, 0x72, 0x14, 0x1f, 0x46, 0x76, 0xc8, 0x02, 0x91, 0x7d, 0x19,
-	0x4c, 0x01, 0x67, 0x30, 0x11, 0x4c, 0xc4, 0x09, 0xa7, 0x47, 0xb1, 0x2b, 0x83, 0xa9, 0xe0, 0xb1,
-	0x8d, 0x1a, 0x50, 0x3a, 0x26, 0xde, 0x80, 0x79, 0x03, 0xf1, 0x49, 0x53, 0x9b, 0x95, 0xcb, 0x21,
-	0x85, 0x8c, 0x80, 0xad, 0xea, 0x50, 0x34, 0xbf, 0xe9, 0x9b, 0xdd, 0xdd, 0x4e, 0xaf, 0x2b, 0x1e,
-	0x90, 0x6e, 0xaf, 0x6b, 0x26, 0x0f, 0x88, 0x81, 0x5b, 0x8f, 0x3a, 0xfb, 0xa6, 0xa6, 0xac, 0xfe,
-	0xa8, 0x40, 0x79, 0xb2, 0x6b, 0x50, 0x19, 0x0a, 0xed, 0xce, 0xae, 0xd1, 0xdc, 0x32, 0xdb, 0xda,
-	0x0c, 0xd2, 0xa0, 0xfc, 0xd0, 0xec, 0x5b, 0xcd, 0xad, 0x5e, 0xeb, 0x71, 0x77, 0x6f, 0x5b, 0x53,
-	0xd0, 0x22, 0x68, 0x63, 0xc4, 0x6a, 0x1e, 0x58, 0x02, 0xcd, 0xa1, 0x5b, 0x70, 0x63, 0xd7, 0xec,
-	0x5b, 0x5b, 0x46, 0xdf, 0xdc, 0xed, 0x5b, 0x9d, 0xae, 0xb5, 0x6d, 0xf6, 0x8d, 0xb6, 0xd1, 0x37,
-	0xb4, 0x3c, 0xba, 0x01, 0x28, 0xeb, 0x6b, 0xf6, 0xda, 0x07, 0x9a, 0x2a, 0xb4, 0xf7, 0x4d, 0xdc,
-	0xd9, 0xec, 0xb4, 0x0c, 0xb1, 0xbb, 0x36, 0xbb, 0xfa, 0x83, 0x02, 0xa5, 0x89, 0xda, 0xa1, 0x22,
-	0xcc, 0x9a, 0xdb, 0x3b, 0xfd, 0x83, 0x24, 0x10, 0xe9, 0x11, 0x5b, 0x1a, 0xf8, 0xa1, 0xa6, 0xa0,
-	0xeb, 0xb0, 0x90, 0x20, 0x2d, 0xa3, 0xdb, 0xeb, 0x76, 0x5a, 0xc6, 0x96, 0x96, 0x13, 0xd1, 0x25,
-	0x60, 0xbb, 0x23, 0x8f, 0x64, 0xe0, 0x03, 0x2d, 0x8f, 0x1a, 0xf0, 0xde, 0x55, 0xd4, 0xea, 0x61,
-	0xab, 0x87, 0xdb, 0x26, 0x36, 0xdb, 0x9a, 0x2a, 0x52, 0xd2, 0x36, 0x37, 0x8d, 0xbd, 0xad, 0xbe,
-	0x36, 0xd7, 0x6c, 0xfe, 0x72, 0x51, 0x57, 0x9e, 0x5e, 0xd4, 0x95, 0x67, 0x17, 0x75, 0xe5, 0xaf,
-	0x8b, 0xba, 0xf2, 0xd3, 0xf3, 0xfa, 0xcc, 0xb3, 0xe7, 0xf5, 0x99, 0x3f, 0x9f, 0xd7, 0x67, 0xbe,
-	0xbd, 0x3b, 0x60, 0xfc, 0x38, 0x3e, 0x5c, 0xb3, 0xfd, 0xe1, 0x7a, 0xe6, 0x3b, 0xfc, 0x34, 0xf9,
-	0x12, 0x17, 0x4f, 0x44, 0x74, 0x38, 0x27, 0x3f, 0xac, 0xef, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0xd6, 0x1c, 0x3e, 0x83, 0xab, 0x0b, 0x00, 0x00,
+	// 1475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x6f, 0xdb, 0x46,
+	0x16, 0x36, 0x25, 0x59, 0x96, 0x9e, 0x7e, 0x98, 0x99, 0x78, 0xbd, 0x4a, 0xd6, 0x91, 0xbc, 0x4c,
+	0x76, 0xd7, 0x70, 0xb0, 0x36, 0xd6, 0xc9, 0x02, 0x8b, 0x20, 0xc0, 0x82, 0x92, 0xe8, 0x44, 0x8d,
+	0x2d, 0x19, 0x63, 0xd9, 0xad, 0x7b, 0x21, 0xc6, 0xd4, 0x58, 0x1a, 0x84, 0x22, 0x59, 0x72, 0x68,
+	0xd8, 0xbd, 0xf6, 0x56, 0xa0, 0x40, 0xff, 0x8a, 0xa2, 0x40, 0x81, 0x02, 0x3d, 0xf4, 0x7f, 0xc8,
+	0x31, 0xc7, 0x9e, 0x8c, 0xc2, 0x39, 0x14, 0xcd, 0x31, 0xf7, 0x02, 0xc5, 0x0c, 0x49, 0x59, 0x74,
+	0x94, 0xb4, 0x39, 0xc9, 0xef, 0x9b, 0xef, 0x7d, 0xf3, 0xde, 0x9b, 0x37, 0x6f, 0x4c, 0xf8, 0xa7,
+	0x4d, 0x4e, 0x89, 0x43, 0xf9, 0xa6, 0xf8, 0xdd, 0x0c, 0x3c, 0x6a, 0x6d, 0x12, 0x8f, 0x99, 0x96,
+	0x6b, 0xdb, 0xd4, 0xe2, 0xcc, 0x75, 0x36, 0x3c, 0xdf, 0xe5, 0x2e, 0xba, 0x11, 0xf3, 0x36, 0xc4,
+	0xef, 0x86, 0xe0, 0xdd, 0x5e, 0x1a, 0xba, 0x43, 0x57, 0xae, 0x6e, 0x8a, 0xbf, 0x22, 0xa2, 0xf6,
+	0x5b, 0x16, 0x2a, 0xba, 0xc7, 0x5a, 0x13, 0x01, 0x54, 0x83, 0x05, 0xea, 0x90, 0x63, 0x9b, 0x0e,
+	0x6a, 0xca, 0xaa, 0xb2, 0x56, 0xc0, 0x89, 0x89, 0xf6, 0x60, 0xf1, 0x6a, 0x23, 0x73, 0x40, 0x38,
+	0xa9, 0x65, 0x56, 0x95, 0xb5, 0xd2, 0xd6, 0xdf, 0x37, 0xde, 0xda, 0x6e, 0xe3, 0x4a, 0xb1, 0x4d,
+	0x38, 0x69, 0xe6, 0x5e, 0x5c, 0x34, 0xe6, 0x70, 0xd5, 0x4a, 0xa1, 0x68, 0x1d, 0x72, 0xc4, 0x63,
+	0x41, 0x2d, 0xbb, 0x9a, 0x5d, 0x2b, 0x6d, 0x2d, 0xcf, 0x90, 0xd1, 0x3d, 0x86, 0x25, 0x07, 0x3d,
+	0x80, 0x85, 0x11, 0x25, 0x03, 0xea, 0x07, 0xb5, 0x9c, 0xa4, 0xdf, 0x9a, 0x41, 0x7f, 0x2a, 0x19,
+	0x38, 0x61, 0xa2, 0x1d, 0x50, 0x99, 0x33, 0xa2, 0x3e, 0xe3, 0xc4, 0xb1, 0xa8, 0x29, 0x37, 0x9b,
+	0x5f, 0xcd, 0xfe, 0xa9, 0x98, 0xf1, 0xe2, 0x94, 0xab, 0x2e, 0x42, 0xd8, 0x01, 0xd5, 0x23, 0x7e,
+	0x40, 0xcd, 0x01, 0xf3, 0x05, 0xef, 0x94, 0x06, 0xb5, 0xfc, 0x3b, 0xd5, 0xf6, 0x04, 0xb5, 0x9d,
+	0x30, 0xf1, 0xa2, 0x97, 0xb2, 0x03, 0xf4, 0x18, 0x80, 0x9e, 0x71, 0xea, 0x04, 0xcc, 0x75, 0x82,
+	0xda, 0x82, 0xd4, 0x59, 0x99, 0xa1, 0x63, 0x24, 0x24, 0x3c, 0xc5, 0x47, 0x06, 0x54, 0x4e, 0xa9,
+	0xcf, 0x4e, 0x98, 0x45, 0xb8, 0x14, 0x28, 0x48, 0x81, 0xc6, 0x0c, 0x81, 0xc3, 0x29, 0x1e, 0x4e,
+	0x7b, 0x69, 0x9f, 0x41, 0x71, 0xa2, 0x8f, 0x10, 0xe4, 0x1c, 0x32, 0xa6, 0xf2, 0xdc, 0x8b, 0x58,
+	0xfe, 0x8d, 0xee, 0x42, 0xc5, 0x0a, 0xcd, 0x71, 0x68, 0x73, 0xe6, 0xd9, 0x8c, 0xfa, 0xf2, 0xc8,
+	0x33, 0xb8, 0x6c, 0x85, 0xbb, 0x13, 0x0c, 0xdd, 0x87, 0x9c, 0x1f, 0xda, 0xb4, 0x96, 0x95, 0xed,
+	0xf0, 0xd7, 0x19, 0x31, 0xe0, 0xd0, 0xa6, 0x58, 0x92, 0xb4, 0xaf, 0x14, 0xc8, 0x09, 0x13, 0x2d,
+	0xc1, 0xfc, 0xb1, 0xed, 0x5a, 0xcf, 0xe5, 0x7e, 0x39, 0x1c, 0x19, 0xa2, 0xff, 0xc6, 0x94, 0x8f,
+	0xdc, 0x41, 0x50, 0xcb, 0xac, 0x66, 0xd7, 0x8a, 0x38, 0x31, 0xd1, 0x43, 0xc8, 0x7b, 0xc4, 0x27,
+	0xe3, 0xa4, 0x5f, 0x56, 0x66, 0x17, 0x9d, 0x8c, 0xe5, 0x66, 0x31, 0x17, 0x35, 0xa0, 0x24, 0x85,
+	0x4d, 0x9f, 0x38, 0x43, 0x5a, 0xcb, 0xc9, 0xbd, 0x40, 0x42, 0x58, 0x20, 0x1a, 0x81, 0xe2, 0xc4,
+	0x0b, 0x3d, 0x96, 0x7b, 0x04, 0xd4, 0x97, 0x41, 0x95, 0xb6, 0xea, 0x33, 0xf6, 0x68, 0x0a, 0x5f,
+	0x79, 0xba, 0x7e, 0xdc, 0xd7, 0xb1, 0x0f, 0x5a, 0x86, 0xfc, 0x29, 0xb1, 0x43, 0x9a, 0x84, 0x1e,
+	0x5b, 0xda, 0x37, 0x19, 0x28, 0x4f, 0x9f, 0xc2, 0xcc, 0x4a, 0x7f, 0x04, 0x8b, 0xd7, 0xba, 0xeb,
+	0x3d, 0xd7, 0xeb, 0x5a, 0x73, 0x55, 0xd3, 0xcd, 0x85, 0xfe, 0x3b, 0x09, 0x24, 0x2a, 0xd5, 0x9d,
+	0x77, 0x49, 0x1c, 0x0a, 0x56, 0x12, 0x27, 0xda, 0x83, 0x42, 0x40, 0x45, 0x83, 0xf0, 0x73, 0x59,
+	0xa8, 0xea, 0xd6, 0xc3, 0x3f, 0xe8, 0xa7, 0x94, 0xb1, 0x1f, 0xfb, 0xe2, 0x89, 0x8a, 0xf6, 0x6f,
+	0x58, 0x9a, 0xc5, 0x40, 0x05, 0xc8, 0x6d, 0x13, 0x66, 0xab, 0x73, 0xa8, 0x04, 0x0b, 0x1f, 0x13,
+	0xdf, 0x61, 0xce, 0x50, 0x55, 0xb4, 0xcf, 0x01, 0xae, 0xc2, 0x42, 0x2b, 0x50, 0x9c, 0x74, 0x7c,
+	0x5c, 0xaa, 0x2b, 0x00, 0xfd, 0x03, 0xaa, 0xf4, 0xcc, 0xa3, 0x16, 0xa7, 0x03, 0x53, 0xc6, 0x2f,
+	0xcb, 0x55, 0xc4, 0x95, 0x04, 0x8d, 0x44, 0xfe, 0x05, 0x8b, 0x36, 0xe1, 0x34, 0xe0, 0xe6, 0x80,
+	0x05, 0xf2, 0x2e, 0xcb, 0x36, 0xcd, 0xe1, 0x6a, 0x04, 0xb7, 0x63, 0x54, 0xfb, 0x21, 0x03, 0xd5,
+	0xf4, 0x04, 0x40, 0x87, 0x50, 0x11, 0xe3, 0x95, 0x39, 0x9c, 0xfa, 0x27, 0xc4, 0x8a, 0xcf, 0xab,
+	0xf9, 0x9f, 0xd7, 0x17, 0x8d, 0xf4, 0xc2, 0x9b, 0x8b, 0xc6, 0xca, 0x98, 0x78, 0x01, 0xf7, 0x43,
+	0x8b, 0x87, 0x3e, 0x7d, 0xa4, 0xa5, 0x96, 0x35, 0x5c, 0x26, 0x1e, 0xeb, 0x24, 0xa6, 0xd0, 0x95,
+	0x6b, 0x0e, 0xb1, 0x4d, 0x8f, 0xf0, 0x51, 0x2d, 0x73, 0xa5, 0x9b, 0x5a, 0x78, 0x5b, 0x37, 0xb5,
+	0xac, 0xe1, 0x72, 0x62, 0xef, 0x11, 0x3e, 0x42, 0x0f, 0x20, 0xc7, 0xcf, 0xbd, 0x28, 0xc1, 0x62,
+	0xb3, 0xf1, 0xfa, 0xa2, 0x21, 0xed, 0x37, 0x17, 0x8d, 0x9b, 0x69, 0x15, 0x81, 0x6a, 0x58, 0x2e,
+	0xa2, 0x47, 0x90, 0x27, 0x83, 0x81, 0xe9, 0x3a, 0xf2, 0xc8, 0x8b, 0xcd, 0xbb, 0xaf, 0x2f, 0x1a,
+	0x31, 0xf2, 0xe6, 0xa2, 0xf1, 0x97, 0x6b, 0x69, 0x49, 0x5c, 0xc3, 0xf3, 0x64, 0x30, 0xe8, 0x39,
+	0xda, 0x2f, 0x0a, 0xe4, 0xa3, 0x99, 0x3b, 0xb3, 0xa5, 0xff, 0x07, 0xb9, 0xe7, 0xcc, 0x19, 0xc8,
+	0xf4, 0xaa, 0x5b, 0xf7, 0xde, 0x39, 0xb0, 0xe3, 0x9f, 0xfe, 0xb9, 0x47, 0xb1, 0xf4, 0x40, 0x4d,
+	0x28, 0x9f, 0x84, 0x4e, 0xf4, 0xd2, 0x70, 0x32, 0x94, 0x19, 0x55, 0x67, 0x4e, 0xb7, 0xed, 0x83,
+	0x6e, 0xab, 0xdf, 0xe9, 0x75, 0xcd, 0xbe, 0xfe, 0x04, 0x97, 0x12, 0xa7, 0x3e, 0x19, 0x6a, 0xcf,
+	0x00, 0xae, 0x74, 0x51, 0x05, 0x8a, 0x1e, 0x09, 0x02, 0x33, 0xa0, 0xce, 0x40, 0x9d, 0x43, 0x55,
+	0x00, 0x69, 0xfa, 0xd4, 0xb3, 0xcf, 0x55, 0x65, 0xb2, 0x7c, 0xec, 0xf2, 0x91, 0x9a, 0x41, 0x8b,
+	0x50, 0x92, 0x26, 0x1b, 0x3a, 0xae, 0x4f, 0xd5, 0xac, 0xf6, 0x63, 0x06, 0xb2, 0xba, 0xc7, 0xde,
+	0xf3, 0x3c, 0x26, 0x05, 0xc8, 0x5c, 0x9b, 0x9e, 0xee, 0xd8, 0x0b, 0x39, 0x35, 0x43, 0x87, 0xf1,
+	0x20, 0x6e, 0xbd, 0x72, 0x0c, 0x1e, 0x08, 0x0c, 0x6d, 0xc0, 0x4d, 0x7a, 0xc6, 0x7d, 0x62, 0xa6,
+	0xa9, 0xd1, 0xa4, 0xba, 0x21, 0x97, 0x5a, 0xd3, 0x7c, 0x1d, 0x0a, 0x16, 0xe1, 0x74, 0xe8, 0xfa,
+	0xe7, 0xb5, 0xbc, 0x9c, 0x10, 0xb3, 0xea, 0xb2, 0xef, 0x51, 0xab, 0x15, 0xd3, 0xe2, 0x31, 0x35,
+	0x71, 0x43, 0x1d, 0xa8, 0x44, 0x43, 0x51, 0xcc, 0x0d, 0xe6, 0x0c, 0x6b, 0x0b, 0x1f, 0x30, 0xed,
+	0xca, 0xc7, 0x09, 0xc4, 0x9c, 0x21, 0xba, 0x03, 0xc0, 0xd9, 0x98, 0xba, 0x21, 0x37, 0xc7, 0xe2,
+	0x15, 0x12, 0x41, 0x17, 0x63, 0x64, 0x37, 0xd0, 0x7e, 0x55, 0xa0, 0x9a, 0x1e, 0x56, 0x6f, 0x9d,
+	0xad, 0xf2, 0xe1, 0x67, 0x8b, 0xee, 0xc3, 0x8d, 0x2b, 0x0d, 0x3a, 0xf6, 0xc4, 0x5d, 0x8e, 0x2b,
+	0xaf, 0x4e, 0x78, 0x31, 0x8e, 0x9e, 0x41, 0xd5, 0xa7, 0x41, 0x68, 0xf3, 0x49, 0xba, 0xd9, 0x0f,
+	0x48, 0xb7, 0x12, 0xf9, 0x26, 0xf9, 0xde, 0x82, 0x82, 0xb8, 0xdb, 0xf2, 0xa8, 0xe5, 0x85, 0xc1,
+	0x0b, 0xc4, 0x63, 0x5d, 0x32, 0xa6, 0xda, 0xf7, 0x0a, 0x94, 0xa6, 0xfc, 0x45, 0x69, 0xa2, 0x87,
+	0xc1, 0x24, 0xbe, 0x48, 0x53, 0x3c, 0x09, 0xc5, 0x08, 0xd1, 0xfd, 0x21, 0xfa, 0x3f, 0x94, 0x22,
+	0xc3, 0x14, 0x11, 0xc7, 0x97, 0x64, 0x56, 0x4c, 0x7b, 0x3a, 0xde, 0x37, 0xb0, 0x29, 0xaa, 0x81,
+	0x63, 0xc5, 0xed, 0xd0, 0xb1, 0x44, 0x77, 0x0d, 0xe8, 0x09, 0x11, 0x89, 0x45, 0x03, 0x50, 0xde,
+	0x7b, 0x5c, 0x8e, 0xc1, 0x68, 0xfe, 0xdd, 0x86, 0x02, 0x75, 0x2c, 0x77, 0x20, 0xd2, 0x8e, 0xe2,
+	0x9d, 0xd8, 0xda, 0x77, 0x0a, 0x94, 0xa7, 0xfb, 0x04, 0xdd, 0x13, 0x8a, 0x9c, 0xfa, 0x63, 0xe6,
+	0xb0, 0x80, 0x33, 0x2b, 0xee, 0xf1, 0x34, 0x28, 0x1e, 0x6e, 0xdb, 0xb5, 0x88, 0x2d, 0x43, 0x2e,
+	0xe0, 0xc8, 0x40, 0x1a, 0x94, 0x83, 0xf0, 0x38, 0xb0, 0x7c, 0xe6, 0x89, 0xea, 0xcb, 0x60, 0x0a,
+	0x38, 0x85, 0x89, 0x60, 0x02, 0x4e, 0x38, 0x3d, 0x09, 0x6d, 0x19, 0x4c, 0x05, 0x4f, 0x6c, 0xf1,
+	0x50, 0x8f, 0x88, 0x33, 0x64, 0xce, 0x50, 0xfc, 0x9f, 0x56, 0x9b, 0x97, 0xee, 0x10, 0x43, 0xba,
+	0xc7, 0xd6, 0x35, 0x28, 0x1a, 0x9f, 0xf4, 0x8d, 0xee, 0x7e, 0xa7, 0xd7, 0x15, 0x0f, 0x48, 0xb7,
+	0xd7, 0x35, 0xa2, 0x07, 0x44, 0xc7, 0xad, 0xa7, 0x9d, 0x43, 0x43, 0x55, 0xd6, 0xbf, 0x54, 0xa0,
+	0x3c, 0xdd, 0x35, 0xa8, 0x0c, 0x85, 0x76, 0x67, 0x5f, 0x6f, 0xee, 0x18, 0x6d, 0x75, 0x0e, 0xa9,
+	0x50, 0x7e, 0x62, 0xf4, 0xcd, 0xe6, 0x4e, 0xaf, 0xf5, 0xac, 0x7b, 0xb0, 0xab, 0x2a, 0x68, 0x09,
+	0xd4, 0x09, 0x62, 0x36, 0x8f, 0x4c, 0x81, 0x66, 0xd0, 0x6d, 0x58, 0xde, 0x37, 0xfa, 0xe6, 0x8e,
+	0xde, 0x37, 0xf6, 0xfb, 0x66, 0xa7, 0x6b, 0xee, 0x1a, 0x7d, 0xbd, 0xad, 0xf7, 0x75, 0x35, 0x8b,
+	0x96, 0x01, 0xa5, 0xd7, 0x9a, 0xbd, 0xf6, 0x91, 0x9a, 0x13, 0xda, 0x87, 0x06, 0xee, 0x6c, 0x77,
+	0x5a, 0xba, 0xd8, 0x5d, 0x9d, 0x5f, 0xff, 0x42, 0x81, 0xd2, 0xd4, 0xd9, 0xa1, 0x22, 0xcc, 0x1b,
+	0xbb, 0x7b, 0xfd, 0xa3, 0x28, 0x10, 0xb9, 0x22, 0xb6, 0xd4, 0xf1, 0x13, 0x55, 0x41, 0x37, 0x61,
+	0x31, 0x42, 0x5a, 0x7a, 0xb7, 0xd7, 0xed, 0xb4, 0xf4, 0x1d, 0x35, 0x23, 0xa2, 0x8b, 0xc0, 0x76,
+	0x47, 0xa6, 0xa4, 0xe3, 0x23, 0x35, 0x8b, 0x1a, 0xf0, 0xb7, 0xeb, 0xa8, 0xd9, 0xc3, 0x66, 0x0f,
+	0xb7, 0x0d, 0x6c, 0xb4, 0xd5, 0x9c, 0x28, 0x49, 0xdb, 0xd8, 0xd6, 0x0f, 0x76, 0xfa, 0x6a, 0xbe,
+	0xd9, 0xfc, 0xf6, 0xb2, 0xae, 0xbc, 0xb8, 0xac, 0x2b, 0x2f, 0x2f, 0xeb, 0xca, 0xcf, 0x97, 0x75,
+	0xe5, 0xeb, 0x57, 0xf5, 0xb9, 0x97, 0xaf, 0xea, 0x73, 0x3f, 0xbd, 0xaa, 0xcf, 0x7d, 0x7a, 0x6f,
+	0xc8, 0xf8, 0x28, 0x3c, 0xde, 0xb0, 0xdc, 0xf1, 0x66, 0xea, 0xe3, 0xe2, 0x2c, 0xfa, 0xbc, 0x10,
+	0x4f, 0x44, 0x70, 0x9c, 0x97, 0x5f, 0x0b, 0x0f, 0x7e, 0x1f, 0x00, 0x55, 0xb8, 0xa7, 0x96, 0x80,
+	0x0c, 0x00, 0x00,
 }
 
 func (this *ApiCollection) Equal(that interface{}) bool {
@@ -1186,6 +1268,57 @@ func (this *Rule) Equal(that interface{}) bool {
 	if this.Block != that1.Block {
 		return false
 	}
+	if len(this.Methods) != len(that1.Methods) {
+		return false
+	}
+	for i := range this.Methods {
+		if this.Methods[i] != that1.Methods[i] {
+			return false
+		}
+	}
+	if len(this.Params) != len(that1.Params) {
+		return false
+	}
+	for i := range this.Params {
+		if !this.Params[i].Equal(that1.Params[i]) {
+			return false
+		}
+	}
+	if this.BlockRange != that1.BlockRange {
+		return false
+	}
+	return true
+}
+func (this *ParamRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamRule)
+	if !ok {
+		that2, ok := that.(ParamRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Parser.Equal(&that1.Parser) {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Verification) Equal(that interface{}) bool {
@@ -1663,6 +1796,34 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockRange != 0 {
+		i = encodeVarintApiCollection(dAtA, i, uint64(m.BlockRange))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApiCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Methods[iNdEx])
+			copy(dAtA[i:], m.Methods[iNdEx])
+			i = encodeVarintApiCollection(dAtA, i, uint64(len(m.Methods[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Block != 0 {
 		i = encodeVarintApiCollection(dAtA, i, uint64(m.Block))
 		i--
@@ -1671,6 +1832,48 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintApiCollection(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Parser.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApiCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Verification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2201,6 +2404,38 @@ func (m *Rule) Size() (n int) {
 	if m.Block != 0 {
 		n += 1 + sovApiCollection(uint64(m.Block))
 	}
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
+	if m.BlockRange != 0 {
+		n += 1 + sovApiCollection(uint64(m.BlockRange))
+	}
+	return n
+}
+
+func (m *ParamRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Parser.Size()
+	n += 1 + l + sovApiCollection(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovApiCollection(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2885,6 +3120,206 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, &ParamRule{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			m.BlockRange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRange |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApiCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApiCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApiCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApiCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApiCollection(dAtA[iNdEx:])
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleValidate(t *testing.T) {
+	require.NoError(t, (*Rule)(nil).Validate())
+	require.NoError(t, (&Rule{Block: 10}).Validate())
+	require.NoError(t, (&Rule{Methods: []string{"debug_trace*"}}).Validate())
+	require.Error(t, (&Rule{Methods: []string{"debug_*_trace"}}).Validate())
+	require.Error(t, (&Rule{Methods: []string{""}}).Validate())
+	require.Error(t, (&Rule{Params: []*ParamRule{{Parser: BlockParser{ParserFunc: PARSER_FUNC_DEFAULT, ParserArg: []string{"0"}}}}}).Validate())
+	require.Error(t, (&Rule{Params: []*ParamRule{{Parser: BlockParser{ParserFunc: PARSER_FUNC_PARSE_BY_ARG}}}}).Validate())
+	require.NoError(t, (&Rule{Params: []*ParamRule{{Parser: BlockParser{ParserFunc: PARSER_FUNC_PARSE_BY_ARG, ParserArg: []string{"0"}}}}}).Validate())
+}
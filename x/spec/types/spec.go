@@ -28,8 +28,11 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 		APIInterfaceGrpc:          {},
 	}
 	availavleEncodings := map[string]struct{}{
-		EncodingBase64: {},
-		EncodingHex:    {},
+		EncodingBase64:          {},
+		EncodingHex:             {},
+		EncodingHexLittleEndian: {},
+		EncodingBech32:          {},
+		EncodingBase58:          {},
 	}
 
 	for _, char := range spec.Name {
@@ -108,6 +111,12 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 				details["api"] = api.Name
 				return details, fmt.Errorf("compute units out or range %s", api.Name)
 			}
+			if api.BlockParsing.Encoding != "" {
+				if _, ok := availavleEncodings[api.BlockParsing.Encoding]; !ok {
+					details["api"] = api.Name
+					return details, fmt.Errorf("unsupported api encoding %s in api %s", api.BlockParsing.Encoding, api.Name)
+				}
+			}
 		}
 		currentHeaders := map[string]struct{}{}
 		for _, header := range apiCollection.Headers {
@@ -122,6 +131,12 @@ func (spec Spec) ValidateSpec(maxCU uint64) (map[string]string, error) {
 				return details, fmt.Errorf("header names must be lower case %s", header.Name)
 			}
 		}
+		for _, extension := range apiCollection.Extensions {
+			if err := extension.Rule.Validate(); err != nil {
+				details["extension"] = extension.Name
+				return details, err
+			}
+		}
 	}
 
 	if spec.DataReliabilityEnabled && spec.Enabled {
@@ -20,8 +20,11 @@ const (
 )
 
 const (
-	EncodingBase64 = "base64"
-	EncodingHex    = "hex"
+	EncodingBase64          = "base64"
+	EncodingHex             = "hex"
+	EncodingHexLittleEndian = "hex_le" // hex of a byte reversed hash, e.g. bitcoin style block hashes
+	EncodingBech32          = "bech32"
+	EncodingBase58          = "base58"
 )
 
 const (
@@ -32,6 +32,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddProject())
 	cmd.AddCommand(CmdDelProject())
 	cmd.AddCommand(CmdAutoRenewal())
+	cmd.AddCommand(CmdDepositOveruse())
+	cmd.AddCommand(CmdTransfer())
+	cmd.AddCommand(CmdCancel())
+	cmd.AddCommand(CmdUpgrade())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCancel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [optional: consumer]",
+		Short: "Cancel a subscription at the end of the current month",
+		Long: `The cancel command ends the subscription at the end of the current month. The months
+left after the current one are refunded to the subscription creator (pro-rata, with the
+annual discount if it was applied), and an advance purchase is refunded to its creator.
+Auto-renewal is disabled.`,
+		Example: `Required flags: --from <subscription_consumer>
+lavad tx subscription cancel --from <subscription_consumer>
+lavad tx subscription cancel <subscription_consumer> --from <subscription_creator>`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			consumer := creator
+			if len(args) == 1 {
+				consumer = args[0]
+			}
+
+			msg := types.NewMsgCancel(
+				creator,
+				consumer,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
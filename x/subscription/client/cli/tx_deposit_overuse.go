@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdDepositOveruse() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-overuse [deposit] [cu-cap] [optional: consumer]",
+		Short: "Deposit funds to pay for CU used beyond the subscription's monthly allowance",
+		Long: `The deposit-overuse command adds funds to the subscription's overuse deposit and sets
+the max CU that can be used beyond the monthly allowance each month. Overuse is charged
+from the deposit at the plan's overuse rate, and is only possible with plans that allow it.
+The remaining deposit is refunded to the subscription creator when the subscription expires.
+Use a zero deposit to only change the cap, and a zero cap to disable overuse.`,
+		Example: `Required flags: --from <subscription_consumer>
+lavad tx subscription deposit-overuse 1000000ulava 100000 --from <subscription_consumer>
+lavad tx subscription deposit-overuse 0ulava 0 --from <subscription_consumer>
+lavad tx subscription deposit-overuse 1000000ulava 100000 <subscription_consumer> --from <subscription_creator>`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			consumer := creator
+			if len(args) == 3 {
+				consumer = args[2]
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			cuCap, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositOveruse(
+				creator,
+				consumer,
+				deposit,
+				cuCap,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
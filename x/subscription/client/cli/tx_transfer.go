@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [new-consumer] [optional: consumer]",
+		Short: "Transfer a subscription and its projects to another address",
+		Long: `The transfer command moves a subscription, with its projects, to a new consumer address
+which also becomes the subscription's creator (and pays for its renewals). The new consumer
+continues the current month, and the subscription's projects move on the next epoch.
+The new consumer must not have a subscription of its own.`,
+		Example: `Required flags: --from <subscription_consumer>
+lavad tx subscription transfer <new_consumer> --from <subscription_consumer>
+lavad tx subscription transfer <new_consumer> <subscription_consumer> --from <subscription_creator>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			consumer := creator
+			if len(args) == 2 {
+				consumer = args[1]
+			}
+
+			msg := types.NewMsgTransfer(
+				creator,
+				consumer,
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [plan-index] [optional: consumer]",
+		Short: "Upgrade a subscription to a higher priced plan in the middle of the month",
+		Long: `The upgrade command switches the subscription to a higher priced plan from the next epoch,
+without waiting for the end of the month. The CU allowance difference between the plans is
+added to the current month, and only the price difference of the remaining months is charged.`,
+		Example: `Required flags: --from <subscription_consumer>
+lavad tx subscription upgrade <plan_index> --from <subscription_consumer>
+lavad tx subscription upgrade <plan_index> <subscription_consumer> --from <subscription_creator>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			consumer := creator
+			if len(args) == 2 {
+				consumer = args[1]
+			}
+
+			msg := types.NewMsgUpgrade(
+				creator,
+				consumer,
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
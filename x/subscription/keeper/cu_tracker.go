@@ -151,11 +151,24 @@ func (k Keeper) RewardAndResetCuTracker(ctx sdk.Context, cuTrackerTimerKeyBytes
 		return
 	}
 
-	totalTokenAmount := plan.Price.Amount
-	if plan.Price.Amount.Quo(sdk.NewIntFromUint64(totalCuTracked)).GT(sdk.NewIntFromUint64(LIMIT_TOKEN_PER_CU)) {
+	var subObj types.Subscription
+	if found := k.subsFS.FindEntry(ctx, sub, block, &subObj); !found {
+		utils.LavaFormatError("cannot find subscription", types.ErrCuTrackerPayoutFailed,
+			utils.Attribute{Key: "sub_consumer", Value: sub},
+			utils.Attribute{Key: "block", Value: block},
+		)
+		return
+	}
+
+	// part of the plan price may have been rewarded already (mid-month upgrade or transfer)
+	totalTokenAmount := subObj.MonthReward(plan)
+	if totalTokenAmount.Quo(sdk.NewIntFromUint64(totalCuTracked)).GT(sdk.NewIntFromUint64(LIMIT_TOKEN_PER_CU)) {
 		totalTokenAmount = sdk.NewIntFromUint64(LIMIT_TOKEN_PER_CU * totalCuTracked)
 	}
 
+	// the CU overuse was already charged from the overuse deposit, add it to the reward
+	totalTokenAmount = totalTokenAmount.Add(subObj.OverusePayment(plan))
+
 	// get the adjustment factor, and delete the entries
 	adjustments := k.GetConsumerAdjustments(ctx, sub)
 	adjustmentFactorForProvider := k.GetAdjustmentFactorProvider(ctx, adjustments)
@@ -43,6 +43,9 @@ func (k Keeper) List(goCtx context.Context, req *types.QueryListRequest) (*types
 			Cluster:             sub.Cluster,
 			AutoRenewalNextPlan: sub.AutoRenewalNextPlan,
 			FutureSubscription:  sub.FutureSubscription,
+			OveruseDeposit:      sub.OveruseDeposit,
+			OveruseCuCap:        sub.OveruseCuCap,
+			MonthCuOveruse:      sub.MonthCuOveruse,
 		}
 
 		allSubsInfo = append(allSubsInfo, subInfoStruct)
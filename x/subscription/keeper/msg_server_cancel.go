@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) Cancel(goCtx context.Context, msg *types.MsgCancel) (*types.MsgCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, utils.LavaFormatError("Invalid creator address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Consumer); err != nil {
+		return nil, utils.LavaFormatError("Invalid consumer address", err,
+			utils.LogAttr("consumer", msg.Consumer),
+		)
+	}
+
+	err := k.Keeper.CancelSubscription(ctx, msg.Creator, msg.Consumer)
+	return &types.MsgCancelResponse{}, err
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) DepositOveruse(goCtx context.Context, msg *types.MsgDepositOveruse) (*types.MsgDepositOveruseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAcct, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, utils.LavaFormatError("Invalid creator address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	sub, found := k.GetSubscription(ctx, msg.Consumer)
+	if !found {
+		return nil, utils.LavaFormatWarning("could not deposit for subscription overuse", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: msg.Consumer},
+		)
+	}
+
+	// Verify creator either sub.Creator or sub.Consumer
+	if msg.Creator != sub.Consumer && msg.Creator != sub.Creator {
+		return nil, utils.LavaFormatWarning("could not deposit for subscription overuse", fmt.Errorf("creator is not authorized to deposit for this subscription"),
+			utils.Attribute{Key: "creator", Value: msg.Creator},
+			utils.Attribute{Key: "consumer", Value: msg.Consumer},
+		)
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found || !plan.AllowOveruse {
+		return nil, utils.LavaFormatWarning("could not deposit for subscription overuse", fmt.Errorf("subscription plan does not allow overuse"),
+			utils.Attribute{Key: "consumer", Value: msg.Consumer},
+			utils.Attribute{Key: "plan", Value: sub.PlanIndex},
+		)
+	}
+
+	if msg.Deposit.Denom != k.stakingKeeper.BondDenom(ctx) {
+		return nil, utils.LavaFormatWarning("could not deposit for subscription overuse", fmt.Errorf("invalid deposit denom"),
+			utils.Attribute{Key: "consumer", Value: msg.Consumer},
+			utils.Attribute{Key: "deposit", Value: msg.Deposit},
+		)
+	}
+
+	if !msg.Deposit.IsZero() {
+		err = k.chargeFromCreatorAccountToModule(ctx, creatorAcct, msg.Deposit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	deposit := sub.OveruseDeposit + msg.Deposit.Amount.Uint64()
+	k.setOveruseDeposit(ctx, msg.Consumer, sub.Block, deposit, msg.CuCap)
+
+	details := map[string]string{
+		"creator":  msg.Creator,
+		"consumer": msg.Consumer,
+		"deposit":  msg.Deposit.String(),
+		"total":    strconv.FormatUint(deposit, 10),
+		"cuCap":    strconv.FormatUint(msg.CuCap, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.DepositOveruseEventName, details, "subscription overuse deposit updated")
+
+	return &types.MsgDepositOveruseResponse{}, nil
+}
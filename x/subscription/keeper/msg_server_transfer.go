@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, utils.LavaFormatError("Invalid creator address", err,
+			utils.LogAttr("creator", msg.Creator),
+		)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Consumer); err != nil {
+		return nil, utils.LavaFormatError("Invalid consumer address", err,
+			utils.LogAttr("consumer", msg.Consumer),
+		)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewConsumer); err != nil {
+		return nil, utils.LavaFormatError("Invalid new consumer address", err,
+			utils.LogAttr("new_consumer", msg.NewConsumer),
+		)
+	}
+
+	err := k.Keeper.TransferSubscription(ctx, msg.Creator, msg.Consumer, msg.NewConsumer)
+	return &types.MsgTransferResponse{}, err
+}
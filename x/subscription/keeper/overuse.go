@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"math"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// setOveruseDeposit sets the overuse deposit and cap of the current version of the
+// subscription and of its future versions (e.g. a pending upgrade). Versions of past
+// months keep their own monthly overuse counters, but the deposit is only read from
+// the current version
+func (k Keeper) setOveruseDeposit(ctx sdk.Context, consumer string, block, deposit, cuCap uint64) {
+	for _, version := range k.subsFS.GetEntryVersionsRange(ctx, consumer, block, math.MaxUint64-block) {
+		var sub types.Subscription
+		k.subsFS.ReadEntry(ctx, consumer, version, &sub)
+		sub.OveruseDeposit = deposit
+		sub.OveruseCuCap = cuCap
+		k.subsFS.ModifyEntry(ctx, consumer, version, &sub)
+	}
+}
+
+// chargeOveruse charges CU used beyond the monthly allowance from the overuse deposit
+// (up to the overuse cap). The caller is responsible to save the subscription
+func (k Keeper) chargeOveruse(ctx sdk.Context, sub *types.Subscription, cuAmount uint64) {
+	// the deposit of an expired subscription was already refunded
+	current, found := k.GetSubscription(ctx, sub.Consumer)
+	if !found {
+		return
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return
+	}
+
+	// the cap applies to the month of the relay, the deposit is taken from the current version
+	view := *sub
+	view.OveruseDeposit = current.OveruseDeposit
+	cuLeft := view.OveruseCuLeft(plan)
+	if cuAmount > cuLeft {
+		cuAmount = cuLeft
+	}
+	if cuAmount == 0 {
+		return
+	}
+
+	sub.MonthCuOveruse += cuAmount
+	deposit := current.OveruseDeposit - cuAmount*plan.OveruseRate
+	k.setOveruseDeposit(ctx, sub.Consumer, current.Block, deposit, current.OveruseCuCap)
+	if sub.Block >= current.Block {
+		sub.OveruseDeposit = deposit
+	}
+
+	utils.LavaFormatDebug("charging sub for cu overuse",
+		utils.LogAttr("sub", sub.Consumer),
+		utils.LogAttr("sub_block", sub.Block),
+		utils.LogAttr("overuse_cu", cuAmount),
+		utils.LogAttr("month_cu_overuse", sub.MonthCuOveruse),
+		utils.LogAttr("overuse_deposit", deposit))
+}
+
+// refundOveruseDeposit returns the remaining overuse deposit to the subscription creator
+func (k Keeper) refundOveruseDeposit(ctx sdk.Context, sub types.Subscription) {
+	if sub.OveruseDeposit == 0 {
+		return
+	}
+
+	refund := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.NewIntFromUint64(sub.OveruseDeposit))
+	err := k.refundFromModuleToAccount(ctx, sub.Creator, refund)
+	if err != nil {
+		utils.LavaFormatError("critical: failed refunding overuse deposit", err,
+			utils.Attribute{Key: "consumer", Value: sub.Consumer},
+			utils.Attribute{Key: "creator", Value: sub.Creator},
+		)
+		return
+	}
+
+	details := map[string]string{
+		"consumer": sub.Consumer,
+		"creator":  sub.Creator,
+		"refund":   refund.String(),
+		"block":    strconv.FormatInt(ctx.BlockHeight(), 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.RefundOveruseDepositEventName, details, "overuse deposit refunded")
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositOveruse(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 0) // 2 sub, 0 adm, 0 dev
+
+	sub1Acct, sub1Addr := ts.Account("sub1")
+	_, sub2Addr := ts.Account("sub2")
+
+	noOverusePlan := common.CreateMockPlan()
+	noOverusePlan.Index = "nooveruse"
+	noOverusePlan.AllowOveruse = false
+	noOverusePlan.Block = ts.BlockHeight()
+	ts.AddPlan(noOverusePlan.Index, noOverusePlan)
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, "free", 1, false, false)
+	require.NoError(t, err)
+	_, err = ts.TxSubscriptionBuy(sub2Addr, sub2Addr, noOverusePlan.Index, 1, false, false)
+	require.NoError(t, err)
+
+	balance := ts.GetBalance(sub1Acct.Addr)
+
+	// not the creator nor the consumer
+	err = ts.TxSubscriptionDepositOveruse(sub2Addr, sub1Addr, 1000, 50)
+	require.Error(t, err)
+
+	// plan does not allow overuse
+	err = ts.TxSubscriptionDepositOveruse(sub2Addr, sub2Addr, 1000, 50)
+	require.Error(t, err)
+
+	// no subscription
+	err = ts.TxSubscriptionDepositOveruse(sub1Addr, common.CreateNewAccount(ts.GoCtx, *ts.Keepers, 0).Addr.String(), 1000, 50)
+	require.Error(t, err)
+
+	err = ts.TxSubscriptionDepositOveruse(sub1Addr, sub1Addr, 1000, 50)
+	require.NoError(t, err)
+	require.Equal(t, balance-1000, ts.GetBalance(sub1Acct.Addr))
+
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, uint64(1000), sub.OveruseDeposit)
+	require.Equal(t, uint64(50), sub.OveruseCuCap)
+
+	// top up and change the cap
+	err = ts.TxSubscriptionDepositOveruse(sub1Addr, sub1Addr, 500, 70)
+	require.NoError(t, err)
+	require.Equal(t, balance-1500, ts.GetBalance(sub1Acct.Addr))
+
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, uint64(1500), sub.OveruseDeposit)
+	require.Equal(t, uint64(70), sub.OveruseCuCap)
+
+	// change only the cap
+	err = ts.TxSubscriptionDepositOveruse(sub1Addr, sub1Addr, 0, 30)
+	require.NoError(t, err)
+	require.Equal(t, balance-1500, ts.GetBalance(sub1Acct.Addr))
+
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, uint64(1500), sub.OveruseDeposit)
+	require.Equal(t, uint64(30), sub.OveruseCuCap)
+}
+
+func TestChargeOveruse(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 0) // 1 sub, 0 adm, 0 dev
+
+	_, sub1Addr := ts.Account("sub1")
+	plan := ts.Plan("free")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, plan.Index, 2, false, false)
+	require.NoError(t, err)
+
+	// deposit for 70 CU of overuse, capped at 50 CU
+	deposit := 70 * plan.OveruseRate
+	err = ts.TxSubscriptionDepositOveruse(sub1Addr, sub1Addr, int64(deposit), 50)
+	require.NoError(t, err)
+
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	monthCuTotal := sub.MonthCuTotal
+
+	// use the whole allowance and 30 CU beyond it
+	sub, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(ts.Ctx, sub1Addr, ts.BlockHeight(), monthCuTotal+30)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), sub.MonthCuLeft)
+	require.Equal(t, uint64(30), sub.MonthCuOveruse)
+	require.Equal(t, deposit-30*plan.OveruseRate, sub.OveruseDeposit)
+	require.Equal(t, uint64(20), sub.OveruseCuLeft(plan))
+
+	// only the CU up to the cap are charged
+	sub, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(ts.Ctx, sub1Addr, ts.BlockHeight(), 40)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), sub.MonthCuOveruse)
+	require.Equal(t, deposit-50*plan.OveruseRate, sub.OveruseDeposit)
+	require.Equal(t, uint64(0), sub.OveruseCuLeft(plan))
+
+	res, err := ts.QuerySubscriptionCurrent(sub1Addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), res.Sub.MonthCuOveruse)
+
+	// a new month resets the overuse counter and keeps the deposit
+	ts.AdvanceMonths(1).AdvanceEpoch()
+
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, monthCuTotal, sub.MonthCuLeft)
+	require.Equal(t, uint64(0), sub.MonthCuOveruse)
+	require.Equal(t, deposit-50*plan.OveruseRate, sub.OveruseDeposit)
+
+	// the deposit now limits the overuse
+	require.Equal(t, uint64(20), sub.OveruseCuLeft(plan))
+}
+
+func TestOveruseDepositRefund(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 0, 0) // 1 sub, 0 adm, 0 dev
+
+	sub1Acct, sub1Addr := ts.Account("sub1")
+	plan := ts.Plan("free")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, plan.Index, 1, false, false)
+	require.NoError(t, err)
+
+	err = ts.TxSubscriptionDepositOveruse(sub1Addr, sub1Addr, 1000, 50)
+	require.NoError(t, err)
+
+	_, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(ts.Ctx, sub1Addr, ts.BlockHeight(), plan.PlanPolicy.TotalCuLimit+10)
+	require.NoError(t, err)
+
+	balance := ts.GetBalance(sub1Acct.Addr)
+
+	// expire the subscription, the remaining deposit is refunded
+	ts.AdvanceMonths(1).AdvanceEpoch()
+
+	_, found := ts.getSubscription(sub1Addr)
+	require.False(t, found)
+	require.Equal(t, balance+1000-int64(10*plan.OveruseRate), ts.GetBalance(sub1Acct.Addr))
+}
@@ -453,6 +453,8 @@ func (k Keeper) handleZeroDurationLeftForSubscription(ctx sdk.Context, block uin
 func (k Keeper) resetSubscriptionDetailsAndAppendEntry(ctx sdk.Context, sub *types.Subscription, block uint64, deleteOldTimer bool) error {
 	// reset subscription CU allowance for this coming month
 	sub.MonthCuLeft = sub.MonthCuTotal
+	sub.MonthCuOveruse = 0
+	sub.MonthRewardPaid = 0
 	sub.Block = block
 
 	// restart timer and append new (fixated) version of this subscription
@@ -526,6 +528,39 @@ func (k Keeper) chargeFromCreatorAccountToModule(ctx sdk.Context, creator sdk.Ac
 	return nil
 }
 
+// planCost returns the price of a plan for a number of months (with the annual
+// discount if the duration bought is eligible)
+func (k Keeper) planCost(plan planstypes.Plan, months, durationBought uint64) sdk.Coin {
+	price := plan.GetPrice()
+	price.Amount = price.Amount.MulRaw(int64(months))
+	k.applyPlanDiscountIfEligible(durationBought, &plan, &price)
+	return price
+}
+
+func (k Keeper) refundFromModuleToAccount(ctx sdk.Context, addr string, amount sdk.Coin) error {
+	acct, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return utils.LavaFormatError("critical: invalid refund address", err,
+			utils.LogAttr("address", addr),
+			utils.LogAttr("amount", amount),
+		)
+	}
+
+	if amount.IsZero() {
+		return nil
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, acct, []sdk.Coin{amount})
+	if err != nil {
+		return utils.LavaFormatError("refund failed. funds transfer failed", err,
+			utils.LogAttr("address", addr),
+			utils.LogAttr("amount", amount),
+		)
+	}
+
+	return nil
+}
+
 func (k Keeper) CreateFutureSubscription(ctx sdk.Context,
 	creator string,
 	consumer string,
@@ -615,10 +650,343 @@ func (k Keeper) CreateFutureSubscription(ctx sdk.Context,
 	return nil
 }
 
+// TransferSubscription moves a subscription and its projects to a new consumer address.
+// The CU tracked until the next epoch is rewarded under the old consumer, and the new
+// consumer continues the current month (the projects move on the next epoch). The
+// subscription's creator is kept, so refunds still go to the account that paid
+func (k Keeper) TransferSubscription(ctx sdk.Context, creator, consumer, newConsumer string) error {
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("Got an error while trying to get next epoch on TransferSubscription", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	sub, err := k.getSubscriptionForUpdate(ctx, creator, consumer, nextEpoch)
+	if err != nil {
+		return err
+	}
+
+	if sub.Block == nextEpoch {
+		return utils.LavaFormatWarning("can't transfer a subscription that was upgraded in the current epoch", fmt.Errorf("subscription block is equal to next epoch"),
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	var newSub types.Subscription
+	if k.subsFS.FindEntry(ctx, newConsumer, block, &newSub) || k.subsFS.FindEntry(ctx, newConsumer, nextEpoch, &newSub) {
+		return utils.LavaFormatWarning("can't transfer subscription", fmt.Errorf("new consumer already has a subscription"),
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("new_consumer", newConsumer),
+		)
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return utils.LavaFormatError("critical: failed to find existing subscription plan", legacyerrors.ErrKeyNotFound,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("planIndex", sub.PlanIndex),
+			utils.LogAttr("planBlock", sub.PlanBlock),
+		)
+	}
+
+	err = k.projectsKeeper.TransferProjects(ctx, consumer, newConsumer)
+	if err != nil {
+		return utils.LavaFormatWarning("can't transfer subscription projects", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("new_consumer", newConsumer),
+		)
+	}
+
+	// the new subscription continues the current month. The plan price of this month (and
+	// the overuse so far) is rewarded under the old consumer, so the new one doesn't reward it again
+	newSub = sub
+	newSub.Consumer = newConsumer
+	newSub.Block = block
+	newSub.MonthCuOveruse = 0
+	if plan.Price.Amount.GT(sdk.NewIntFromUint64(newSub.MonthRewardPaid)) {
+		newSub.MonthRewardPaid = plan.Price.Amount.Uint64()
+	}
+
+	// the projects stay with the old consumer until the next epoch, so reward the CU
+	// tracked under it only after the relays of the current epoch can no longer be paid
+	k.addCuTrackerTimerForSubscription(ctx, nextEpoch, &sub)
+
+	// the overuse deposit moves to the new subscription
+	sub.OveruseDeposit = 0
+	sub.OveruseCuCap = 0
+	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
+
+	err = k.subsFS.DelEntry(ctx, consumer, nextEpoch)
+	if err != nil {
+		return utils.LavaFormatError("transfer subscription failed, delete old subscription failed", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", nextEpoch),
+		)
+	}
+
+	if k.subsTS.HasTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer)) {
+		k.subsTS.DelTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer))
+	}
+
+	err = k.subsFS.AppendEntry(ctx, newConsumer, block, &newSub)
+	if err != nil {
+		return utils.LavaFormatError("transfer subscription failed, append new subscription failed", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("new_consumer", newConsumer),
+		)
+	}
+	k.subsTS.AddTimerByBlockTime(ctx, newSub.MonthExpiryTime, []byte(newConsumer), []byte{})
+
+	details := map[string]string{
+		"creator":     creator,
+		"consumer":    consumer,
+		"newConsumer": newConsumer,
+		"plan":        sub.PlanIndex,
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.TransferSubscriptionEventName, details, "subscription transferred")
+	return nil
+}
+
+// CancelSubscription ends a subscription at the end of the current month. The unused months
+// (and the future subscription, if any) are refunded
+func (k Keeper) CancelSubscription(ctx sdk.Context, creator, consumer string) error {
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("Got an error while trying to get next epoch on CancelSubscription", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	// take the most updated subscription (including next-epoch upgrade)
+	sub, err := k.getSubscriptionForUpdate(ctx, creator, consumer, nextEpoch)
+	if err != nil {
+		return err
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return utils.LavaFormatError("critical: failed to find existing subscription plan", legacyerrors.ErrKeyNotFound,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("planIndex", sub.PlanIndex),
+			utils.LogAttr("planBlock", sub.PlanBlock),
+		)
+	}
+
+	// the current month is used, refund the months after it
+	refund := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	if sub.DurationLeft > 1 {
+		refund = k.planCost(plan, sub.DurationLeft-1, sub.DurationBought)
+		err = k.refundFromModuleToAccount(ctx, sub.Creator, refund)
+		if err != nil {
+			return err
+		}
+		sub.DurationLeft = 1
+	}
+
+	futureRefund := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	if sub.FutureSubscription != nil {
+		futureSub := sub.FutureSubscription
+		futurePlan, found := k.plansKeeper.FindPlan(ctx, futureSub.PlanIndex, futureSub.PlanBlock)
+		if !found {
+			return utils.LavaFormatError("critical: failed to find future subscription plan", legacyerrors.ErrKeyNotFound,
+				utils.LogAttr("consumer", consumer),
+				utils.LogAttr("planIndex", futureSub.PlanIndex),
+				utils.LogAttr("planBlock", futureSub.PlanBlock),
+			)
+		}
+
+		futureRefund = k.planCost(futurePlan, futureSub.DurationBought, futureSub.DurationBought)
+		err = k.refundFromModuleToAccount(ctx, futureSub.Creator, futureRefund)
+		if err != nil {
+			return err
+		}
+
+		// the future subscription held a reference to its plan
+		k.plansKeeper.PutPlan(ctx, futureSub.PlanIndex, futureSub.PlanBlock)
+		sub.FutureSubscription = nil
+	}
+
+	sub.AutoRenewalNextPlan = types.AUTO_RENEWAL_PLAN_NONE
+	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
+
+	details := map[string]string{
+		"creator":      creator,
+		"consumer":     consumer,
+		"refund":       refund.String(),
+		"futureRefund": futureRefund.String(),
+		"expiry":       strconv.FormatUint(sub.MonthExpiryTime, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.CancelSubscriptionEventName, details, "subscription cancelled")
+	return nil
+}
+
+// UpgradeSubscription switches a subscription to a higher priced plan in the middle of the
+// month (from the next epoch). The CU allowance difference is added to the current month and
+// only the price difference of the remaining months is charged
+func (k Keeper) UpgradeSubscription(ctx sdk.Context, creator, consumer, planIndex string) error {
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("Got an error while trying to get next epoch on UpgradeSubscription", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid subscription creator address", err,
+			utils.LogAttr("creator", creator),
+		)
+	}
+
+	sub, err := k.getSubscriptionForUpdate(ctx, creator, consumer, nextEpoch)
+	if err != nil {
+		return err
+	}
+
+	if sub.Block == nextEpoch {
+		return utils.LavaFormatWarning("can't upgrade the same subscription more than once in the same epoch", fmt.Errorf("subscription block is equal to next epoch"),
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+			utils.LogAttr("nextEpoch", nextEpoch),
+		)
+	}
+
+	if sub.DurationLeft == 0 {
+		return utils.LavaFormatWarning("can't upgrade subscription", fmt.Errorf("subscription already expired"),
+			utils.LogAttr("consumer", consumer),
+		)
+	}
+
+	currentPlan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return utils.LavaFormatError("critical: failed to find existing subscription plan", legacyerrors.ErrKeyNotFound,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("planIndex", sub.PlanIndex),
+			utils.LogAttr("planBlock", sub.PlanBlock),
+		)
+	}
+
+	// takes a reference to the new plan
+	newPlan, found := k.plansKeeper.GetPlan(ctx, planIndex)
+	if !found {
+		return utils.LavaFormatWarning("can't upgrade subscription to an invalid plan", legacyerrors.ErrKeyNotFound,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("plan", planIndex),
+		)
+	}
+
+	if !newPlan.Price.Amount.GT(currentPlan.Price.Amount) {
+		return utils.LavaFormatWarning("can't upgrade subscription", fmt.Errorf("new plan's price must be higher than the current plan's"),
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("currentPlan", currentPlan.Index),
+			utils.LogAttr("currentPrice", currentPlan.Price),
+			utils.LogAttr("newPlan", newPlan.Index),
+			utils.LogAttr("newPrice", newPlan.Price),
+		)
+	}
+
+	// charge only the price difference of the remaining months (including the current one)
+	delta := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	newCost := k.planCost(newPlan, sub.DurationLeft, sub.DurationBought)
+	oldCost := k.planCost(currentPlan, sub.DurationLeft, sub.DurationBought)
+	if newCost.IsGTE(oldCost) {
+		delta = newCost.Sub(oldCost)
+	}
+	if delta.IsPositive() {
+		err = k.chargeFromCreatorAccountToModule(ctx, creatorAcct, delta)
+		if err != nil {
+			return err
+		}
+	}
+
+	// reward the CU tracked so far (and the overuse) with the current plan. Relays of the current
+	// epoch are still tracked under it, so reward only after they can no longer be paid
+	k.addCuTrackerTimerForSubscription(ctx, nextEpoch, &sub)
+
+	// remove one refcount for previous plan
+	k.plansKeeper.PutPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+
+	if sub.AutoRenewalNextPlan == sub.PlanIndex {
+		sub.AutoRenewalNextPlan = newPlan.Index
+	}
+
+	// carry over the CU allowance difference to the current month
+	newCuTotal := newPlan.PlanPolicy.TotalCuLimit
+	if newCuTotal >= sub.MonthCuTotal {
+		sub.MonthCuLeft += newCuTotal - sub.MonthCuTotal
+	} else if sub.MonthCuTotal-newCuTotal < sub.MonthCuLeft {
+		sub.MonthCuLeft -= sub.MonthCuTotal - newCuTotal
+	} else {
+		sub.MonthCuLeft = 0
+	}
+	sub.MonthCuTotal = newCuTotal
+
+	if currentPlan.Price.Amount.GT(sdk.NewIntFromUint64(sub.MonthRewardPaid)) {
+		sub.MonthRewardPaid = currentPlan.Price.Amount.Uint64()
+	}
+	sub.MonthCuOveruse = 0
+
+	sub.PlanIndex = newPlan.Index
+	sub.PlanBlock = newPlan.Block
+	sub.Block = nextEpoch
+	sub.Cluster = types.GetClusterKey(sub)
+
+	err = k.subsFS.AppendEntry(ctx, consumer, nextEpoch, &sub)
+	if err != nil {
+		return utils.LavaFormatError("upgrade subscription failed, append subscription failed", err,
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", nextEpoch),
+		)
+	}
+
+	details := map[string]string{
+		"consumer": consumer,
+		"oldPlan":  currentPlan.Index,
+		"newPlan":  newPlan.Index,
+		"charged":  delta.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.UpgradeSubscriptionEventName, details, "subscription upgraded")
+	return nil
+}
+
+// getSubscriptionForUpdate returns the subscription of a consumer at a block, verifying
+// that the creator is either the subscription's creator or its consumer
+func (k Keeper) getSubscriptionForUpdate(ctx sdk.Context, creator, consumer string, block uint64) (types.Subscription, error) {
+	var sub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); !found {
+		return sub, utils.LavaFormatWarning("could not update subscription", fmt.Errorf("subscription not found"),
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+
+	if creator != sub.Creator && creator != sub.Consumer {
+		return sub, utils.LavaFormatWarning("could not update subscription", fmt.Errorf("creator is not authorized to update this subscription"),
+			utils.LogAttr("creator", creator),
+			utils.LogAttr("consumer", consumer),
+		)
+	}
+
+	return sub, nil
+}
+
 func (k Keeper) RemoveExpiredSubscription(ctx sdk.Context, consumer string, block uint64, planIndex string, planBlock uint64) {
 	// delete all projects before deleting
 	k.delAllProjectsFromSubscription(ctx, consumer)
 
+	var sub types.Subscription
+	if found := k.subsFS.FindEntry(ctx, consumer, block, &sub); found {
+		k.refundOveruseDeposit(ctx, sub)
+	}
+
 	// delete subscription effective now (don't wait for end of epoch)
 	err := k.subsFS.DelEntry(ctx, consumer, block)
 	if err != nil {
@@ -712,6 +1080,7 @@ func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer strin
 	}
 
 	if sub.MonthCuLeft < cuAmount {
+		k.chargeOveruse(ctx, &sub, cuAmount-sub.MonthCuLeft)
 		sub.MonthCuLeft = 0
 	} else {
 		sub.MonthCuLeft -= cuAmount
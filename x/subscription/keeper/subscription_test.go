@@ -2280,3 +2280,184 @@ func TestSubscriptionUpgradeAffectsTimer(t *testing.T) {
 		verifyTimerStore("after buying premium-plus")
 	})
 }
+
+func TestSubscriptionCancel(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 0) // 2 sub, 0 adm, 0 dev
+
+	sub1Acct, sub1Addr := ts.Account("sub1")
+	_, sub2Addr := ts.Account("sub2")
+	plan := ts.Plan("free")
+	premiumPlan := ts.Plan("premium")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, plan.Index, 3, false, false)
+	require.NoError(t, err)
+
+	// buy a future subscription (charged upfront)
+	_, err = ts.TxSubscriptionBuy(sub1Addr, sub1Addr, premiumPlan.Index, 2, false, true)
+	require.NoError(t, err)
+
+	balance := ts.GetBalance(sub1Acct.Addr)
+
+	// not the creator nor the consumer
+	err = ts.TxSubscriptionCancel(sub2Addr, sub1Addr)
+	require.Error(t, err)
+
+	// no subscription
+	err = ts.TxSubscriptionCancel(sub2Addr, sub2Addr)
+	require.Error(t, err)
+
+	err = ts.TxSubscriptionCancel(sub1Addr, sub1Addr)
+	require.NoError(t, err)
+
+	// the unused months and the future subscription are refunded
+	refund := plan.Price.Amount.MulRaw(2).Add(premiumPlan.Price.Amount.MulRaw(2))
+	require.Equal(t, balance+refund.Int64(), ts.GetBalance(sub1Acct.Addr))
+
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, uint64(1), sub.DurationLeft)
+	require.Nil(t, sub.FutureSubscription)
+	require.Equal(t, types.AUTO_RENEWAL_PLAN_NONE, sub.AutoRenewalNextPlan)
+
+	// the subscription ends with the current month
+	ts.AdvanceMonths(1).AdvanceEpoch()
+	_, found := ts.getSubscription(sub1Addr)
+	require.False(t, found)
+}
+
+func TestSubscriptionUpgradeMidMonth(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 0) // 2 sub, 0 adm, 0 dev
+
+	sub1Acct, sub1Addr := ts.Account("sub1")
+	_, sub2Addr := ts.Account("sub2")
+	freePlan := ts.Plan("free")
+	premiumPlan := ts.Plan("premium")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, freePlan.Index, 3, false, false)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	cuUsed := uint64(1000)
+	_, err = ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(ts.Ctx, sub1Addr, ts.BlockHeight(), cuUsed)
+	require.NoError(t, err)
+
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	durationLeft := sub.DurationLeft
+	cuLeft := sub.MonthCuLeft
+	balance := ts.GetBalance(sub1Acct.Addr)
+
+	// not the creator nor the consumer
+	err = ts.TxSubscriptionUpgrade(sub2Addr, sub1Addr, premiumPlan.Index)
+	require.Error(t, err)
+
+	// same price
+	err = ts.TxSubscriptionUpgrade(sub1Addr, sub1Addr, freePlan.Index)
+	require.Error(t, err)
+
+	// unknown plan
+	err = ts.TxSubscriptionUpgrade(sub1Addr, sub1Addr, "unknown")
+	require.Error(t, err)
+
+	err = ts.TxSubscriptionUpgrade(sub1Addr, sub1Addr, premiumPlan.Index)
+	require.NoError(t, err)
+
+	// only the price difference of the remaining months is charged
+	delta := premiumPlan.Price.Amount.Sub(freePlan.Price.Amount).MulRaw(int64(durationLeft))
+	require.Equal(t, balance-delta.Int64(), ts.GetBalance(sub1Acct.Addr))
+
+	// only one upgrade per epoch
+	err = ts.TxSubscriptionUpgrade(sub1Addr, sub1Addr, premiumPlan.Index)
+	require.Error(t, err)
+
+	// the plan changes on the next epoch
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, freePlan.Index, sub.PlanIndex)
+
+	ts.AdvanceEpoch()
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, premiumPlan.Index, sub.PlanIndex)
+	require.Equal(t, durationLeft, sub.DurationLeft)
+	require.Equal(t, premiumPlan.PlanPolicy.TotalCuLimit, sub.MonthCuTotal)
+	require.Equal(t, cuLeft+premiumPlan.PlanPolicy.TotalCuLimit-freePlan.PlanPolicy.TotalCuLimit, sub.MonthCuLeft)
+	require.Equal(t, freePlan.Price.Amount.Uint64(), sub.MonthRewardPaid)
+
+	// the next month starts with the full premium allowance
+	ts.AdvanceMonths(1).AdvanceEpoch()
+	sub = getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+	require.Equal(t, premiumPlan.PlanPolicy.TotalCuLimit, sub.MonthCuLeft)
+	require.Equal(t, uint64(0), sub.MonthRewardPaid)
+	require.Equal(t, durationLeft-1, sub.DurationLeft)
+}
+
+func TestSubscriptionTransfer(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(3, 0, 1) // 3 sub, 0 adm, 1 dev
+
+	_, sub1Addr := ts.Account("sub1")
+	_, sub2Addr := ts.Account("sub2")
+	_, sub3Addr := ts.Account("sub3")
+	_, dev1Addr := ts.Account("dev1")
+	plan := ts.Plan("free")
+
+	_, err := ts.TxSubscriptionBuy(sub1Addr, sub1Addr, plan.Index, 3, false, false)
+	require.NoError(t, err)
+	_, err = ts.TxSubscriptionBuy(sub3Addr, sub3Addr, plan.Index, 1, false, false)
+	require.NoError(t, err)
+
+	projectData := projectstypes.ProjectData{
+		Name:        "myproj",
+		Enabled:     true,
+		ProjectKeys: []projectstypes.ProjectKey{projectstypes.ProjectDeveloperKey(dev1Addr)},
+	}
+	err = ts.TxSubscriptionAddProject(sub1Addr, projectData)
+	require.NoError(t, err)
+
+	err = ts.TxSubscriptionDepositOveruse(sub1Addr, sub1Addr, 1000, 50)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	sub := getSubscriptionAndFailTestIfNotFound(t, ts, sub1Addr)
+
+	// not the creator nor the consumer
+	err = ts.TxSubscriptionTransfer(sub2Addr, sub1Addr, sub2Addr)
+	require.Error(t, err)
+
+	// the new consumer already has a subscription
+	err = ts.TxSubscriptionTransfer(sub1Addr, sub1Addr, sub3Addr)
+	require.Error(t, err)
+
+	err = ts.TxSubscriptionTransfer(sub1Addr, sub1Addr, sub2Addr)
+	require.NoError(t, err)
+
+	// the new consumer's subscription is effective immediately
+	newSub := getSubscriptionAndFailTestIfNotFound(t, ts, sub2Addr)
+	require.Equal(t, sub1Addr, newSub.Creator)
+	require.Equal(t, sub.PlanIndex, newSub.PlanIndex)
+	require.Equal(t, sub.DurationLeft, newSub.DurationLeft)
+	require.Equal(t, sub.MonthExpiryTime, newSub.MonthExpiryTime)
+	require.Equal(t, sub.OveruseDeposit, newSub.OveruseDeposit)
+	require.Equal(t, plan.Price.Amount.Uint64(), newSub.MonthRewardPaid)
+
+	// the projects move on the next epoch
+	ts.AdvanceEpoch()
+	_, found := ts.getSubscription(sub1Addr)
+	require.False(t, found)
+
+	res, err := ts.QueryProjectDeveloper(dev1Addr)
+	require.NoError(t, err)
+	require.Equal(t, projectstypes.ProjectIndex(sub2Addr, projectData.Name), res.Project.Index)
+
+	res, err = ts.QueryProjectDeveloper(sub2Addr)
+	require.NoError(t, err)
+	require.Equal(t, projectstypes.ProjectIndex(sub2Addr, projectstypes.ADMIN_PROJECT_NAME), res.Project.Index)
+
+	_, err = ts.QueryProjectDeveloper(sub1Addr)
+	require.Error(t, err)
+
+	// the new consumer's subscription keeps renewing the months
+	ts.AdvanceMonths(1).AdvanceEpoch()
+	newSub = getSubscriptionAndFailTestIfNotFound(t, ts, sub2Addr)
+	require.Equal(t, sub.DurationLeft-1, newSub.DurationLeft)
+	require.Equal(t, uint64(0), newSub.MonthRewardPaid)
+}
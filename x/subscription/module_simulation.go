@@ -41,6 +41,22 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgAutoRenewal int = 100
 
+	opWeightMsgDepositOveruse = "op_weight_msg_deposit_overuse"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDepositOveruse int = 100
+
+	opWeightMsgTransfer = "op_weight_msg_transfer"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransfer int = 100
+
+	opWeightMsgCancel = "op_weight_msg_cancel"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancel int = 100
+
+	opWeightMsgUpgrade = "op_weight_msg_upgrade"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpgrade int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -113,6 +129,50 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		subscriptionsimulation.SimulateMsgAutoRenewal(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgDepositOveruse int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDepositOveruse, &weightMsgDepositOveruse, nil,
+		func(_ *rand.Rand) {
+			weightMsgDepositOveruse = defaultWeightMsgDepositOveruse
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDepositOveruse,
+		subscriptionsimulation.SimulateMsgDepositOveruse(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTransfer int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTransfer, &weightMsgTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransfer = defaultWeightMsgTransfer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransfer,
+		subscriptionsimulation.SimulateMsgTransfer(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCancel int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCancel, &weightMsgCancel, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancel = defaultWeightMsgCancel
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancel,
+		subscriptionsimulation.SimulateMsgCancel(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpgrade int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUpgrade, &weightMsgUpgrade, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpgrade = defaultWeightMsgUpgrade
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpgrade,
+		subscriptionsimulation.SimulateMsgUpgrade(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -14,6 +14,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddProject{}, "subscription/AddProject", nil)
 	cdc.RegisterConcrete(&MsgDelProject{}, "subscription/DelProject", nil)
 	cdc.RegisterConcrete(&MsgAutoRenewal{}, "subscription/AutoRenewal", nil)
+	cdc.RegisterConcrete(&MsgDepositOveruse{}, "subscription/DepositOveruse", nil)
+	cdc.RegisterConcrete(&MsgTransfer{}, "subscription/Transfer", nil)
+	cdc.RegisterConcrete(&MsgCancel{}, "subscription/Cancel", nil)
+	cdc.RegisterConcrete(&MsgUpgrade{}, "subscription/Upgrade", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +34,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAutoRenewal{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDepositOveruse{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransfer{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancel{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpgrade{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancel
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgCancel{
+				Creator:  "invalid_address",
+				Consumer: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "consumer invalid address",
+			msg: MsgCancel{
+				Creator:  sample.AccAddress(),
+				Consumer: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid",
+			msg: MsgCancel{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
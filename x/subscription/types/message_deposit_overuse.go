@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDepositOveruse = "deposit_overuse"
+
+var _ sdk.Msg = &MsgDepositOveruse{}
+
+func NewMsgDepositOveruse(creator, consumer string, deposit sdk.Coin, cuCap uint64) *MsgDepositOveruse {
+	return &MsgDepositOveruse{
+		Creator:  creator,
+		Consumer: consumer,
+		Deposit:  deposit,
+		CuCap:    cuCap,
+	}
+}
+
+func (msg *MsgDepositOveruse) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositOveruse) Type() string {
+	return TypeMsgDepositOveruse
+}
+
+func (msg *MsgDepositOveruse) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDepositOveruse) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDepositOveruse) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	if !msg.Deposit.IsValid() {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidCoins, "invalid deposit (%s)", msg.Deposit)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDepositOveruse_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDepositOveruse
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgDepositOveruse{
+				Creator:  "invalid_address",
+				Consumer: sample.AccAddress(),
+				Deposit:  sdk.NewCoin("ulava", math.NewInt(100)),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "consumer invalid address",
+			msg: MsgDepositOveruse{
+				Creator:  sample.AccAddress(),
+				Consumer: "invalid_address",
+				Deposit:  sdk.NewCoin("ulava", math.NewInt(100)),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid deposit",
+			msg: MsgDepositOveruse{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Deposit:  sdk.Coin{Denom: "ulava", Amount: math.NewInt(-1)},
+			},
+			err: legacyerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid",
+			msg: MsgDepositOveruse{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Deposit:  sdk.NewCoin("ulava", math.NewInt(100)),
+				CuCap:    1000,
+			},
+		},
+		{
+			name: "valid zero deposit",
+			msg: MsgDepositOveruse{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Deposit:  sdk.NewCoin("ulava", math.ZeroInt()),
+				CuCap:    1000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransfer = "transfer"
+
+var _ sdk.Msg = &MsgTransfer{}
+
+func NewMsgTransfer(creator, consumer, newConsumer string) *MsgTransfer {
+	return &MsgTransfer{
+		Creator:     creator,
+		Consumer:    consumer,
+		NewConsumer: newConsumer,
+	}
+}
+
+func (msg *MsgTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransfer) Type() string {
+	return TypeMsgTransfer
+}
+
+func (msg *MsgTransfer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.NewConsumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid new consumer address (%s)", err)
+	}
+
+	if msg.Consumer == msg.NewConsumer {
+		return sdkerrors.Wrapf(ErrInvalidParameter, "can't transfer a subscription to its own consumer")
+	}
+
+	return nil
+}
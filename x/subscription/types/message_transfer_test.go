@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransfer_ValidateBasic(t *testing.T) {
+	consumer := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgTransfer
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgTransfer{
+				Creator:     "invalid_address",
+				Consumer:    consumer,
+				NewConsumer: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "consumer invalid address",
+			msg: MsgTransfer{
+				Creator:     sample.AccAddress(),
+				Consumer:    "invalid_address",
+				NewConsumer: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "new consumer invalid address",
+			msg: MsgTransfer{
+				Creator:     sample.AccAddress(),
+				Consumer:    consumer,
+				NewConsumer: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "transfer to self",
+			msg: MsgTransfer{
+				Creator:     consumer,
+				Consumer:    consumer,
+				NewConsumer: consumer,
+			},
+			err: ErrInvalidParameter,
+		},
+		{
+			name: "valid",
+			msg: MsgTransfer{
+				Creator:     consumer,
+				Consumer:    consumer,
+				NewConsumer: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
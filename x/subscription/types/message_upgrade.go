@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpgrade = "upgrade"
+
+var _ sdk.Msg = &MsgUpgrade{}
+
+func NewMsgUpgrade(creator, consumer, planIndex string) *MsgUpgrade {
+	return &MsgUpgrade{
+		Creator:  creator,
+		Consumer: consumer,
+		Index:    planIndex,
+	}
+}
+
+func (msg *MsgUpgrade) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpgrade) Type() string {
+	return TypeMsgUpgrade
+}
+
+func (msg *MsgUpgrade) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpgrade) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	if strings.TrimSpace(msg.Index) == "" {
+		return sdkerrors.Wrapf(ErrBlankParameter, "invalid plan index (%s)", msg.Index)
+	}
+
+	return nil
+}
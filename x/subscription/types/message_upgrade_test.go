@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpgrade_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpgrade
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgUpgrade{
+				Creator:  "invalid_address",
+				Consumer: sample.AccAddress(),
+				Index:    "premium",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "consumer invalid address",
+			msg: MsgUpgrade{
+				Creator:  sample.AccAddress(),
+				Consumer: "invalid_address",
+				Index:    "premium",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "blank plan index",
+			msg: MsgUpgrade{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Index:    " ",
+			},
+			err: ErrBlankParameter,
+		},
+		{
+			name: "valid",
+			msg: MsgUpgrade{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Index:    "premium",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
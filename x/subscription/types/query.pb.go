@@ -381,6 +381,9 @@ type ListInfoStruct struct {
 	DurationTotal       uint64              `protobuf:"varint,9,opt,name=duration_total,json=durationTotal,proto3" json:"duration_total,omitempty"`
 	AutoRenewalNextPlan string              `protobuf:"bytes,11,opt,name=auto_renewal_next_plan,json=autoRenewalNextPlan,proto3" json:"auto_renewal_next_plan,omitempty"`
 	FutureSubscription  *FutureSubscription `protobuf:"bytes,12,opt,name=future_subscription,json=futureSubscription,proto3" json:"future_subscription,omitempty"`
+	OveruseDeposit      uint64              `protobuf:"varint,13,opt,name=overuse_deposit,json=overuseDeposit,proto3" json:"overuse_deposit,omitempty"`
+	OveruseCuCap        uint64              `protobuf:"varint,14,opt,name=overuse_cu_cap,json=overuseCuCap,proto3" json:"overuse_cu_cap,omitempty"`
+	MonthCuOveruse      uint64              `protobuf:"varint,15,opt,name=month_cu_overuse,json=monthCuOveruse,proto3" json:"month_cu_overuse,omitempty"`
 }
 
 func (m *ListInfoStruct) Reset()         { *m = ListInfoStruct{} }
@@ -493,6 +496,27 @@ func (m *ListInfoStruct) GetFutureSubscription() *FutureSubscription {
 	return nil
 }
 
+func (m *ListInfoStruct) GetOveruseDeposit() uint64 {
+	if m != nil {
+		return m.OveruseDeposit
+	}
+	return 0
+}
+
+func (m *ListInfoStruct) GetOveruseCuCap() uint64 {
+	if m != nil {
+		return m.OveruseCuCap
+	}
+	return 0
+}
+
+func (m *ListInfoStruct) GetMonthCuOveruse() uint64 {
+	if m != nil {
+		return m.MonthCuOveruse
+	}
+	return 0
+}
+
 type QueryNextToMonthExpiryRequest struct {
 }
 
@@ -645,62 +669,65 @@ func init() {
 }
 
 var fileDescriptor_e870698c9d8ccc09 = []byte{
-	// 876 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x51, 0x6f, 0xdb, 0x54,
-	0x14, 0xae, 0xdb, 0x2c, 0x4d, 0x4f, 0xd2, 0x6e, 0xbb, 0xad, 0x90, 0x67, 0x41, 0xda, 0x7a, 0x8c,
-	0x6e, 0xa3, 0x8b, 0x95, 0x16, 0x54, 0xf6, 0xc2, 0xa4, 0x56, 0x4c, 0x02, 0x15, 0x14, 0xb2, 0x6a,
-	0x48, 0x3c, 0x60, 0x39, 0xe6, 0x26, 0x35, 0x72, 0x7c, 0x3d, 0xdf, 0x7b, 0x47, 0xaa, 0x69, 0x2f,
-	0x3c, 0xf2, 0x84, 0xe0, 0x17, 0xf0, 0x37, 0x78, 0xe3, 0x6d, 0x4f, 0x68, 0x12, 0x0f, 0xf0, 0x84,
-	0x50, 0xcb, 0x0f, 0x41, 0xf7, 0xf8, 0x3a, 0xd8, 0x4b, 0xe3, 0x84, 0x3d, 0xc5, 0xf7, 0xf8, 0x3b,
-	0xdf, 0xf7, 0x9d, 0x73, 0x8f, 0x8f, 0x02, 0xb7, 0x42, 0xef, 0xa9, 0x17, 0x51, 0xe1, 0xa8, 0x5f,
-	0x87, 0xcb, 0x1e, 0xf7, 0x93, 0x20, 0x16, 0x01, 0x8b, 0x9c, 0x27, 0x92, 0x26, 0x67, 0xad, 0x38,
-	0x61, 0x82, 0x91, 0x1b, 0x1a, 0xd6, 0x52, 0xbf, 0xad, 0x3c, 0xcc, 0xda, 0x18, 0xb0, 0x01, 0x43,
-	0x94, 0xa3, 0x9e, 0xd2, 0x04, 0xeb, 0xcd, 0x01, 0x63, 0x83, 0x90, 0x3a, 0x5e, 0x1c, 0x38, 0x5e,
-	0x14, 0x31, 0xe1, 0x29, 0x30, 0xd7, 0x6f, 0xef, 0xfa, 0x8c, 0x0f, 0x19, 0x77, 0x7a, 0x1e, 0xa7,
-	0xa9, 0x8e, 0xf3, 0xb4, 0xdd, 0xa3, 0xc2, 0x6b, 0x3b, 0xb1, 0x37, 0x08, 0x22, 0x04, 0x6b, 0xec,
-	0x3b, 0xd3, 0x1d, 0xc6, 0x5e, 0xe2, 0x0d, 0x33, 0xce, 0xdd, 0xe9, 0xb8, 0xfc, 0x21, 0x45, 0xdb,
-	0x1b, 0x40, 0x3e, 0x57, 0xba, 0x1d, 0xa4, 0xe8, 0xd2, 0x27, 0x92, 0x72, 0x61, 0x3f, 0x86, 0xf5,
-	0x42, 0x94, 0xc7, 0x2c, 0xe2, 0x94, 0x3c, 0x80, 0x6a, 0x2a, 0x65, 0x1a, 0x5b, 0xc6, 0xed, 0xfa,
-	0xde, 0x76, 0x6b, 0x6a, 0x3b, 0x5a, 0x69, 0xea, 0x61, 0xe5, 0xc5, 0x5f, 0x9b, 0x0b, 0x5d, 0x9d,
-	0x66, 0xb7, 0x35, 0xef, 0x91, 0x4c, 0x12, 0x1a, 0x09, 0x2d, 0x47, 0x2c, 0xa8, 0xf9, 0x2c, 0xe2,
-	0x72, 0x48, 0x13, 0x64, 0x5e, 0xe9, 0x8e, 0xcf, 0xf6, 0x17, 0xb0, 0x51, 0x4c, 0x19, 0x7b, 0x59,
-	0xe2, 0xb2, 0xa7, 0x8d, 0xec, 0x94, 0x18, 0x79, 0x94, 0x3b, 0xa0, 0x1d, 0xa3, 0xab, 0x32, 0xed,
-	0x0f, 0xc1, 0x44, 0xe2, 0xe3, 0x80, 0x8b, 0x4e, 0xc2, 0xbe, 0xa1, 0xbe, 0xc8, 0xea, 0x27, 0x36,
-	0x34, 0xf2, 0x1c, 0xda, 0x54, 0x21, 0x66, 0x1f, 0xc0, 0x8d, 0x4b, 0xf2, 0xb5, 0x3b, 0x0b, 0x6a,
-	0xb1, 0x8e, 0x99, 0xc6, 0xd6, 0x92, 0xaa, 0x28, 0x3b, 0xdb, 0x04, 0xae, 0x8d, 0x13, 0xb3, 0x86,
-	0x7b, 0x70, 0x3d, 0x17, 0xd3, 0x24, 0xc7, 0xb0, 0xa2, 0x14, 0xdd, 0x20, 0xea, 0x33, 0x64, 0xa9,
-	0xef, 0xdd, 0x29, 0x29, 0x54, 0xe5, 0x7e, 0x1c, 0xf5, 0xd9, 0x23, 0x91, 0x48, 0x5f, 0xe8, 0xce,
-	0xd7, 0x14, 0x44, 0x45, 0xed, 0x3f, 0x96, 0x60, 0xad, 0x08, 0x29, 0xeb, 0x3b, 0x21, 0x50, 0x89,
-	0x43, 0x2f, 0x32, 0x17, 0x31, 0x8e, 0xcf, 0x64, 0x07, 0xae, 0x7e, 0x2d, 0x13, 0x1c, 0x4a, 0xb7,
-	0xc7, 0xe4, 0xe0, 0x54, 0x98, 0x4b, 0x5b, 0xc6, 0xed, 0x4a, 0x77, 0x2d, 0x0b, 0x1f, 0x62, 0x94,
-	0xdc, 0x84, 0xd5, 0x31, 0x30, 0xa4, 0x7d, 0x61, 0x56, 0x10, 0xd6, 0xc8, 0x82, 0xc7, 0xb4, 0x2f,
-	0xc8, 0x36, 0x34, 0x86, 0x2c, 0x12, 0xa7, 0x2e, 0x1d, 0xc5, 0x41, 0x72, 0x66, 0x5e, 0x41, 0x4c,
-	0x1d, 0x63, 0x1f, 0x61, 0x88, 0xbc, 0x0d, 0x6b, 0x29, 0xc4, 0x97, 0xae, 0x60, 0xc2, 0x0b, 0xcd,
-	0x6a, 0x4a, 0x84, 0xd1, 0x23, 0x79, 0xa2, 0x62, 0xc4, 0x86, 0xd5, 0x31, 0x0a, 0xd5, 0x96, 0x73,
-	0x4c, 0x47, 0x12, 0xc5, 0x4c, 0x58, 0xf6, 0x43, 0xc9, 0x05, 0x4d, 0xcc, 0x1a, 0x56, 0x94, 0x1d,
-	0xc9, 0x2d, 0x18, 0xbb, 0xd7, 0x1a, 0x2b, 0x98, 0x3e, 0xae, 0x20, 0x15, 0xd9, 0x87, 0x37, 0x3c,
-	0x29, 0x98, 0x9b, 0xd0, 0x88, 0x7e, 0xeb, 0x85, 0x6e, 0x44, 0x47, 0xc2, 0xc5, 0x0e, 0xd5, 0x91,
-	0x6f, 0x5d, 0xbd, 0xed, 0xa6, 0x2f, 0x3f, 0xa3, 0x23, 0xd1, 0x51, 0x0d, 0xfb, 0x0a, 0xd6, 0xfb,
-	0x52, 0xc8, 0x84, 0xba, 0x85, 0x71, 0x6a, 0xe0, 0xd0, 0xde, 0x2b, 0xb9, 0xcb, 0x87, 0x98, 0x95,
-	0x1f, 0xdd, 0x2e, 0xe9, 0x4f, 0xc4, 0x3e, 0xa9, 0xd4, 0xe0, 0x5a, 0xdd, 0xde, 0x84, 0xb7, 0x70,
-	0x78, 0x94, 0xec, 0x09, 0xfb, 0xf4, 0xbf, 0xfe, 0x65, 0xd3, 0xd5, 0x81, 0xab, 0x27, 0xc1, 0x90,
-	0x26, 0x69, 0x54, 0x0d, 0x40, 0xe9, 0xd5, 0xbf, 0x7a, 0x31, 0x8b, 0x13, 0x17, 0x63, 0x8f, 0xa0,
-	0x39, 0x4d, 0x52, 0x0f, 0xef, 0x63, 0x58, 0xcd, 0x57, 0xc4, 0xf5, 0x00, 0xdf, 0x2d, 0x29, 0xfa,
-	0x15, 0x8f, 0x7a, 0x82, 0x8b, 0x34, 0x7b, 0xbf, 0x55, 0xe1, 0x0a, 0x4a, 0x93, 0x1f, 0x0d, 0xa8,
-	0xa6, 0x5b, 0x86, 0x94, 0xb5, 0x72, 0x72, 0xbd, 0x59, 0xad, 0x79, 0xe1, 0x69, 0x2d, 0xf6, 0x9d,
-	0xef, 0x7e, 0xff, 0xe7, 0xa7, 0xc5, 0x9b, 0x64, 0xdb, 0x99, 0xb5, 0x83, 0xc9, 0xcf, 0x06, 0x2c,
-	0xeb, 0x55, 0x45, 0x66, 0xca, 0x14, 0xd7, 0xa0, 0xe5, 0xcc, 0x8d, 0xd7, 0xbe, 0xde, 0x47, 0x5f,
-	0x0e, 0xb9, 0x57, 0xe2, 0xcb, 0x4f, 0x73, 0x9c, 0x67, 0xd9, 0xf5, 0x3e, 0x27, 0xbf, 0x18, 0xd0,
-	0xc8, 0x6f, 0x2d, 0xb2, 0x3f, 0x4b, 0xf8, 0x92, 0x1d, 0x69, 0xbd, 0xf7, 0xff, 0x92, 0xb4, 0xe5,
-	0x07, 0x68, 0xf9, 0x3e, 0x39, 0x28, 0xb1, 0x1c, 0x06, 0x5c, 0xb8, 0xd9, 0xba, 0x74, 0x9e, 0xe5,
-	0xdf, 0x3d, 0x27, 0xdf, 0x1b, 0x50, 0x51, 0xcc, 0xe4, 0xdd, 0x79, 0xf4, 0x33, 0xb3, 0xbb, 0xf3,
-	0x81, 0xb5, 0xc9, 0x1d, 0x34, 0xb9, 0x4d, 0x36, 0x67, 0x98, 0x24, 0xbf, 0x1a, 0x70, 0x7d, 0xe2,
-	0x13, 0x20, 0x1f, 0xcc, 0x12, 0x9b, 0xf6, 0xa1, 0x5a, 0xf7, 0x5f, 0x23, 0x53, 0x7b, 0x3e, 0x40,
-	0xcf, 0x6d, 0xe2, 0x94, 0x78, 0xc6, 0x9d, 0x25, 0x98, 0x9b, 0xff, 0xba, 0x0f, 0x1f, 0xbe, 0x38,
-	0x6f, 0x1a, 0x2f, 0xcf, 0x9b, 0xc6, 0xdf, 0xe7, 0x4d, 0xe3, 0x87, 0x8b, 0xe6, 0xc2, 0xcb, 0x8b,
-	0xe6, 0xc2, 0x9f, 0x17, 0xcd, 0x85, 0x2f, 0x77, 0x07, 0x81, 0x38, 0x95, 0xbd, 0x96, 0xcf, 0x86,
-	0x45, 0xd2, 0x51, 0x91, 0x56, 0x9c, 0xc5, 0x94, 0xf7, 0xaa, 0xf8, 0x87, 0x62, 0xff, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x20, 0x4e, 0x3c, 0x4c, 0x4a, 0x09, 0x00, 0x00,
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0xae, 0xe3, 0x3c, 0x3b, 0x3f, 0x3a, 0x89, 0xd0, 0xd6, 0x02, 0x27, 0xd9, 0x52,
+	0x92, 0x96, 0xd4, 0xab, 0x24, 0xa0, 0xd0, 0x0b, 0x95, 0x62, 0xa8, 0x04, 0x0a, 0x10, 0xdc, 0xa8,
+	0x48, 0x1c, 0x58, 0x8d, 0xb7, 0x63, 0x67, 0x91, 0xbd, 0xb3, 0x9d, 0x1f, 0xc1, 0x51, 0xd5, 0x0b,
+	0x47, 0x4e, 0x08, 0xfe, 0x02, 0xfe, 0x0d, 0x6e, 0xdc, 0x7a, 0x42, 0x91, 0xb8, 0x70, 0x42, 0x28,
+	0xe1, 0x0f, 0x41, 0xf3, 0x63, 0xcd, 0x6e, 0x13, 0xaf, 0x43, 0x4f, 0xde, 0xf9, 0xe6, 0x7b, 0xdf,
+	0xfb, 0xe6, 0xcd, 0x9b, 0x27, 0xc3, 0x9d, 0x3e, 0x3e, 0xc1, 0x31, 0x11, 0xbe, 0xfa, 0xf5, 0xb9,
+	0xec, 0xf0, 0x90, 0x45, 0x89, 0x88, 0x68, 0xec, 0x3f, 0x93, 0x84, 0x9d, 0x36, 0x13, 0x46, 0x05,
+	0x45, 0xb7, 0x2c, 0xad, 0xa9, 0x7e, 0x9b, 0x59, 0x5a, 0x7d, 0xa5, 0x47, 0x7b, 0x54, 0xb3, 0x7c,
+	0xf5, 0x65, 0x02, 0xea, 0x6f, 0xf6, 0x28, 0xed, 0xf5, 0x89, 0x8f, 0x93, 0xc8, 0xc7, 0x71, 0x4c,
+	0x05, 0x56, 0x64, 0x6e, 0x77, 0xef, 0x85, 0x94, 0x0f, 0x28, 0xf7, 0x3b, 0x98, 0x13, 0x93, 0xc7,
+	0x3f, 0xd9, 0xee, 0x10, 0x81, 0xb7, 0xfd, 0x04, 0xf7, 0xa2, 0x58, 0x93, 0x2d, 0xf7, 0x9d, 0xf1,
+	0x0e, 0x13, 0xcc, 0xf0, 0x20, 0xd5, 0xdc, 0x1a, 0xcf, 0xcb, 0x2e, 0x0c, 0xdb, 0x5b, 0x01, 0xf4,
+	0xa5, 0xca, 0x7b, 0xa8, 0x25, 0xda, 0xe4, 0x99, 0x24, 0x5c, 0x78, 0x4f, 0x60, 0x39, 0x87, 0xf2,
+	0x84, 0xc6, 0x9c, 0xa0, 0x87, 0x50, 0x36, 0xa9, 0x5c, 0x67, 0xcd, 0xd9, 0xac, 0xee, 0xac, 0x37,
+	0xc7, 0x96, 0xa3, 0x69, 0x42, 0xf7, 0x4b, 0x2f, 0xff, 0x5a, 0x9d, 0x6a, 0xdb, 0x30, 0x6f, 0xdb,
+	0xea, 0xb6, 0x24, 0x63, 0x24, 0x16, 0x36, 0x1d, 0xaa, 0x43, 0x25, 0xa4, 0x31, 0x97, 0x03, 0xc2,
+	0xb4, 0xf2, 0x5c, 0x7b, 0xb4, 0xf6, 0xbe, 0x82, 0x95, 0x7c, 0xc8, 0xc8, 0xcb, 0x0c, 0x97, 0x1d,
+	0x6b, 0x64, 0xa3, 0xc0, 0xc8, 0xe3, 0xcc, 0x42, 0xdb, 0x71, 0xda, 0x2a, 0xd2, 0xfb, 0x10, 0x5c,
+	0x2d, 0x7c, 0x10, 0x71, 0x71, 0xc8, 0xe8, 0xb7, 0x24, 0x14, 0xe9, 0xf9, 0x91, 0x07, 0xb5, 0xac,
+	0x86, 0x35, 0x95, 0xc3, 0xbc, 0x3d, 0xb8, 0x75, 0x45, 0xbc, 0x75, 0x57, 0x87, 0x4a, 0x62, 0x31,
+	0xd7, 0x59, 0x9b, 0x51, 0x27, 0x4a, 0xd7, 0x1e, 0x82, 0xa5, 0x51, 0x60, 0x5a, 0x70, 0x0c, 0x37,
+	0x33, 0x98, 0x15, 0x39, 0x80, 0x39, 0x95, 0x31, 0x88, 0xe2, 0x2e, 0xd5, 0x2a, 0xd5, 0x9d, 0xbb,
+	0x05, 0x07, 0x55, 0xb1, 0x9f, 0xc4, 0x5d, 0xfa, 0x58, 0x30, 0x19, 0x0a, 0x5b, 0xf9, 0x8a, 0xa2,
+	0x28, 0xd4, 0x3b, 0x2b, 0xc1, 0x42, 0x9e, 0x52, 0x54, 0x77, 0x84, 0xa0, 0x94, 0xf4, 0x71, 0xec,
+	0x4e, 0x6b, 0x5c, 0x7f, 0xa3, 0x0d, 0x58, 0x7c, 0x2a, 0x99, 0x6e, 0xca, 0xa0, 0x43, 0x65, 0xef,
+	0x58, 0xb8, 0x33, 0x6b, 0xce, 0x66, 0xa9, 0xbd, 0x90, 0xc2, 0xfb, 0x1a, 0x45, 0xb7, 0x61, 0x7e,
+	0x44, 0xec, 0x93, 0xae, 0x70, 0x4b, 0x9a, 0x56, 0x4b, 0xc1, 0x03, 0xd2, 0x15, 0x68, 0x1d, 0x6a,
+	0x03, 0x1a, 0x8b, 0xe3, 0x80, 0x0c, 0x93, 0x88, 0x9d, 0xba, 0x37, 0x34, 0xa7, 0xaa, 0xb1, 0x8f,
+	0x35, 0x84, 0xde, 0x86, 0x05, 0x43, 0x09, 0x65, 0x20, 0xa8, 0xc0, 0x7d, 0xb7, 0x6c, 0x84, 0x34,
+	0xda, 0x92, 0x47, 0x0a, 0x43, 0x1e, 0xcc, 0x8f, 0x58, 0x3a, 0xdb, 0x6c, 0x46, 0xa9, 0x25, 0x75,
+	0x32, 0x17, 0x66, 0xc3, 0xbe, 0xe4, 0x82, 0x30, 0xb7, 0xa2, 0x4f, 0x94, 0x2e, 0xd1, 0x1d, 0x18,
+	0xb9, 0xb7, 0x39, 0xe6, 0x74, 0xf8, 0xe8, 0x04, 0x26, 0xc9, 0x2e, 0xbc, 0x81, 0xa5, 0xa0, 0x01,
+	0x23, 0x31, 0xf9, 0x0e, 0xf7, 0x83, 0x98, 0x0c, 0x45, 0xa0, 0x2b, 0x54, 0xd5, 0x7a, 0xcb, 0x6a,
+	0xb7, 0x6d, 0x36, 0x3f, 0x27, 0x43, 0x71, 0xa8, 0x0a, 0xf6, 0x0d, 0x2c, 0x77, 0xa5, 0x90, 0x8c,
+	0x04, 0xb9, 0x76, 0xaa, 0xe9, 0xa6, 0xbd, 0x5f, 0x70, 0x97, 0x8f, 0x74, 0x54, 0xb6, 0x75, 0xdb,
+	0xa8, 0x7b, 0x09, 0x53, 0x17, 0x42, 0x4f, 0x08, 0x93, 0x9c, 0x04, 0x4f, 0x49, 0x42, 0x79, 0x24,
+	0xdc, 0x79, 0x73, 0x21, 0x16, 0xfe, 0xc8, 0xa0, 0xaa, 0x90, 0x29, 0x31, 0x94, 0x41, 0x88, 0x13,
+	0x77, 0xc1, 0x14, 0xd2, 0xa2, 0x2d, 0xd9, 0xc2, 0x09, 0xda, 0x84, 0xa5, 0x51, 0x21, 0xed, 0x86,
+	0xbb, 0x68, 0xf4, 0x6c, 0x2d, 0xbf, 0x30, 0xe8, 0xa7, 0xa5, 0x0a, 0x2c, 0x55, 0xbd, 0x55, 0x78,
+	0x4b, 0x77, 0xad, 0x3a, 0xef, 0x11, 0xfd, 0xec, 0xbf, 0x8b, 0x4b, 0xdb, 0xfa, 0x10, 0x16, 0x8f,
+	0xa2, 0x01, 0x61, 0x06, 0x55, 0x9d, 0x57, 0xd8, 0x73, 0xaf, 0x76, 0xc4, 0xf4, 0xa5, 0x8e, 0xf0,
+	0x86, 0xd0, 0x18, 0x97, 0xd2, 0xbe, 0x9a, 0x27, 0x30, 0x9f, 0x2d, 0x25, 0xb7, 0x2f, 0xe7, 0x5e,
+	0x41, 0xb5, 0x5f, 0xf1, 0x68, 0x9f, 0x4e, 0x5e, 0x66, 0xe7, 0xf7, 0x32, 0xdc, 0xd0, 0xa9, 0xd1,
+	0x4f, 0x0e, 0x94, 0xcd, 0x78, 0x43, 0x45, 0x77, 0x78, 0x79, 0xae, 0xd6, 0x9b, 0xd7, 0xa5, 0x9b,
+	0xb3, 0x78, 0x77, 0xbf, 0xff, 0xe3, 0x9f, 0x9f, 0xa7, 0x6f, 0xa3, 0x75, 0x7f, 0xd2, 0xf0, 0x47,
+	0xbf, 0x38, 0x30, 0x6b, 0x67, 0x24, 0x9a, 0x98, 0x26, 0x3f, 0x7f, 0xeb, 0xfe, 0xb5, 0xf9, 0xd6,
+	0xd7, 0xfb, 0xda, 0x97, 0x8f, 0xee, 0x17, 0xf8, 0x0a, 0x4d, 0x8c, 0xff, 0x3c, 0xbd, 0xde, 0x17,
+	0xe8, 0x57, 0x07, 0x6a, 0xd9, 0x71, 0x89, 0x76, 0x27, 0x25, 0xbe, 0x62, 0x38, 0xd7, 0xdf, 0xfb,
+	0x7f, 0x41, 0xd6, 0xf2, 0x43, 0x6d, 0xf9, 0x01, 0xda, 0x2b, 0xb0, 0xdc, 0x8f, 0xb8, 0x08, 0xd2,
+	0x39, 0xed, 0x3f, 0xcf, 0xee, 0xbd, 0x40, 0x3f, 0x38, 0x50, 0x52, 0xca, 0xe8, 0xdd, 0xeb, 0xe4,
+	0x4f, 0xcd, 0x6e, 0x5d, 0x8f, 0x6c, 0x4d, 0x6e, 0x68, 0x93, 0xeb, 0x68, 0x75, 0x82, 0x49, 0xf4,
+	0x9b, 0x03, 0x37, 0x2f, 0x3d, 0x01, 0xf4, 0xc1, 0xa4, 0x64, 0xe3, 0x1e, 0x6a, 0xfd, 0xc1, 0x6b,
+	0x44, 0x5a, 0xcf, 0x7b, 0xda, 0xf3, 0x36, 0xf2, 0x0b, 0x3c, 0xeb, 0x61, 0x29, 0x68, 0x90, 0x7d,
+	0xdd, 0xfb, 0x8f, 0x5e, 0x9e, 0x37, 0x9c, 0xb3, 0xf3, 0x86, 0xf3, 0xf7, 0x79, 0xc3, 0xf9, 0xf1,
+	0xa2, 0x31, 0x75, 0x76, 0xd1, 0x98, 0xfa, 0xf3, 0xa2, 0x31, 0xf5, 0xf5, 0x56, 0x2f, 0x12, 0xc7,
+	0xb2, 0xd3, 0x0c, 0xe9, 0x20, 0x2f, 0x3a, 0xcc, 0xcb, 0x8a, 0xd3, 0x84, 0xf0, 0x4e, 0x59, 0xff,
+	0x93, 0xd9, 0xfd, 0x77, 0x00, 0x1f, 0xca, 0x3f, 0x96, 0xc3, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1200,6 +1227,21 @@ func (m *ListInfoStruct) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MonthCuOveruse != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MonthCuOveruse))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OveruseCuCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OveruseCuCap))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.OveruseDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OveruseDeposit))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.FutureSubscription != nil {
 		{
 			size, err := m.FutureSubscription.MarshalToSizedBuffer(dAtA[:i])
@@ -1521,6 +1563,15 @@ func (m *ListInfoStruct) Size() (n int) {
 		l = m.FutureSubscription.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.OveruseDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.OveruseDeposit))
+	}
+	if m.OveruseCuCap != 0 {
+		n += 1 + sovQuery(uint64(m.OveruseCuCap))
+	}
+	if m.MonthCuOveruse != 0 {
+		n += 1 + sovQuery(uint64(m.MonthCuOveruse))
+	}
 	return n
 }
 
@@ -2476,6 +2527,63 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseDeposit", wireType)
+			}
+			m.OveruseDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseCuCap", wireType)
+			}
+			m.OveruseCuCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseCuCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuOveruse", wireType)
+			}
+			m.MonthCuOveruse = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCuOveruse |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
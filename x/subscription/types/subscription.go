@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	planstypes "github.com/lavanet/lava/x/plans/types"
 )
 
 const (
@@ -43,3 +45,32 @@ func (sub Subscription) ValidateSubscription() error {
 func (sub Subscription) IsAutoRenewalOn() bool {
 	return sub.AutoRenewalNextPlan != AUTO_RENEWAL_PLAN_NONE
 }
+
+// OveruseCuLeft returns the CU the subscription can still use beyond its monthly
+// allowance, limited by both the overuse cap and the deposit
+func (sub Subscription) OveruseCuLeft(plan planstypes.Plan) uint64 {
+	if !plan.AllowOveruse || plan.OveruseRate == 0 || sub.MonthCuOveruse >= sub.OveruseCuCap {
+		return 0
+	}
+
+	cuLeft := sub.OveruseCuCap - sub.MonthCuOveruse
+	if cuByDeposit := sub.OveruseDeposit / plan.OveruseRate; cuByDeposit < cuLeft {
+		cuLeft = cuByDeposit
+	}
+	return cuLeft
+}
+
+// OverusePayment returns the amount charged for the CU overuse of the current month
+func (sub Subscription) OverusePayment(plan planstypes.Plan) math.Int {
+	return math.NewIntFromUint64(sub.MonthCuOveruse).MulRaw(int64(plan.OveruseRate))
+}
+
+// MonthReward returns the part of the plan price to reward for the current month
+// (the plan price minus what was already rewarded before a mid-month upgrade or transfer)
+func (sub Subscription) MonthReward(plan planstypes.Plan) math.Int {
+	paid := math.NewIntFromUint64(sub.MonthRewardPaid)
+	if plan.Price.Amount.LTE(paid) {
+		return math.ZeroInt()
+	}
+	return plan.Price.Amount.Sub(paid)
+}
@@ -37,6 +37,10 @@ type Subscription struct {
 	DurationTotal       uint64              `protobuf:"varint,14,opt,name=duration_total,json=durationTotal,proto3" json:"duration_total,omitempty"`
 	FutureSubscription  *FutureSubscription `protobuf:"bytes,16,opt,name=future_subscription,json=futureSubscription,proto3" json:"future_subscription,omitempty"`
 	AutoRenewalNextPlan string              `protobuf:"bytes,17,opt,name=auto_renewal_next_plan,json=autoRenewalNextPlan,proto3" json:"auto_renewal_next_plan,omitempty"`
+	OveruseDeposit      uint64              `protobuf:"varint,18,opt,name=overuse_deposit,json=overuseDeposit,proto3" json:"overuse_deposit,omitempty"`
+	OveruseCuCap        uint64              `protobuf:"varint,19,opt,name=overuse_cu_cap,json=overuseCuCap,proto3" json:"overuse_cu_cap,omitempty"`
+	MonthCuOveruse      uint64              `protobuf:"varint,20,opt,name=month_cu_overuse,json=monthCuOveruse,proto3" json:"month_cu_overuse,omitempty"`
+	MonthRewardPaid     uint64              `protobuf:"varint,21,opt,name=month_reward_paid,json=monthRewardPaid,proto3" json:"month_reward_paid,omitempty"`
 }
 
 func (m *Subscription) Reset()         { *m = Subscription{} }
@@ -170,6 +174,34 @@ func (m *Subscription) GetAutoRenewalNextPlan() string {
 	return ""
 }
 
+func (m *Subscription) GetOveruseDeposit() uint64 {
+	if m != nil {
+		return m.OveruseDeposit
+	}
+	return 0
+}
+
+func (m *Subscription) GetOveruseCuCap() uint64 {
+	if m != nil {
+		return m.OveruseCuCap
+	}
+	return 0
+}
+
+func (m *Subscription) GetMonthCuOveruse() uint64 {
+	if m != nil {
+		return m.MonthCuOveruse
+	}
+	return 0
+}
+
+func (m *Subscription) GetMonthRewardPaid() uint64 {
+	if m != nil {
+		return m.MonthRewardPaid
+	}
+	return 0
+}
+
 type FutureSubscription struct {
 	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	PlanIndex      string `protobuf:"bytes,2,opt,name=plan_index,json=planIndex,proto3" json:"plan_index,omitempty"`
@@ -248,37 +280,41 @@ func init() {
 }
 
 var fileDescriptor_c3bc5507ca237d79 = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x5b, 0xb7, 0x4d, 0x26, 0x7f, 0xee, 0x16, 0xa1, 0x05, 0x09, 0x2b, 0x0a, 0x20, 0x22,
-	0x54, 0x1c, 0x89, 0xbe, 0x41, 0x10, 0x95, 0x88, 0x10, 0x42, 0xa1, 0x27, 0x0e, 0x58, 0xb6, 0xbb,
-	0x69, 0x2c, 0x6c, 0xaf, 0xb5, 0x9e, 0x05, 0xf7, 0x2d, 0xb8, 0xf0, 0x46, 0x1c, 0x38, 0xf6, 0xc8,
-	0x11, 0x25, 0x2f, 0x82, 0x76, 0x9c, 0x58, 0x89, 0x0a, 0x15, 0x27, 0x6b, 0xbe, 0x1f, 0xcf, 0xee,
-	0xce, 0x37, 0x70, 0x9a, 0x04, 0x5f, 0x82, 0x4c, 0xe0, 0xd8, 0x7c, 0xc7, 0x85, 0x0e, 0x8b, 0x48,
-	0xc5, 0x39, 0xc6, 0x32, 0xdb, 0x29, 0xbc, 0x5c, 0x49, 0x94, 0xec, 0xc1, 0x5a, 0xed, 0x99, 0xaf,
-	0xb7, 0x2d, 0x18, 0xfe, 0xb0, 0xa1, 0xf3, 0x61, 0x0b, 0x60, 0x1c, 0x8e, 0x22, 0x25, 0x02, 0x94,
-	0x8a, 0x5b, 0x03, 0x6b, 0xd4, 0x9a, 0x6d, 0x4a, 0xf6, 0x10, 0x9a, 0x91, 0xcc, 0x0a, 0x9d, 0x0a,
-	0xc5, 0xf7, 0x88, 0xaa, 0x6b, 0x76, 0x0f, 0x0e, 0xc2, 0x44, 0x46, 0x9f, 0xf9, 0xfe, 0xc0, 0x1a,
-	0xd9, 0xb3, 0xaa, 0x60, 0x8f, 0x00, 0xf2, 0x24, 0xc8, 0xfc, 0x38, 0xbb, 0x14, 0x25, 0xb7, 0xc9,
-	0xd3, 0x32, 0xc8, 0x1b, 0x03, 0xd4, 0x74, 0xe5, 0x3c, 0x20, 0x27, 0xd1, 0x13, 0x72, 0x3f, 0x83,
-	0xfe, 0xa5, 0x56, 0x81, 0x39, 0x95, 0x1f, 0x4a, 0x7d, 0xb5, 0x40, 0x7e, 0x48, 0x9a, 0xde, 0x06,
-	0x9e, 0x10, 0xca, 0x1e, 0x43, 0xb7, 0x16, 0x26, 0x62, 0x8e, 0xfc, 0x88, 0x64, 0x9d, 0x0d, 0xf8,
-	0x56, 0xcc, 0x91, 0x3d, 0x87, 0xe3, 0x54, 0x66, 0xb8, 0xf0, 0x45, 0x99, 0xc7, 0xea, 0xda, 0xc7,
-	0x38, 0x15, 0xbc, 0x49, 0xc2, 0x3e, 0x11, 0xaf, 0x09, 0xbf, 0x88, 0x53, 0xc1, 0x9e, 0x40, 0xaf,
-	0xd2, 0x46, 0xda, 0x47, 0x89, 0x41, 0xc2, 0xa1, 0xfa, 0x23, 0xa1, 0xaf, 0xf4, 0x85, 0xc1, 0xd8,
-	0x10, 0xba, 0xb5, 0x8a, 0xda, 0xb6, 0x49, 0xd4, 0x5e, 0x8b, 0xa8, 0xab, 0x79, 0xcd, 0x44, 0x17,
-	0x28, 0x14, 0xef, 0xae, 0x5f, 0xb3, 0x2a, 0xd9, 0x53, 0xa8, 0xaf, 0xb1, 0xee, 0xd1, 0x23, 0x7b,
-	0x7d, 0x95, 0xaa, 0xc9, 0x27, 0x38, 0x99, 0x6b, 0xd4, 0x4a, 0xf8, 0xdb, 0x63, 0xe3, 0xce, 0xc0,
-	0x1a, 0xb5, 0x5f, 0xbe, 0xf0, 0xfe, 0x39, 0x58, 0xef, 0x9c, 0x5c, 0xdb, 0xa3, 0x9d, 0xb1, 0xf9,
-	0x2d, 0x8c, 0x9d, 0xc1, 0xfd, 0x40, 0xa3, 0xf4, 0x95, 0xc8, 0xc4, 0xd7, 0x20, 0xf1, 0x33, 0x51,
-	0xa2, 0x6f, 0x66, 0xc0, 0x8f, 0xe9, 0xbc, 0x27, 0x86, 0x9d, 0x55, 0xe4, 0x3b, 0x51, 0xe2, 0xfb,
-	0x24, 0xc8, 0xa6, 0x76, 0xb3, 0xe5, 0xc0, 0xd4, 0x6e, 0x76, 0x9c, 0xee, 0xd4, 0x6e, 0xf6, 0x1d,
-	0x67, 0xf8, 0xdd, 0x02, 0x76, 0xbb, 0xe3, 0x1d, 0x61, 0xda, 0x8d, 0xc6, 0xde, 0xdd, 0xd1, 0xd8,
-	0xff, 0x8f, 0x68, 0xd8, 0x7f, 0x8b, 0xc6, 0xe4, 0xfc, 0xe7, 0xd2, 0xb5, 0x6e, 0x96, 0xae, 0xf5,
-	0x7b, 0xe9, 0x5a, 0xdf, 0x56, 0x6e, 0xe3, 0x66, 0xe5, 0x36, 0x7e, 0xad, 0xdc, 0xc6, 0xc7, 0xd3,
-	0xab, 0x18, 0x17, 0x3a, 0xf4, 0x22, 0x99, 0x8e, 0x77, 0x96, 0xa9, 0xdc, 0x5d, 0x27, 0xbc, 0xce,
-	0x45, 0x11, 0x1e, 0xd2, 0x22, 0x9d, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0xad, 0xec, 0x4c, 0x89,
-	0x78, 0x03, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc7, 0x9b, 0x2d, 0xdd, 0x5a, 0xf7, 0x5f, 0xe6, 0xee, 0xf7, 0x93, 0x41, 0x22, 0xaa, 0x0a,
+	0x88, 0x0a, 0x8d, 0x54, 0x62, 0x6f, 0xd0, 0xc2, 0x24, 0x2a, 0x04, 0x53, 0xd9, 0x15, 0x17, 0x58,
+	0x6e, 0xe2, 0xae, 0x16, 0x69, 0x1c, 0x39, 0xf6, 0xd6, 0xbd, 0x05, 0x37, 0xbc, 0x13, 0x97, 0xbb,
+	0xe4, 0x12, 0xb5, 0x57, 0xbc, 0x05, 0xb2, 0x9d, 0x86, 0x54, 0x83, 0x89, 0xab, 0xe8, 0x7c, 0xce,
+	0xf7, 0xe4, 0xd8, 0xe7, 0x7b, 0x0c, 0x4e, 0x62, 0x72, 0x45, 0x12, 0x2a, 0x87, 0xfa, 0x3b, 0xcc,
+	0xd4, 0x2c, 0x0b, 0x05, 0x4b, 0x25, 0xe3, 0xc9, 0x4e, 0x10, 0xa4, 0x82, 0x4b, 0x0e, 0x1f, 0xe4,
+	0xea, 0x40, 0x7f, 0x83, 0xb2, 0xa0, 0xff, 0xb3, 0x0a, 0x9a, 0x1f, 0x4a, 0x00, 0x22, 0x70, 0x18,
+	0x0a, 0x4a, 0x24, 0x17, 0xc8, 0xe9, 0x39, 0x83, 0xfa, 0x74, 0x1b, 0xc2, 0x87, 0xa0, 0x16, 0xf2,
+	0x24, 0x53, 0x4b, 0x2a, 0xd0, 0x9e, 0x49, 0x15, 0x31, 0x3c, 0x06, 0xd5, 0x59, 0xcc, 0xc3, 0xcf,
+	0x68, 0xbf, 0xe7, 0x0c, 0xdc, 0xa9, 0x0d, 0xe0, 0x23, 0x00, 0xd2, 0x98, 0x24, 0x98, 0x25, 0x11,
+	0x5d, 0x21, 0xd7, 0xd4, 0xd4, 0x35, 0x79, 0xa3, 0x41, 0x91, 0xb6, 0x95, 0x55, 0x53, 0x69, 0xd2,
+	0x23, 0x53, 0xfd, 0x0c, 0x74, 0x22, 0x25, 0x88, 0x3e, 0x15, 0x9e, 0x71, 0x75, 0xb9, 0x90, 0xe8,
+	0xc0, 0x68, 0xda, 0x5b, 0x3c, 0x32, 0x14, 0x3e, 0x06, 0xad, 0x42, 0x18, 0xd3, 0xb9, 0x44, 0x87,
+	0x46, 0xd6, 0xdc, 0xc2, 0xb7, 0x74, 0x2e, 0xe1, 0x73, 0x70, 0xb4, 0xe4, 0x89, 0x5c, 0x60, 0xba,
+	0x4a, 0x99, 0xb8, 0xc1, 0x92, 0x2d, 0x29, 0xaa, 0x19, 0x61, 0xc7, 0x24, 0x5e, 0x1b, 0x7e, 0xc1,
+	0x96, 0x14, 0x3e, 0x01, 0x6d, 0xab, 0x0d, 0x15, 0x96, 0x5c, 0x92, 0x18, 0x01, 0xfb, 0x47, 0x43,
+	0xc7, 0xea, 0x42, 0x33, 0xd8, 0x07, 0xad, 0x42, 0x65, 0xda, 0x36, 0x8c, 0xa8, 0x91, 0x8b, 0x4c,
+	0x57, 0x3d, 0xcd, 0x58, 0x65, 0x92, 0x0a, 0xd4, 0xca, 0xa7, 0x69, 0x43, 0xf8, 0x14, 0x14, 0xd7,
+	0xc8, 0x7b, 0xb4, 0x4d, 0x79, 0x71, 0x15, 0xdb, 0xe4, 0x13, 0xe8, 0xce, 0x95, 0x54, 0x82, 0xe2,
+	0xb2, 0x6d, 0xc8, 0xeb, 0x39, 0x83, 0xc6, 0xcb, 0x17, 0xc1, 0x5f, 0x8d, 0x0d, 0xce, 0x4c, 0x55,
+	0xd9, 0xda, 0x29, 0x9c, 0xdf, 0x61, 0xf0, 0x14, 0xfc, 0x4f, 0x94, 0xe4, 0x58, 0xd0, 0x84, 0x5e,
+	0x93, 0x18, 0x27, 0x74, 0x25, 0xb1, 0xf6, 0x00, 0x1d, 0x99, 0xf3, 0x76, 0x75, 0x76, 0x6a, 0x93,
+	0xef, 0xe8, 0x4a, 0x9e, 0xc7, 0x24, 0xd1, 0xce, 0xf0, 0x2b, 0x2a, 0x54, 0x46, 0x71, 0x44, 0x53,
+	0x9e, 0x31, 0x89, 0xa0, 0x75, 0x26, 0xc7, 0xaf, 0x2c, 0xd5, 0x83, 0xdc, 0x0a, 0x43, 0x85, 0x43,
+	0x92, 0xa2, 0xae, 0x1d, 0x64, 0x4e, 0xc7, 0x6a, 0x4c, 0x52, 0x38, 0x00, 0x5e, 0x31, 0xc8, 0x3c,
+	0x81, 0x8e, 0xed, 0xff, 0xf2, 0x59, 0xbe, 0xb7, 0xf4, 0xb7, 0x89, 0x82, 0x5e, 0x13, 0x11, 0xe1,
+	0x94, 0xb0, 0x08, 0xfd, 0x57, 0x32, 0x71, 0x6a, 0xf8, 0x39, 0x61, 0xd1, 0xc4, 0xad, 0xd5, 0x3d,
+	0x30, 0x71, 0x6b, 0x4d, 0xaf, 0x35, 0x71, 0x6b, 0x1d, 0xcf, 0xeb, 0x7f, 0x75, 0x00, 0xbc, 0x3b,
+	0x96, 0x7b, 0x36, 0x7e, 0x77, 0x7f, 0xf7, 0xee, 0xdf, 0xdf, 0xfd, 0x7f, 0xd8, 0x5f, 0xf7, 0x4f,
+	0xfb, 0x3b, 0x3a, 0xfb, 0xb6, 0xf6, 0x9d, 0xdb, 0xb5, 0xef, 0xfc, 0x58, 0xfb, 0xce, 0x97, 0x8d,
+	0x5f, 0xb9, 0xdd, 0xf8, 0x95, 0xef, 0x1b, 0xbf, 0xf2, 0xf1, 0xe4, 0x92, 0xc9, 0x85, 0x9a, 0x05,
+	0x21, 0x5f, 0x0e, 0x77, 0x5e, 0xfc, 0x6a, 0xf7, 0xcd, 0xcb, 0x9b, 0x94, 0x66, 0xb3, 0x03, 0xf3,
+	0xda, 0x4f, 0x7f, 0x0d, 0x00, 0xa6, 0x2f, 0x2c, 0xa1, 0x1d, 0x04, 0x00, 0x00,
 }
 
 func (m *Subscription) Marshal() (dAtA []byte, err error) {
@@ -301,6 +337,34 @@ func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MonthRewardPaid != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthRewardPaid))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MonthCuOveruse != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthCuOveruse))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.OveruseCuCap != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.OveruseCuCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.OveruseDeposit != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.OveruseDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.AutoRenewalNextPlan) > 0 {
 		i -= len(m.AutoRenewalNextPlan)
 		copy(dAtA[i:], m.AutoRenewalNextPlan)
@@ -507,6 +571,18 @@ func (m *Subscription) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovSubscription(uint64(l))
 	}
+	if m.OveruseDeposit != 0 {
+		n += 2 + sovSubscription(uint64(m.OveruseDeposit))
+	}
+	if m.OveruseCuCap != 0 {
+		n += 2 + sovSubscription(uint64(m.OveruseCuCap))
+	}
+	if m.MonthCuOveruse != 0 {
+		n += 2 + sovSubscription(uint64(m.MonthCuOveruse))
+	}
+	if m.MonthRewardPaid != 0 {
+		n += 2 + sovSubscription(uint64(m.MonthRewardPaid))
+	}
 	return n
 }
 
@@ -916,6 +992,82 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 			}
 			m.AutoRenewalNextPlan = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseDeposit", wireType)
+			}
+			m.OveruseDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseCuCap", wireType)
+			}
+			m.OveruseCuCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseCuCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthCuOveruse", wireType)
+			}
+			m.MonthCuOveruse = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthCuOveruse |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthRewardPaid", wireType)
+			}
+			m.MonthRewardPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthRewardPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubscription(dAtA[iNdEx:])
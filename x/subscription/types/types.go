@@ -11,4 +11,8 @@ const (
 	DelProjectEventName                     = "del_project_to_subscription_event"
 	AddTrackedCuEventName                   = "add_tracked_cu_event"
 	MonthlyCuTrackerProviderRewardEventName = "monthly_cu_tracker_provider_reward"
+	DepositOveruseEventName                 = "deposit_overuse_event"
+	RefundOveruseDepositEventName           = "refund_overuse_deposit_event"
+	TransferSubscriptionEventName           = "transfer_subscription_event"
+	CancelSubscriptionEventName             = "cancel_subscription_event"
 )